@@ -0,0 +1,109 @@
+package chunkedcache
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+var (
+	ErrNotFound         = errors.New("cache entry not found")
+	ErrUnexpectedStatus = errors.New("unexpected HTTP cache response status")
+)
+
+// Backend is a key-value storage for the chunks and the manifests.
+type Backend interface {
+	Exists(ctx context.Context, key string) (bool, error)
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Put(ctx context.Context, key string, data []byte) error
+}
+
+// HTTPBackend talks to an HTTP cache that supports a single /<key> endpoint with
+// GET, HEAD and POST methods, such as the agent's built-in cache server that is
+// backed by the CLI's local cache when running locally or by Cirrus CI otherwise.
+type HTTPBackend struct {
+	client *http.Client
+	host   string
+}
+
+func NewHTTPBackend(client *http.Client, host string) *HTTPBackend {
+	return &HTTPBackend{
+		client: client,
+		host:   host,
+	}
+}
+
+func (backend *HTTPBackend) Exists(ctx context.Context, key string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, backend.url(key), nil)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := backend.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("%w: %s", ErrUnexpectedStatus, resp.Status)
+	}
+}
+
+func (backend *HTTPBackend) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, backend.url(key), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := backend.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		_ = resp.Body.Close()
+
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+	default:
+		_ = resp.Body.Close()
+
+		return nil, fmt.Errorf("%w: %s", ErrUnexpectedStatus, resp.Status)
+	}
+}
+
+func (backend *HTTPBackend) Put(ctx context.Context, key string, data []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, backend.url(key), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := backend.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %s", ErrUnexpectedStatus, resp.Status)
+	}
+
+	return nil
+}
+
+func (backend *HTTPBackend) url(key string) string {
+	return fmt.Sprintf("http://%s/%s", backend.host, url.PathEscape(key))
+}
@@ -0,0 +1,225 @@
+// Package chunkedcache implements a content-addressed cache format in which the
+// tar stream of the cached folders is split into content-defined chunks that are
+// stored separately and shared between the cache entries, so that a change to
+// a few files results in re-uploading only the chunks containing them.
+package chunkedcache
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cirruslabs/cirrus-cli/internal/agent/targz"
+)
+
+var ErrChunkCorrupted = errors.New("chunk is corrupted")
+
+const chunkKeyPrefix = "cirrus-chunk-"
+
+// UploadStats describes how much of the cache entry was actually uploaded.
+type UploadStats struct {
+	Chunks         int
+	UploadedChunks int
+	Bytes          int64
+	UploadedBytes  int64
+}
+
+// ChunkKey returns the key under which a chunk with the specified digest is stored.
+func ChunkKey(digest string) string {
+	return chunkKeyPrefix + digest
+}
+
+// Upload archives the folders, uploads the chunks that are missing
+// in the backend and then stores the manifest under the specified key.
+func Upload(
+	ctx context.Context,
+	backend Backend,
+	key string,
+	baseFolder string,
+	folderPaths []string,
+) (*UploadStats, error) {
+	pipeReader, pipeWriter := io.Pipe()
+	defer pipeReader.Close()
+
+	go func() {
+		pipeWriter.CloseWithError(targz.ArchiveTar(baseFolder, folderPaths, pipeWriter))
+	}()
+
+	manifest := &Manifest{Format: ManifestFormat}
+	stats := &UploadStats{}
+	seen := map[string]struct{}{}
+	chunker := NewChunker(pipeReader)
+
+	for {
+		chunk, err := chunker.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		digestBytes := sha256.Sum256(chunk)
+		digest := hex.EncodeToString(digestBytes[:])
+
+		manifest.Chunks = append(manifest.Chunks, Chunk{
+			Digest: digest,
+			Size:   int64(len(chunk)),
+		})
+		stats.Chunks++
+		stats.Bytes += int64(len(chunk))
+
+		if _, ok := seen[digest]; ok {
+			continue
+		}
+		seen[digest] = struct{}{}
+
+		exists, err := backend.Exists(ctx, ChunkKey(digest))
+		if err != nil {
+			return nil, fmt.Errorf("failed to check chunk %s: %w", digest, err)
+		}
+		if exists {
+			continue
+		}
+
+		compressedChunk, err := compress(chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := backend.Put(ctx, ChunkKey(digest), compressedChunk); err != nil {
+			return nil, fmt.Errorf("failed to upload chunk %s: %w", digest, err)
+		}
+
+		stats.UploadedChunks++
+		stats.UploadedBytes += int64(len(compressedChunk))
+	}
+
+	manifestBytes, err := manifest.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := backend.Put(ctx, key, manifestBytes); err != nil {
+		return nil, fmt.Errorf("failed to upload manifest: %w", err)
+	}
+	stats.UploadedBytes += int64(len(manifestBytes))
+
+	return stats, nil
+}
+
+// Download fetches the chunks listed in the manifest, extracts the resulting
+// tar stream into the destination folder and returns the number of bytes fetched.
+//
+// Chunks are stored independently of the manifest and might be evicted
+// from the backend, in which case an error wrapping ErrNotFound is returned.
+func Download(ctx context.Context, backend Backend, manifest *Manifest, destFolder string) (int64, error) {
+	pipeReader, pipeWriter := io.Pipe()
+
+	var downloadedBytes int64
+
+	fetchErrCh := make(chan error, 1)
+
+	go func() {
+		err := fetchChunks(ctx, backend, manifest, pipeWriter, &downloadedBytes)
+		pipeWriter.CloseWithError(err)
+		fetchErrCh <- err
+	}()
+
+	unarchiveErr := targz.UnarchiveTar(pipeReader, destFolder)
+
+	// Unblock the fetching goroutine in case the tar stream was not fully consumed
+	_ = pipeReader.Close()
+
+	fetchErr := <-fetchErrCh
+
+	// A failed fetch also breaks the tar stream, so report the root cause
+	if fetchErr != nil && !errors.Is(fetchErr, io.ErrClosedPipe) {
+		return downloadedBytes, fetchErr
+	}
+
+	if unarchiveErr != nil {
+		return downloadedBytes, unarchiveErr
+	}
+
+	return downloadedBytes, nil
+}
+
+func fetchChunks(
+	ctx context.Context,
+	backend Backend,
+	manifest *Manifest,
+	writer io.Writer,
+	downloadedBytes *int64,
+) error {
+	for _, chunk := range manifest.Chunks {
+		if err := fetchChunk(ctx, backend, chunk, writer, downloadedBytes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func fetchChunk(ctx context.Context, backend Backend, chunk Chunk, writer io.Writer, downloadedBytes *int64) error {
+	body, err := backend.Get(ctx, ChunkKey(chunk.Digest))
+	if err != nil {
+		return fmt.Errorf("failed to download chunk %s: %w", chunk.Digest, err)
+	}
+	defer body.Close()
+
+	gzipReader, err := gzip.NewReader(&countingReader{reader: body, count: downloadedBytes})
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrChunkCorrupted, chunk.Digest, err)
+	}
+	defer gzipReader.Close()
+
+	// Verify the chunk before passing it further, since the
+	// backends are not guaranteed to validate what they store
+	contents, err := io.ReadAll(gzipReader)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrChunkCorrupted, chunk.Digest, err)
+	}
+
+	digestBytes := sha256.Sum256(contents)
+	if hex.EncodeToString(digestBytes[:]) != chunk.Digest || int64(len(contents)) != chunk.Size {
+		return fmt.Errorf("%w: %s: digest or size mismatch", ErrChunkCorrupted, chunk.Digest)
+	}
+
+	_, err = writer.Write(contents)
+
+	return err
+}
+
+type countingReader struct {
+	reader io.Reader
+	count  *int64
+}
+
+func (reader *countingReader) Read(p []byte) (int, error) {
+	n, err := reader.reader.Read(p)
+	*reader.count += int64(n)
+
+	return n, err
+}
+
+func compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	gzipWriter := gzip.NewWriter(&buf)
+
+	if _, err := gzipWriter.Write(data); err != nil {
+		return nil, err
+	}
+
+	if err := gzipWriter.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
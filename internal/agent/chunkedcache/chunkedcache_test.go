@@ -0,0 +1,164 @@
+package chunkedcache_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/agent/chunkedcache"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// httpCache is a minimal in-memory implementation of the HTTP cache protocol.
+type httpCache struct {
+	mtx   sync.Mutex
+	blobs map[string][]byte
+}
+
+func newHTTPCache(t *testing.T) (*httpCache, *chunkedcache.HTTPBackend) {
+	cache := &httpCache{blobs: map[string][]byte{}}
+
+	server := httptest.NewServer(cache)
+	t.Cleanup(server.Close)
+
+	return cache, chunkedcache.NewHTTPBackend(server.Client(), strings.TrimPrefix(server.URL, "http://"))
+}
+
+func (cache *httpCache) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	cache.mtx.Lock()
+	defer cache.mtx.Unlock()
+
+	key := strings.TrimPrefix(request.URL.Path, "/")
+
+	switch request.Method {
+	case http.MethodHead, http.MethodGet:
+		blob, ok := cache.blobs[key]
+		if !ok {
+			writer.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = writer.Write(blob)
+	case http.MethodPost:
+		blob, err := io.ReadAll(request.Body)
+		if err != nil {
+			writer.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		cache.blobs[key] = blob
+		writer.WriteHeader(http.StatusCreated)
+	default:
+		writer.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestUploadDownload(t *testing.T) {
+	ctx := context.Background()
+	_, backend := newHTTPCache(t)
+
+	// Populate the folder with a large incompressible file and a small one
+	baseFolder := testutil.TempDir(t)
+	largeFile := make([]byte, 16*1024*1024)
+	rand.New(rand.NewSource(1)).Read(largeFile)
+	require.NoError(t, os.WriteFile(filepath.Join(baseFolder, "large.bin"), largeFile, 0600))
+	require.NoError(t, os.Mkdir(filepath.Join(baseFolder, "sub-directory"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(baseFolder, "sub-directory", "small.txt"),
+		[]byte("contents"), 0600))
+
+	stats, err := chunkedcache.Upload(ctx, backend, "first", baseFolder, []string{baseFolder})
+	require.NoError(t, err)
+	require.Equal(t, stats.Chunks, stats.UploadedChunks)
+
+	// Modify the small file, only the last chunk should be re-uploaded
+	require.NoError(t, os.WriteFile(filepath.Join(baseFolder, "sub-directory", "small.txt"),
+		[]byte("changed contents"), 0600))
+
+	stats, err = chunkedcache.Upload(ctx, backend, "second", baseFolder, []string{baseFolder})
+	require.NoError(t, err)
+	require.Greater(t, stats.Chunks, 1)
+	require.Equal(t, 1, stats.UploadedChunks)
+
+	// Restore the second entry
+	manifestReader, err := backend.Get(ctx, "second")
+	require.NoError(t, err)
+	defer manifestReader.Close()
+
+	manifest, err := chunkedcache.ReadManifest(manifestReader)
+	require.NoError(t, err)
+	require.Equal(t, stats.Bytes, manifest.Size())
+
+	destFolder := testutil.TempDir(t)
+	downloadedBytes, err := chunkedcache.Download(ctx, backend, manifest, destFolder)
+	require.NoError(t, err)
+	require.Greater(t, downloadedBytes, int64(len(largeFile)))
+
+	restoredLargeFile, err := os.ReadFile(filepath.Join(destFolder, "large.bin"))
+	require.NoError(t, err)
+	require.Equal(t, largeFile, restoredLargeFile)
+
+	restoredSmallFile, err := os.ReadFile(filepath.Join(destFolder, "sub-directory", "small.txt"))
+	require.NoError(t, err)
+	require.Equal(t, "changed contents", string(restoredSmallFile))
+}
+
+func TestDownloadCorruptedChunk(t *testing.T) {
+	ctx := context.Background()
+	cache, backend := newHTTPCache(t)
+
+	baseFolder := testutil.TempDir(t)
+	require.NoError(t, os.WriteFile(filepath.Join(baseFolder, "file.txt"), []byte("contents"), 0600))
+
+	_, err := chunkedcache.Upload(ctx, backend, "key", baseFolder, []string{baseFolder})
+	require.NoError(t, err)
+
+	manifest, err := chunkedcache.ReadManifest(bytes.NewReader(cache.blobs["key"]))
+	require.NoError(t, err)
+	require.Len(t, manifest.Chunks, 1)
+
+	// Replace the chunk with a valid gzip stream of different contents
+	otherManifest := *manifest
+	otherManifest.Chunks = []chunkedcache.Chunk{{Digest: "0000", Size: 1}}
+	cache.blobs[chunkedcache.ChunkKey("0000")] = cache.blobs[chunkedcache.ChunkKey(manifest.Chunks[0].Digest)]
+
+	_, err = chunkedcache.Download(ctx, backend, &otherManifest, testutil.TempDir(t))
+	require.ErrorIs(t, err, chunkedcache.ErrChunkCorrupted)
+}
+
+func TestDownloadEvictedChunk(t *testing.T) {
+	ctx := context.Background()
+	cache, backend := newHTTPCache(t)
+
+	baseFolder := testutil.TempDir(t)
+	largeFile := make([]byte, 16*1024*1024)
+	rand.New(rand.NewSource(1)).Read(largeFile)
+	require.NoError(t, os.WriteFile(filepath.Join(baseFolder, "large.bin"), largeFile, 0600))
+
+	_, err := chunkedcache.Upload(ctx, backend, "key", baseFolder, []string{baseFolder})
+	require.NoError(t, err)
+
+	manifest, err := chunkedcache.ReadManifest(bytes.NewReader(cache.blobs["key"]))
+	require.NoError(t, err)
+	require.Greater(t, len(manifest.Chunks), 1)
+
+	// Evict a chunk in the middle of the tar stream
+	delete(cache.blobs, chunkedcache.ChunkKey(manifest.Chunks[len(manifest.Chunks)/2].Digest))
+
+	_, err = chunkedcache.Download(ctx, backend, manifest, testutil.TempDir(t))
+	require.ErrorIs(t, err, chunkedcache.ErrNotFound)
+}
+
+func TestReadManifestLegacyArchive(t *testing.T) {
+	_, err := chunkedcache.ReadManifest(bytes.NewReader([]byte{0x1f, 0x8b, 0x08, 0x00}))
+	require.ErrorIs(t, err, chunkedcache.ErrNotManifest)
+
+	_, err = chunkedcache.ReadManifest(bytes.NewReader(nil))
+	require.ErrorIs(t, err, chunkedcache.ErrNotManifest)
+}
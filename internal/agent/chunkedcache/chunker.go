@@ -0,0 +1,99 @@
+package chunkedcache
+
+import (
+	"errors"
+	"io"
+)
+
+const (
+	// MinChunkSize is the size below which no chunk boundary is placed, except at the end of the stream.
+	MinChunkSize = 256 * 1024
+
+	// MaxChunkSize is the size at which a chunk boundary is forcefully placed.
+	MaxChunkSize = 4 * 1024 * 1024
+
+	// averageChunkBits results in chunks of MinChunkSize + 1 MiB on average.
+	averageChunkBits = 20
+	averageChunkMask = (1 << averageChunkBits) - 1
+)
+
+// gear is a table of pseudo-random values used by the rolling hash. It's generated
+// from a fixed seed, so that the chunk boundaries are stable across the agent runs,
+// which is what makes the chunks reusable between the cache entries.
+var gear = func() (result [256]uint64) {
+	// SplitMix64
+	state := uint64(0x6a09e667f3bcc908)
+
+	for i := range result {
+		state += 0x9e3779b97f4a7c15
+		z := state
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		result[i] = z ^ (z >> 31)
+	}
+
+	return result
+}()
+
+// Chunker splits a stream into content-defined chunks using a gear-based
+// rolling hash, so that a change in the stream only affects the chunks
+// around it and not all the chunks that follow.
+type Chunker struct {
+	reader io.Reader
+	buf    []byte
+	filled int
+	eof    bool
+}
+
+func NewChunker(reader io.Reader) *Chunker {
+	return &Chunker{
+		reader: reader,
+		buf:    make([]byte, MaxChunkSize),
+	}
+}
+
+// Next returns the next chunk or io.EOF when the stream is exhausted.
+func (chunker *Chunker) Next() ([]byte, error) {
+	if !chunker.eof && chunker.filled < len(chunker.buf) {
+		n, err := io.ReadFull(chunker.reader, chunker.buf[chunker.filled:])
+		chunker.filled += n
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			chunker.eof = true
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	if chunker.filled == 0 {
+		return nil, io.EOF
+	}
+
+	cut := cutPoint(chunker.buf[:chunker.filled])
+
+	chunk := make([]byte, cut)
+	copy(chunk, chunker.buf[:cut])
+
+	copy(chunker.buf, chunker.buf[cut:chunker.filled])
+	chunker.filled -= cut
+
+	return chunk, nil
+}
+
+func cutPoint(data []byte) int {
+	if len(data) <= MinChunkSize {
+		return len(data)
+	}
+
+	var hash uint64
+
+	for i := MinChunkSize; i < len(data); i++ {
+		hash = (hash << 1) + gear[data[i]]
+
+		if hash&averageChunkMask == 0 {
+			return i + 1
+		}
+	}
+
+	return len(data)
+}
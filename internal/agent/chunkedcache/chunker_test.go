@@ -0,0 +1,78 @@
+package chunkedcache_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/agent/chunkedcache"
+	"github.com/stretchr/testify/require"
+)
+
+func chunkAll(t *testing.T, data []byte) [][]byte {
+	var result [][]byte
+
+	chunker := chunkedcache.NewChunker(bytes.NewReader(data))
+
+	for {
+		chunk, err := chunker.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+
+		result = append(result, chunk)
+	}
+
+	return result
+}
+
+func TestChunkerBounds(t *testing.T) {
+	data := make([]byte, 32*1024*1024)
+	rand.New(rand.NewSource(1)).Read(data)
+
+	chunks := chunkAll(t, data)
+	require.Greater(t, len(chunks), 1)
+
+	for i, chunk := range chunks {
+		require.LessOrEqual(t, len(chunk), chunkedcache.MaxChunkSize)
+
+		if i != len(chunks)-1 {
+			require.GreaterOrEqual(t, len(chunk), chunkedcache.MinChunkSize)
+		}
+	}
+
+	require.Equal(t, data, bytes.Join(chunks, nil))
+}
+
+func TestChunkerEmpty(t *testing.T) {
+	require.Empty(t, chunkAll(t, nil))
+}
+
+func TestChunkerResynchronizes(t *testing.T) {
+	data := make([]byte, 32*1024*1024)
+	rand.New(rand.NewSource(1)).Read(data)
+
+	// Insert a few bytes in the middle of the stream
+	middle := len(data) / 2
+	modified := append(append(append([]byte{}, data[:middle]...), []byte("change")...), data[middle:]...)
+
+	original := map[string]struct{}{}
+	for _, chunk := range chunkAll(t, data) {
+		original[string(chunk)] = struct{}{}
+	}
+
+	modifiedChunks := chunkAll(t, modified)
+
+	var changed int
+	for _, chunk := range modifiedChunks {
+		if _, ok := original[string(chunk)]; !ok {
+			changed++
+		}
+	}
+
+	// Only the chunks around the insertion point should differ
+	require.LessOrEqual(t, changed, 2)
+}
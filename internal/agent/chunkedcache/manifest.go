@@ -0,0 +1,73 @@
+package chunkedcache
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ManifestFormat identifies the chunked cache entries and distinguishes
+// them from the legacy ones, which are plain .tar.gz archives.
+const ManifestFormat = "cirrus-chunked-cache-v1"
+
+var (
+	ErrNotManifest     = errors.New("not a chunked cache manifest")
+	ErrInvalidManifest = errors.New("invalid chunked cache manifest")
+)
+
+// manifestPrefix is how every serialized manifest starts, which allows
+// to tell it apart from a legacy archive without reading the whole entry.
+var manifestPrefix = []byte(`{"format":"` + ManifestFormat + `"`)
+
+// Manifest is stored under the cache key and lists the chunks that
+// need to be concatenated to get the tar stream of the cached folders.
+type Manifest struct {
+	Format string  `json:"format"`
+	Chunks []Chunk `json:"chunks"`
+}
+
+type Chunk struct {
+	// Digest is a hex-encoded SHA-256 of the chunk's uncompressed contents
+	Digest string `json:"digest"`
+	Size   int64  `json:"size"`
+}
+
+// Size returns the uncompressed size of the tar stream described by the manifest.
+func (manifest *Manifest) Size() int64 {
+	var result int64
+
+	for _, chunk := range manifest.Chunks {
+		result += chunk.Size
+	}
+
+	return result
+}
+
+func (manifest *Manifest) Marshal() ([]byte, error) {
+	return json.Marshal(manifest)
+}
+
+// ReadManifest reads a manifest, returning ErrNotManifest
+// if the reader contains something else (e.g. a legacy archive).
+func ReadManifest(reader io.Reader) (*Manifest, error) {
+	bufferedReader := bufio.NewReader(reader)
+
+	prefix, err := bufferedReader.Peek(len(manifestPrefix))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	if !bytes.Equal(prefix, manifestPrefix) {
+		return nil, ErrNotManifest
+	}
+
+	var manifest Manifest
+
+	if err := json.NewDecoder(bufferedReader).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidManifest, err)
+	}
+
+	return &manifest, nil
+}
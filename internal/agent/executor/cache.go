@@ -18,11 +18,13 @@ import (
 	"time"
 
 	"github.com/bmatcuk/doublestar"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/chunkedcache"
 	"github.com/cirruslabs/cirrus-cli/internal/agent/client"
 	"github.com/cirruslabs/cirrus-cli/internal/agent/environment"
 	"github.com/cirruslabs/cirrus-cli/internal/agent/hasher"
 	"github.com/cirruslabs/cirrus-cli/internal/agent/targz"
 	"github.com/cirruslabs/cirrus-cli/pkg/api"
+	"github.com/dustin/go-humanize"
 )
 
 type Cache struct {
@@ -33,6 +35,8 @@ type Cache struct {
 	FileHasher               *hasher.Hasher
 	SkipUpload               bool
 	CacheAvailable           bool
+	// Cache entry exists, but some of its chunks were evicted, so it needs to be re-uploaded
+	CacheIncomplete bool
 }
 
 var caches = make([]Cache, 0)
@@ -113,14 +117,14 @@ func (executor *Executor) DownloadCache(
 		}
 	}
 
-	cachePopulated, cacheAvailable := executor.tryToDownloadAndPopulateCache(ctx, logUploader, commandName, cacheHost, cacheKey, baseFolder)
+	cachePopulated, cacheAvailable, cacheIncomplete := executor.tryToDownloadAndPopulateCache(ctx, logUploader, commandName, cacheHost, cacheKey, baseFolder)
 
 	if !cacheAvailable && instruction.OptimisticallyRestoreOnMiss {
 		logUploader.Write([]byte("\nWasn't able to find the exact cache! Requesting the last available one..."))
 		cacheInfo := executor.findLatestAvailableCache(ctx, logUploader, commandName)
 		if cacheInfo != nil {
 			logUploader.Write([]byte(fmt.Sprintf("\nFound cache entry %s created by task %s!", cacheInfo.Key, cacheInfo.CreatedByTaskId)))
-			cachePopulated, cacheAvailable = executor.tryToDownloadAndPopulateCache(ctx, logUploader, commandName, cacheHost, cacheInfo.Key, baseFolder)
+			cachePopulated, cacheAvailable, _ = executor.tryToDownloadAndPopulateCache(ctx, logUploader, commandName, cacheHost, cacheInfo.Key, baseFolder)
 		}
 	}
 
@@ -167,8 +171,9 @@ func (executor *Executor) DownloadCache(
 			BaseFolder:               baseFolder,
 			PartiallyExpandedFolders: partiallyExpandedFolders,
 			FileHasher:               fileHasher,
-			SkipUpload:               cacheAvailable && !instruction.ReuploadOnChanges,
+			SkipUpload:               cacheAvailable && !instruction.ReuploadOnChanges && !cacheIncomplete,
 			CacheAvailable:           cacheAvailable,
+			CacheIncomplete:          cacheIncomplete,
 		},
 	)
 	return true
@@ -258,49 +263,50 @@ func (executor *Executor) tryToDownloadAndPopulateCache(
 	cacheHost string,
 	cacheKey string,
 	folderToCache string,
-) (bool, bool) { // successfully populated, available remotely
+) (bool, bool, bool) { // successfully populated, available remotely, incomplete
 	cacheFile, fetchDuration, err := FetchCache(ctx, logUploader, commandName, cacheHost, cacheKey)
 	if err != nil {
 		logUploader.Write([]byte(fmt.Sprintf("\nFailed to fetch archive for %s cache: %s!", commandName, err)))
 		if err, ok := err.(net.Error); ok && err.Timeout() {
-			return false, true
+			return false, true, false
 		} else {
-			return false, false
+			return false, false, false
 		}
 	}
 	if cacheFile == nil {
-		return false, false
-	}
-
-	cacheFileInfo, statErr := os.Stat(cacheFile.Name())
-	if statErr != nil {
-		executor.cacheAttempts.Failed(cacheKey, fmt.Sprintf("failed to determine cache file size: %v", statErr))
+		return false, false, false
 	}
 
 	_, _ = logUploader.Write([]byte(fmt.Sprintf("\nCache hit for %s!", cacheKey)))
 	unarchiveStartTime := time.Now()
-	err = unarchiveCache(cacheFile, folderToCache)
-	if err != nil {
+	cacheSize, err := unarchiveCache(ctx, cacheHost, cacheFile, folderToCache)
+	if errors.Is(err, chunkedcache.ErrNotFound) {
+		// Retrying won't help since the chunk was evicted, so re-populate and re-upload the entry
+		logUploader.Write([]byte(fmt.Sprintf("\nSome chunks of %s cache are no longer available (%s)! "+
+			"Treating this as a cache miss. Cleaning up %s...\n", commandName, err, folderToCache)))
+		os.RemoveAll(folderToCache)
+		return false, false, true
+	} else if err != nil {
 		logUploader.Write([]byte(fmt.Sprintf("\nFailed to unarchive %s cache because of %s! Retrying...\n", commandName, err)))
 		os.RemoveAll(folderToCache)
 		cacheFile, fetchDuration, err = FetchCache(ctx, logUploader, commandName, cacheHost, cacheKey)
 		if err != nil {
 			logUploader.Write([]byte(fmt.Sprintf("\nFailed to fetch archive for %s cache: %s!", commandName, err)))
 			if err, ok := err.(net.Error); ok && err.Timeout() {
-				return false, true
+				return false, true, false
 			} else {
-				return false, false
+				return false, false, false
 			}
 		}
 		if cacheFile == nil {
-			return false, true
+			return false, true, false
 		}
-		err = unarchiveCache(cacheFile, folderToCache)
+		cacheSize, err = unarchiveCache(ctx, cacheHost, cacheFile, folderToCache)
 		if err != nil {
 			logUploader.Write([]byte(fmt.Sprintf("\nFailed again to unarchive %s cache because of %s!\n", commandName, err)))
 			logUploader.Write([]byte(fmt.Sprintf("\nTreating this failure as a cache miss but won't try to re-upload! Cleaning up %s...\n", folderToCache)))
 			os.RemoveAll(folderToCache)
-			return false, true
+			return false, true, false
 		}
 	} else {
 		unarchiveDuration := time.Since(unarchiveStartTime)
@@ -309,20 +315,45 @@ func (executor *Executor) tryToDownloadAndPopulateCache(
 		}
 	}
 
-	if statErr == nil {
-		executor.cacheAttempts.Hit(cacheKey, uint64(cacheFileInfo.Size()), fetchDuration, time.Since(unarchiveStartTime))
-	}
+	executor.cacheAttempts.Hit(cacheKey, cacheSize, fetchDuration, time.Since(unarchiveStartTime))
 
-	return true, true
+	return true, true, false
 }
 
+// unarchiveCache extracts the cache entry into the folder and returns its size,
+// which for the chunked entries is the size of the chunks downloaded for it.
 func unarchiveCache(
+	ctx context.Context,
+	cacheHost string,
 	cacheFile *os.File,
 	folderToCache string,
-) error {
+) (uint64, error) {
 	defer os.Remove(cacheFile.Name())
 	EnsureFolderExists(folderToCache)
-	return targz.Unarchive(cacheFile.Name(), folderToCache)
+
+	cacheFileInfo, err := os.Stat(cacheFile.Name())
+	if err != nil {
+		return 0, fmt.Errorf("failed to determine cache file size: %w", err)
+	}
+
+	// Cache entry is either a chunked cache manifest or a legacy archive
+	manifestFile, err := os.Open(cacheFile.Name())
+	if err != nil {
+		return 0, err
+	}
+	manifest, err := chunkedcache.ReadManifest(manifestFile)
+	_ = manifestFile.Close()
+
+	if errors.Is(err, chunkedcache.ErrNotManifest) {
+		return uint64(cacheFileInfo.Size()), targz.Unarchive(cacheFile.Name(), folderToCache)
+	} else if err != nil {
+		return 0, err
+	}
+
+	chunksSize, err := chunkedcache.Download(ctx, chunkedcache.NewHTTPBackend(httpClient, cacheHost), manifest,
+		folderToCache)
+
+	return uint64(chunksSize), err
 }
 
 func FetchCache(
@@ -387,8 +418,6 @@ func (executor *Executor) UploadCache(
 	cacheHost string,
 	instruction *api.UploadCacheInstruction,
 ) bool {
-	var err error
-
 	cache := FindCache(instruction.CacheName)
 
 	if cache == nil {
@@ -438,6 +467,75 @@ func (executor *Executor) UploadCache(
 		}
 	}
 
+	cacheURL := fmt.Sprintf("http://%s/%s", cacheHost, url.PathEscape(cache.Key))
+
+	if !cache.CacheAvailable && !cache.CacheIncomplete {
+		// check if some other task has uploaded the cache already
+		req, err := http.NewRequestWithContext(ctx, http.MethodHead, cacheURL, nil)
+		if err != nil {
+			logUploader.Write([]byte(fmt.Sprintf("\nFailed to create cache check request to URL %s!", cacheURL)))
+			return false
+		}
+		response, _ := httpClient.Do(req)
+		if response != nil && response.StatusCode == http.StatusOK {
+			logUploader.Write([]byte(fmt.Sprintf("\nSome other task has already uploaded cache entry %s! Skipping upload...", cache.Key)))
+			return true
+		}
+	}
+
+	if executor.env.Get("CIRRUS_CACHE_LEGACY_FORMAT") != "true" {
+		if executor.uploadChunkedCache(ctx, logUploader, instruction.CacheName, cacheHost, cache, foldersToCache) {
+			return true
+		}
+
+		logUploader.Write([]byte("\nFalling back to the legacy cache format..."))
+	}
+
+	return executor.uploadLegacyCache(ctx, logUploader, commandName, instruction.CacheName, cacheURL, cache,
+		foldersToCache)
+}
+
+// uploadChunkedCache uploads the cache in the content-addressed format, in which only
+// the chunks that are not yet stored in the HTTP cache are uploaded, and returns
+// false if the upload has failed and the legacy format should be used instead.
+func (executor *Executor) uploadChunkedCache(
+	ctx context.Context,
+	logUploader *LogUploader,
+	cacheName string,
+	cacheHost string,
+	cache *Cache,
+	foldersToCache []string,
+) bool {
+	logUploader.Write([]byte(fmt.Sprintf("\nUploading cache %s...", cacheName)))
+
+	uploadStartTime := time.Now()
+	stats, err := chunkedcache.Upload(ctx, chunkedcache.NewHTTPBackend(httpClient, cacheHost), cache.Key,
+		cache.BaseFolder, foldersToCache)
+	if err != nil {
+		logUploader.Write([]byte(fmt.Sprintf("\nFailed to upload chunked cache '%s': %s!", cacheName, err)))
+		return false
+	}
+	uploadDuration := time.Since(uploadStartTime)
+
+	logUploader.Write([]byte(fmt.Sprintf("\n%s cache size is %s, uploaded %d new chunks out of %d (%s).",
+		cacheName, humanize.Bytes(uint64(stats.Bytes)), stats.UploadedChunks, stats.Chunks,
+		humanize.Bytes(uint64(stats.UploadedBytes)))))
+
+	// Archiving happens while uploading the chunks, so there's no separate archiving phase
+	executor.cacheAttempts.Miss(cache.Key, uint64(stats.UploadedBytes), 0, uploadDuration)
+
+	return true
+}
+
+func (executor *Executor) uploadLegacyCache(
+	ctx context.Context,
+	logUploader *LogUploader,
+	commandName string,
+	cacheName string,
+	cacheURL string,
+	cache *Cache,
+	foldersToCache []string,
+) bool {
 	cacheFile, err := os.CreateTemp("", "")
 	if err != nil {
 		logUploader.Write([]byte(fmt.Sprintf("\nFailed to create temporary cache file: %v", err)))
@@ -461,30 +559,14 @@ func (executor *Executor) UploadCache(
 	bytesToUpload := fi.Size()
 
 	if bytesToUpload < 1024 {
-		logUploader.Write([]byte(fmt.Sprintf("\n%s cache size is %d bytes.", instruction.CacheName, bytesToUpload)))
+		logUploader.Write([]byte(fmt.Sprintf("\n%s cache size is %d bytes.", cacheName, bytesToUpload)))
 	} else if bytesToUpload < 1024*1024 {
-		logUploader.Write([]byte(fmt.Sprintf("\n%s cache size is %dKb.", instruction.CacheName, bytesToUpload/1024)))
+		logUploader.Write([]byte(fmt.Sprintf("\n%s cache size is %dKb.", cacheName, bytesToUpload/1024)))
 	} else {
-		logUploader.Write([]byte(fmt.Sprintf("\n%s cache size is %dMb.", instruction.CacheName, bytesToUpload/1024/1024)))
-	}
-
-	cacheURL := fmt.Sprintf("http://%s/%s", cacheHost, url.PathEscape(cache.Key))
-
-	if !cache.CacheAvailable {
-		// check if some other task has uploaded the cache already
-		req, err := http.NewRequestWithContext(ctx, http.MethodHead, cacheURL, nil)
-		if err != nil {
-			logUploader.Write([]byte(fmt.Sprintf("\nFailed to create cache check request to URL %s!", cacheURL)))
-			return false
-		}
-		response, _ := httpClient.Do(req)
-		if response != nil && response.StatusCode == http.StatusOK {
-			logUploader.Write([]byte(fmt.Sprintf("\nSome other task has already uploaded cache entry %s! Skipping upload...", cache.Key)))
-			return true
-		}
+		logUploader.Write([]byte(fmt.Sprintf("\n%s cache size is %dMb.", cacheName, bytesToUpload/1024/1024)))
 	}
 
-	logUploader.Write([]byte(fmt.Sprintf("\nUploading cache %s...", instruction.CacheName)))
+	logUploader.Write([]byte(fmt.Sprintf("\nUploading cache %s...", cacheName)))
 	uploadStartTime := time.Now()
 	err = UploadCacheFile(ctx, cacheURL, cacheFile)
 	if err != nil {
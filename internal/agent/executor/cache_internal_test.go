@@ -0,0 +1,100 @@
+package executor
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/agent/chunkedcache"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkedCacheHitAndEvictedChunk(t *testing.T) {
+	ctx := context.Background()
+
+	var mtx sync.Mutex
+	blobs := map[string][]byte{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		key := strings.TrimPrefix(request.URL.Path, "/")
+
+		switch request.Method {
+		case http.MethodHead, http.MethodGet:
+			blob, ok := blobs[key]
+			if !ok {
+				writer.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = writer.Write(blob)
+		case http.MethodPost:
+			blob, err := io.ReadAll(request.Body)
+			if err != nil {
+				writer.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			blobs[key] = blob
+			writer.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer server.Close()
+
+	cacheHost := strings.TrimPrefix(server.URL, "http://")
+
+	baseFolder := testutil.TempDir(t)
+	largeFile := make([]byte, 16*1024*1024)
+	rand.New(rand.NewSource(1)).Read(largeFile)
+	require.NoError(t, os.WriteFile(filepath.Join(baseFolder, "large.bin"), largeFile, 0600))
+
+	_, err := chunkedcache.Upload(ctx, chunkedcache.NewHTTPBackend(server.Client(), cacheHost), "key",
+		baseFolder, []string{baseFolder})
+	require.NoError(t, err)
+
+	var chunkKeys []string
+	var chunksSize int
+
+	mtx.Lock()
+	for key, blob := range blobs {
+		if strings.HasPrefix(key, chunkedcache.ChunkKey("")) {
+			chunkKeys = append(chunkKeys, key)
+			chunksSize += len(blob)
+		}
+	}
+	mtx.Unlock()
+	require.Greater(t, len(chunkKeys), 1)
+
+	executor := &Executor{cacheAttempts: NewCacheAttempts()}
+	logUploader := &LogUploader{closed: true}
+
+	// The size of the downloaded chunks is reported for a cache hit
+	destFolder := filepath.Join(testutil.TempDir(t), "restored")
+	populated, available, incomplete := executor.tryToDownloadAndPopulateCache(ctx, logUploader, "test",
+		cacheHost, "key", destFolder)
+	require.True(t, populated)
+	require.True(t, available)
+	require.False(t, incomplete)
+	require.EqualValues(t, chunksSize, executor.cacheAttempts.cacheRetrievalAttempts["key"].GetHit().SizeBytes)
+
+	// An evicted chunk results in a cache miss that needs to be re-uploaded
+	mtx.Lock()
+	delete(blobs, chunkKeys[0])
+	mtx.Unlock()
+
+	destFolder = filepath.Join(testutil.TempDir(t), "restored")
+	populated, available, incomplete = executor.tryToDownloadAndPopulateCache(ctx, logUploader, "test",
+		cacheHost, "key", destFolder)
+	require.False(t, populated)
+	require.False(t, available)
+	require.True(t, incomplete)
+	require.NoDirExists(t, destFolder)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/cirruslabs/cirrus-cli/internal/agent/environment"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/forge"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
@@ -33,9 +34,15 @@ func CloneRepository(
 
 	useMergeRef := env.Get("CIRRUS_RESOLUTION_STRATEGY") == "MERGE_FOR_PRS"
 
+	repoForge, err := forge.NewFromEnvironment(env)
+	if err != nil {
+		logUploader.Write([]byte(fmt.Sprintf("\nFailed to clone: %s!", err)))
+		return false
+	}
+
 	clone_url := env.Get("CIRRUS_REPO_CLONE_URL")
 	if _, has_clone_token := env.Lookup("CIRRUS_REPO_CLONE_TOKEN"); has_clone_token {
-		clone_url = env.ExpandText("https://x-access-token:${CIRRUS_REPO_CLONE_TOKEN}@${CIRRUS_REPO_CLONE_HOST}/${CIRRUS_REPO_FULL_NAME}.git")
+		clone_url = forge.CloneURL(repoForge, env)
 	}
 
 	clone_depth := 0
@@ -53,13 +60,20 @@ func CloneRepository(
 	gitclient.InstallProtocol("http", githttp.NewClient(customClient))
 
 	var repo *git.Repository
-	var err error
 
 	tagsOption := git.NoTags
 	if env.Get("CIRRUS_CLONE_TAGS") == "true" {
 		tagsOption = git.AllTags
 	}
-	if is_pr {
+
+	settings, err := newCloneSettings(env, clone_url)
+	if err != nil {
+		logUploader.Write([]byte(fmt.Sprintf("\nFailed to clone: %s!", err)))
+		return false
+	}
+	settings.describe(logUploader)
+
+	if is_pr || settings.requiresFetch() {
 		repo, err = git.PlainInit(working_dir, false)
 		if err != nil {
 			logUploader.Write([]byte(fmt.Sprintf("\nFailed to init repository: %s!", err)))
@@ -74,31 +88,60 @@ func CloneRepository(
 			return false
 		}
 
-		var refSpec string
+		if settings.reference != "" {
+			cleanup := seedFromReference(ctx, logUploader, repo, settings.reference)
+			defer cleanup()
+		}
+
+		var refSpecs []config.RefSpec
+
+		prRef := plumbing.ReferenceName(fmt.Sprintf("refs/remotes/origin/pull/%s", pr_number))
+		mergeRef, hasMergeRef := repoForge.MergeRef(pr_number)
+		base_branch := env.Get("CIRRUS_BASE_BRANCH")
+		baseRef := plumbing.NewRemoteReferenceName("origin", base_branch)
 
-		if useMergeRef {
-			refSpec = fmt.Sprintf("+refs/pull/%s/merge:refs/remotes/origin/pull/%[1]s", pr_number)
+		switch {
+		case !is_pr && is_tag:
+			refSpecs = append(refSpecs, config.RefSpec(fmt.Sprintf("+refs/tags/%s:refs/tags/%[1]s", tag)))
+		case !is_pr:
+			refSpecs = append(refSpecs, config.RefSpec(fmt.Sprintf("+refs/heads/%s:refs/remotes/origin/%[1]s", branch)))
+		case useMergeRef && hasMergeRef:
+			refSpecs = append(refSpecs, config.RefSpec(fmt.Sprintf("+%s:%s", mergeRef, prRef)))
 			if clone_depth > 0 {
 				// increase by one since we are cloning with an extra "merge" commit from GH
 				clone_depth = clone_depth + 1
 			}
-		} else {
-			refSpec = fmt.Sprintf("+refs/pull/%s/head:refs/remotes/origin/pull/%[1]s", pr_number)
+		case useMergeRef:
+			// The forge doesn't provide a merge commit, so we'll need
+			// to fetch the base branch and create one ourselves
+			if base_branch == "" {
+				logUploader.Write([]byte(fmt.Sprintf("\n%s doesn't provide merge references and CIRRUS_BASE_BRANCH "+
+					"is not set, can't create a merge commit!", repoForge.Name())))
+				return false
+			}
+			refSpecs = append(refSpecs,
+				config.RefSpec(fmt.Sprintf("+%s:%s", repoForge.HeadRef(pr_number), prRef)),
+				config.RefSpec(fmt.Sprintf("+refs/heads/%s:%s", base_branch, baseRef)),
+			)
+		default:
+			refSpecs = append(refSpecs, config.RefSpec(fmt.Sprintf("+%s:%s", repoForge.HeadRef(pr_number), prRef)))
 		}
 
-		logUploader.Write([]byte(fmt.Sprintf("\nFetching %s...\n", refSpec)))
+		for _, refSpec := range refSpecs {
+			logUploader.Write([]byte(fmt.Sprintf("\nFetching %s...\n", refSpec)))
+		}
 		fetchOptions := &git.FetchOptions{
 			RemoteName: remoteConfig.Name,
-			RefSpecs:   []config.RefSpec{config.RefSpec(refSpec)},
+			RefSpecs:   refSpecs,
 			Tags:       tagsOption,
 			Progress:   logUploader,
 			Depth:      clone_depth,
 		}
-		err = repo.FetchContext(ctx, fetchOptions)
+		err = settings.fetch(ctx, logUploader, repo, clone_url, fetchOptions)
 		if err != nil && retryableCloneError(err) {
 			logUploader.Write([]byte(fmt.Sprintf("\nFetch failed: %s!", err)))
 			logUploader.Write([]byte("\nRe-trying to fetch..."))
-			err = repo.FetchContext(ctx, fetchOptions)
+			err = settings.fetch(ctx, logUploader, repo, clone_url, fetchOptions)
 		}
 		if err != nil {
 			logUploader.Write([]byte(fmt.Sprintf("\nFailed fetch: %s!", err)))
@@ -111,9 +154,20 @@ func CloneRepository(
 			return false
 		}
 
-		if useMergeRef {
+		fetchedRef := refSpecs[0].Dst("")
+
+		if useMergeRef && is_pr && hasMergeRef {
 			checkoutOptions := git.CheckoutOptions{
-				Branch: plumbing.ReferenceName(fmt.Sprintf("refs/remotes/origin/pull/%[1]s", pr_number)),
+				Branch:                    fetchedRef,
+				SparseCheckoutDirectories: settings.sparseDirectories,
+			}
+			mergeHash, err := resolveCommit(repo, fetchedRef)
+			if err != nil {
+				logUploader.Write([]byte(fmt.Sprintf("\nFailed to resolve %s: %s!", fetchedRef, err)))
+				return false
+			}
+			if !settings.fetchMissingBlobs(ctx, logUploader, repo, clone_url, mergeHash) {
+				return false
 			}
 			logUploader.Write([]byte(fmt.Sprintf("\nChecking out %s...", checkoutOptions.Branch)))
 			err = workTree.Checkout(&checkoutOptions)
@@ -121,9 +175,72 @@ func CloneRepository(
 				logUploader.Write([]byte(fmt.Sprintf("\nFailed to checkout %s: %s!", checkoutOptions.Branch, err)))
 				return false
 			}
+		} else if useMergeRef && is_pr {
+			headHash, err := resolveCommit(repo, prRef)
+			if err != nil {
+				logUploader.Write([]byte(fmt.Sprintf("\nFailed to resolve %s: %s!", prRef, err)))
+				return false
+			}
+			baseHash, err := resolveCommit(repo, baseRef)
+			if err != nil {
+				logUploader.Write([]byte(fmt.Sprintf("\nFailed to resolve %s: %s!", baseRef, err)))
+				return false
+			}
+
+			logUploader.Write([]byte(fmt.Sprintf("\n%s doesn't provide merge references, merging %s into %s...",
+				repoForge.Name(), headHash, base_branch)))
+			var fetchBlobs func(hashes []plumbing.Hash) error
+			if settings.filter != "" {
+				fetchBlobs = func(hashes []plumbing.Hash) error {
+					return fetchObjects(ctx, repo, clone_url, hashes, logUploader)
+				}
+			}
+
+			mergeHash, err := syntheticMerge(repo, baseHash, headHash, fetchBlobs)
+			if err != nil {
+				logUploader.Write([]byte(fmt.Sprintf("\nFailed to merge %s into %s: %s!", headHash, base_branch, err)))
+				return false
+			}
+
+			checkoutOptions := git.CheckoutOptions{
+				Hash:                      mergeHash,
+				SparseCheckoutDirectories: settings.sparseDirectories,
+			}
+			if !settings.fetchMissingBlobs(ctx, logUploader, repo, clone_url, mergeHash) {
+				return false
+			}
+			logUploader.Write([]byte(fmt.Sprintf("\nChecking out %s...", checkoutOptions.Hash)))
+			err = workTree.Checkout(&checkoutOptions)
+			if err != nil {
+				logUploader.Write([]byte(fmt.Sprintf("\nFailed to checkout %s: %s!", checkoutOptions.Hash, err)))
+				return false
+			}
 		} else {
 			checkoutOptions := git.CheckoutOptions{
-				Hash: plumbing.NewHash(change),
+				Hash:                      plumbing.NewHash(change),
+				SparseCheckoutDirectories: settings.sparseDirectories,
+			}
+
+			// Fall back to the fetched reference when the change is not
+			// reachable, the hard reset below will then report the error
+			if !is_pr {
+				if _, err := repo.CommitObject(checkoutOptions.Hash); err != nil {
+					checkoutOptions.Hash, err = resolveCommit(repo, fetchedRef)
+					if err != nil {
+						logUploader.Write([]byte(fmt.Sprintf("\nFailed to resolve %s: %s!", fetchedRef, err)))
+						return false
+					}
+				}
+
+				// Check out the branch similarly to "git clone"
+				if !is_tag {
+					checkoutOptions.Branch = plumbing.NewBranchReferenceName(branch)
+					checkoutOptions.Create = true
+				}
+			}
+
+			if !settings.fetchMissingBlobs(ctx, logUploader, repo, clone_url, checkoutOptions.Hash) {
+				return false
 			}
 			logUploader.Write([]byte(fmt.Sprintf("\nChecking out %s...", checkoutOptions.Hash)))
 			err = workTree.Checkout(&checkoutOptions)
@@ -186,10 +303,14 @@ func CloneRepository(
 			return false
 		}
 
-		err = workTree.Reset(&git.ResetOptions{
+		if !settings.fetchMissingBlobs(ctx, logUploader, repo, clone_url, plumbing.NewHash(change)) {
+			return false
+		}
+
+		err = workTree.ResetSparsely(&git.ResetOptions{
 			Commit: plumbing.NewHash(change),
 			Mode:   git.HardReset,
-		})
+		}, settings.sparseDirectories)
 		if err != nil {
 			logUploader.Write([]byte(fmt.Sprintf("\nFailed to force reset to %s: %s!", change, err)))
 			return false
@@ -230,6 +351,18 @@ func CloneRepository(
 		logUploader.Write([]byte("\nSucessfully updated submodules!"))
 	}
 
+	if settings.lfs {
+		logUploader.Write([]byte("\nFetching Git LFS objects..."))
+
+		numObjects, err := fetchLFSObjects(ctx, repo, working_dir, settings.lfsURL)
+		if err != nil {
+			logUploader.Write([]byte(fmt.Sprintf("\nFailed to fetch Git LFS objects: %s!", err)))
+			return false
+		}
+
+		logUploader.Write([]byte(fmt.Sprintf("\nFetched %d Git LFS objects!", numObjects)))
+	}
+
 	ref, err = repo.Head()
 	if err != nil {
 		logUploader.Write([]byte("\nFailed to get HEAD information!"))
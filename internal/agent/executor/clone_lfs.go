@@ -0,0 +1,335 @@
+package executor
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+)
+
+var ErrLFS = errors.New("Git LFS request failed")
+
+const (
+	lfsMediaType      = "application/vnd.git-lfs+json"
+	lfsPointerMaxSize = 1024
+	lfsBatchSize      = 100
+	lfsPointerVersion = "version https://git-lfs.github.com/spec/v1"
+)
+
+type lfsObject struct {
+	OID  string `json:"oid"`
+	Size int64  `json:"size"`
+}
+
+type lfsBatchRequest struct {
+	Operation string      `json:"operation"`
+	Transfers []string    `json:"transfers"`
+	Objects   []lfsObject `json:"objects"`
+	HashAlgo  string      `json:"hash_algo"`
+}
+
+type lfsBatchResponse struct {
+	Objects []struct {
+		lfsObject
+		Actions struct {
+			Download *struct {
+				Href   string            `json:"href"`
+				Header map[string]string `json:"header"`
+			} `json:"download"`
+		} `json:"actions"`
+		Error *struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	} `json:"objects"`
+}
+
+// fetchLFSObjects replaces the Git LFS pointer files checked out into
+// the working tree with their contents and returns the number of objects fetched.
+func fetchLFSObjects(ctx context.Context, repo *git.Repository, workingDir string, lfsURL string) (int, error) {
+	pointers, err := findLFSPointers(repo, workingDir)
+	if err != nil {
+		return 0, err
+	}
+	if len(pointers) == 0 {
+		return 0, nil
+	}
+
+	endpoint, err := url.Parse(lfsURL)
+	if err != nil {
+		return 0, err
+	}
+	user := endpoint.User
+	endpoint.User = nil
+
+	client := &http.Client{
+		Timeout: 900 * time.Second,
+	}
+
+	objects := make([]lfsObject, 0, len(pointers))
+	for object := range pointers {
+		objects = append(objects, object)
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].OID < objects[j].OID
+	})
+
+	for start := 0; start < len(objects); start += lfsBatchSize {
+		end := min(start+lfsBatchSize, len(objects))
+
+		batch, err := lfsBatch(ctx, client, endpoint.String(), user, objects[start:end])
+		if err != nil {
+			return 0, err
+		}
+
+		for _, object := range batch.Objects {
+			if object.Error != nil {
+				return 0, fmt.Errorf("%w: object %s: %s (%d)", ErrLFS, object.OID,
+					object.Error.Message, object.Error.Code)
+			}
+
+			paths, ok := pointers[object.lfsObject]
+			if !ok {
+				return 0, fmt.Errorf("%w: server returned an unexpected object %s", ErrLFS, object.OID)
+			}
+
+			if object.Actions.Download == nil {
+				return 0, fmt.Errorf("%w: no download action for object %s", ErrLFS, object.OID)
+			}
+
+			if err := lfsDownload(ctx, client, object.Actions.Download.Href, object.Actions.Download.Header,
+				object.lfsObject, paths); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return len(objects), nil
+}
+
+// findLFSPointers scans the files checked out into the working tree for
+// Git LFS pointers and returns the paths that need to be replaced for each object.
+func findLFSPointers(repo *git.Repository, workingDir string) (map[lfsObject][]string, error) {
+	idx, err := repo.Storer.Index()
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[lfsObject][]string{}
+
+	for _, entry := range idx.Entries {
+		if entry.SkipWorktree || entry.Size > lfsPointerMaxSize {
+			continue
+		}
+		if entry.Mode != filemode.Regular && entry.Mode != filemode.Executable {
+			continue
+		}
+
+		path := filepath.Join(workingDir, filepath.FromSlash(entry.Name))
+
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		object, ok := parseLFSPointer(contents)
+		if !ok {
+			continue
+		}
+
+		result[object] = append(result[object], path)
+	}
+
+	return result, nil
+}
+
+func parseLFSPointer(contents []byte) (lfsObject, bool) {
+	var object lfsObject
+
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+
+	if !scanner.Scan() || scanner.Text() != lfsPointerVersion {
+		return object, false
+	}
+
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), " ")
+		if !ok {
+			return object, false
+		}
+
+		switch key {
+		case "oid":
+			oid, ok := strings.CutPrefix(value, "sha256:")
+			if !ok || len(oid) != sha256.Size*2 {
+				return object, false
+			}
+			if _, err := hex.DecodeString(oid); err != nil {
+				return object, false
+			}
+			object.OID = oid
+		case "size":
+			size, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || size < 0 {
+				return object, false
+			}
+			object.Size = size
+		}
+	}
+
+	return object, object.OID != ""
+}
+
+func lfsBatch(
+	ctx context.Context,
+	client *http.Client,
+	endpoint string,
+	user *url.Userinfo,
+	objects []lfsObject,
+) (*lfsBatchResponse, error) {
+	body, err := json.Marshal(&lfsBatchRequest{
+		Operation: "download",
+		Transfers: []string{"basic"},
+		Objects:   objects,
+		HashAlgo:  "sha256",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint+"/objects/batch",
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", lfsMediaType)
+	req.Header.Set("Content-Type", lfsMediaType)
+
+	if user != nil {
+		password, _ := user.Password()
+		req.SetBasicAuth(user.Username(), password)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: batch API responded with HTTP %d", ErrLFS, resp.StatusCode)
+	}
+
+	var batchResponse lfsBatchResponse
+
+	if err := json.NewDecoder(resp.Body).Decode(&batchResponse); err != nil {
+		return nil, fmt.Errorf("%w: failed to parse the batch API response: %v", ErrLFS, err)
+	}
+
+	return &batchResponse, nil
+}
+
+func lfsDownload(
+	ctx context.Context,
+	client *http.Client,
+	href string,
+	headers map[string]string,
+	object lfsObject,
+	paths []string,
+) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, href, nil)
+	if err != nil {
+		return err
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: downloading object %s responded with HTTP %d", ErrLFS, object.OID, resp.StatusCode)
+	}
+
+	if err := lfsReplace(paths[0], resp.Body, object); err != nil {
+		return err
+	}
+
+	// The same object may be referenced from multiple paths,
+	// in which case copy it from the first downloaded file
+	for _, path := range paths[1:] {
+		if err := lfsCopy(paths[0], path, object); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func lfsCopy(sourcePath string, path string, object lfsObject) error {
+	source, err := os.Open(sourcePath)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	return lfsReplace(path, source, object)
+}
+
+// lfsReplace atomically replaces the pointer file at path with the object's contents
+// after verifying them.
+func lfsReplace(path string, source io.Reader, object lfsObject) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), ".cirrus-lfs-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	hash := sha256.New()
+
+	n, err := io.Copy(io.MultiWriter(tmpFile, hash), io.LimitReader(source, object.Size+1))
+	if err != nil {
+		_ = tmpFile.Close()
+
+		return err
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+
+	if n != object.Size || hex.EncodeToString(hash.Sum(nil)) != object.OID {
+		return fmt.Errorf("%w: object %s doesn't match its pointer", ErrLFS, object.OID)
+	}
+
+	if err := os.Chmod(tmpFile.Name(), info.Mode().Perm()); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), path)
+}
@@ -0,0 +1,532 @@
+package executor_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/agent/environment"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/require"
+)
+
+const lfsContents = "large file contents\n"
+
+// localOrigin is a bare repository that serves as a remote for the clone tests.
+type localOrigin struct {
+	path       string
+	head       string
+	removedOID string
+}
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=Cirrus", "GIT_AUTHOR_EMAIL=hello@cirruslabs.org",
+		"GIT_COMMITTER_NAME=Cirrus", "GIT_COMMITTER_EMAIL=hello@cirruslabs.org",
+		"GIT_CONFIG_NOSYSTEM=1", "HOME="+dir,
+	)
+
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+
+	return strings.TrimSpace(string(output))
+}
+
+func newLocalOrigin(t *testing.T) *localOrigin {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	work := t.TempDir()
+
+	runGit(t, work, "init", "--initial-branch=main")
+
+	// The first commit contains a file that is later removed,
+	// so that its blob is only reachable from the history
+	require.NoError(t, os.WriteFile(filepath.Join(work, "removed.txt"), []byte("removed\n"), 0600))
+	runGit(t, work, "add", "-A")
+	runGit(t, work, "commit", "-m", "Initial commit")
+	removedOID := runGit(t, work, "rev-parse", "HEAD:removed.txt")
+
+	lfsHash := sha256.Sum256([]byte(lfsContents))
+	files := map[string]string{
+		"README.md":          "# Monorepo\n",
+		"app/main.go":        "package main\n",
+		"app/nested/util.go": "package nested\n",
+		"lib/lib.go":         "package lib\n",
+		"assets/logo.bin": fmt.Sprintf("version https://git-lfs.github.com/spec/v1\noid sha256:%s\nsize %d\n",
+			hex.EncodeToString(lfsHash[:]), len(lfsContents)),
+	}
+	for name, contents := range files {
+		path := filepath.Join(work, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0600))
+	}
+	require.NoError(t, os.Remove(filepath.Join(work, "removed.txt")))
+	runGit(t, work, "add", "-A")
+	runGit(t, work, "commit", "-m", "Add the projects")
+	runGit(t, work, "tag", "-a", "v1.0.0", "-m", "Release")
+
+	origin := filepath.Join(t.TempDir(), "origin.git")
+	runGit(t, work, "clone", "--bare", work, origin)
+	runGit(t, origin, "config", "uploadpack.allowFilter", "true")
+	runGit(t, origin, "config", "uploadpack.allowAnySHA1InWant", "true")
+
+	return &localOrigin{
+		path:       origin,
+		head:       runGit(t, work, "rev-parse", "HEAD"),
+		removedOID: removedOID,
+	}
+}
+
+func (origin *localOrigin) clone(t *testing.T, extraEnv map[string]string) (string, bool) {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	env := map[string]string{
+		"CIRRUS_WORKING_DIR":    dir,
+		"CIRRUS_REPO_CLONE_URL": origin.path,
+		"CIRRUS_CHANGE_IN_REPO": origin.head,
+		"CIRRUS_BRANCH":         "main",
+	}
+	for key, value := range extraEnv {
+		env[key] = value
+	}
+
+	succeeded := executor.CloneRepository(context.Background(), newNewlineNormalizingWriter(os.Stdout),
+		environment.New(env))
+
+	return dir, succeeded
+}
+
+func TestCloneLocalSparse(t *testing.T) {
+	origin := newLocalOrigin(t)
+
+	dir, ok := origin.clone(t, map[string]string{
+		"CIRRUS_CLONE_SPARSE_PATHS": "app, /assets/",
+	})
+	require.True(t, ok)
+
+	require.FileExists(t, filepath.Join(dir, "app", "main.go"))
+	require.FileExists(t, filepath.Join(dir, "app", "nested", "util.go"))
+	require.FileExists(t, filepath.Join(dir, "assets", "logo.bin"))
+	require.NoFileExists(t, filepath.Join(dir, "lib", "lib.go"))
+	require.NoFileExists(t, filepath.Join(dir, "README.md"))
+
+	repo, err := git.PlainOpen(dir)
+	require.NoError(t, err)
+
+	head, err := repo.Head()
+	require.NoError(t, err)
+	require.Equal(t, plumbing.NewBranchReferenceName("main"), head.Name())
+	require.Equal(t, origin.head, head.Hash().String())
+}
+
+func TestCloneLocalSparseTag(t *testing.T) {
+	origin := newLocalOrigin(t)
+
+	dir, ok := origin.clone(t, map[string]string{
+		"CIRRUS_TAG":                "v1.0.0",
+		"CIRRUS_CLONE_SPARSE_PATHS": "lib",
+	})
+	require.True(t, ok)
+
+	require.FileExists(t, filepath.Join(dir, "lib", "lib.go"))
+	require.NoFileExists(t, filepath.Join(dir, "app", "main.go"))
+
+	repo, err := git.PlainOpen(dir)
+	require.NoError(t, err)
+
+	head, err := repo.Head()
+	require.NoError(t, err)
+	require.Equal(t, origin.head, head.Hash().String())
+}
+
+func TestCloneLocalPartial(t *testing.T) {
+	origin := newLocalOrigin(t)
+
+	dir, ok := origin.clone(t, map[string]string{
+		"CIRRUS_CLONE_FILTER": "blob:none",
+	})
+	require.True(t, ok)
+
+	require.FileExists(t, filepath.Join(dir, "README.md"))
+	require.FileExists(t, filepath.Join(dir, "lib", "lib.go"))
+
+	repo, err := git.PlainOpen(dir)
+	require.NoError(t, err)
+
+	// The blob that is only reachable from the history should not be fetched
+	require.Error(t, repo.Storer.HasEncodedObject(plumbing.NewHash(origin.removedOID)))
+
+	// Git should be able to lazily fetch it
+	require.Equal(t, "removed", runGit(t, dir, "show", origin.removedOID))
+}
+
+func TestCloneLocalPartialSparse(t *testing.T) {
+	origin := newLocalOrigin(t)
+
+	dir, ok := origin.clone(t, map[string]string{
+		"CIRRUS_CLONE_FILTER":       "blob:limit=0",
+		"CIRRUS_CLONE_SPARSE_PATHS": "app",
+	})
+	require.True(t, ok)
+
+	require.FileExists(t, filepath.Join(dir, "app", "main.go"))
+	require.NoFileExists(t, filepath.Join(dir, "lib", "lib.go"))
+
+	// Only the blobs from the sparse checkout directories should be fetched
+	repo, err := git.PlainOpen(dir)
+	require.NoError(t, err)
+
+	commit, err := repo.CommitObject(plumbing.NewHash(origin.head))
+	require.NoError(t, err)
+
+	tree, err := commit.Tree()
+	require.NoError(t, err)
+
+	appEntry, err := tree.FindEntry("app/main.go")
+	require.NoError(t, err)
+	require.NoError(t, repo.Storer.HasEncodedObject(appEntry.Hash))
+
+	libEntry, err := tree.FindEntry("lib/lib.go")
+	require.NoError(t, err)
+	require.Error(t, repo.Storer.HasEncodedObject(libEntry.Hash))
+}
+
+func TestCloneLocalInvalidFilter(t *testing.T) {
+	origin := newLocalOrigin(t)
+
+	_, ok := origin.clone(t, map[string]string{
+		"CIRRUS_CLONE_FILTER": "tree:0",
+	})
+	require.False(t, ok)
+}
+
+func TestCloneLocalReference(t *testing.T) {
+	origin := newLocalOrigin(t)
+
+	mirror := filepath.Join(t.TempDir(), "mirror.git")
+	runGit(t, filepath.Dir(mirror), "clone", "--mirror", origin.path, mirror)
+
+	dir, ok := origin.clone(t, map[string]string{
+		"CIRRUS_CLONE_REFERENCE": mirror,
+	})
+	require.True(t, ok)
+
+	require.FileExists(t, filepath.Join(dir, "lib", "lib.go"))
+
+	// The reference repository should leave no traces
+	repo, err := git.PlainOpen(dir)
+	require.NoError(t, err)
+
+	remotes, err := repo.Remotes()
+	require.NoError(t, err)
+	require.Len(t, remotes, 1)
+	require.Equal(t, "origin", remotes[0].Config().Name)
+
+	refs, err := repo.References()
+	require.NoError(t, err)
+	require.NoError(t, refs.ForEach(func(ref *plumbing.Reference) error {
+		require.NotContains(t, ref.Name().String(), "cirrus-reference")
+
+		return nil
+	}))
+
+	// The objects are copied, so removing the reference
+	// repository should not break the clone
+	require.NoError(t, os.RemoveAll(mirror))
+	runGit(t, dir, "fsck", "--connectivity-only")
+}
+
+func TestCloneLocalMissingReference(t *testing.T) {
+	origin := newLocalOrigin(t)
+
+	dir, ok := origin.clone(t, map[string]string{
+		"CIRRUS_CLONE_REFERENCE": filepath.Join(t.TempDir(), "does-not-exist"),
+	})
+	require.True(t, ok)
+
+	require.FileExists(t, filepath.Join(dir, "lib", "lib.go"))
+}
+
+func TestCloneLocalLFS(t *testing.T) {
+	origin := newLocalOrigin(t)
+
+	lfsHash := sha256.Sum256([]byte(lfsContents))
+	oid := hex.EncodeToString(lfsHash[:])
+
+	var server *httptest.Server
+
+	server = httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch request.URL.Path {
+		case "/objects/batch":
+			require.Equal(t, "application/vnd.git-lfs+json", request.Header.Get("Content-Type"))
+
+			var batchRequest struct {
+				Operation string `json:"operation"`
+				Objects   []struct {
+					OID  string `json:"oid"`
+					Size int64  `json:"size"`
+				} `json:"objects"`
+			}
+			require.NoError(t, json.NewDecoder(request.Body).Decode(&batchRequest))
+			require.Equal(t, "download", batchRequest.Operation)
+			require.Len(t, batchRequest.Objects, 1)
+			require.Equal(t, oid, batchRequest.Objects[0].OID)
+
+			writer.Header().Set("Content-Type", "application/vnd.git-lfs+json")
+			_ = json.NewEncoder(writer).Encode(map[string]interface{}{
+				"transfer": "basic",
+				"objects": []map[string]interface{}{
+					{
+						"oid":  oid,
+						"size": len(lfsContents),
+						"actions": map[string]interface{}{
+							"download": map[string]interface{}{
+								"href":   server.URL + "/download/" + oid,
+								"header": map[string]string{"X-Token": "secret"},
+							},
+						},
+					},
+				},
+			})
+		case "/download/" + oid:
+			require.Equal(t, "secret", request.Header.Get("X-Token"))
+
+			_, _ = writer.Write([]byte(lfsContents))
+		default:
+			writer.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	dir, ok := origin.clone(t, map[string]string{
+		"CIRRUS_CLONE_LFS":     "true",
+		"CIRRUS_CLONE_LFS_URL": server.URL,
+	})
+	require.True(t, ok)
+
+	contents, err := os.ReadFile(filepath.Join(dir, "assets", "logo.bin"))
+	require.NoError(t, err)
+	require.Equal(t, lfsContents, string(contents))
+}
+
+func TestCloneLocalLFSRequiresURL(t *testing.T) {
+	origin := newLocalOrigin(t)
+
+	_, ok := origin.clone(t, map[string]string{
+		"CIRRUS_CLONE_LFS": "true",
+	})
+	require.False(t, ok)
+}
+
+// addMergeRequest pushes a GitLab-style merge request that modifies the specified files
+// and then modifies the specified files on the main branch, returning the merge request's head.
+func (origin *localOrigin) addMergeRequest(t *testing.T, mrFiles map[string]string, mainFiles map[string]string) string {
+	t.Helper()
+
+	work := filepath.Join(t.TempDir(), "work")
+	runGit(t, filepath.Dir(work), "clone", origin.path, work)
+
+	commit := func(files map[string]string, message string) string {
+		for name, contents := range files {
+			require.NoError(t, os.WriteFile(filepath.Join(work, filepath.FromSlash(name)), []byte(contents), 0600))
+		}
+		runGit(t, work, "add", "-A")
+		runGit(t, work, "commit", "-m", message)
+
+		return runGit(t, work, "rev-parse", "HEAD")
+	}
+
+	runGit(t, work, "checkout", "-b", "feature")
+	mrHead := commit(mrFiles, "Feature")
+	runGit(t, work, "push", "origin", "feature:refs/merge-requests/1/head")
+
+	runGit(t, work, "checkout", "main")
+	origin.head = commit(mainFiles, "Main")
+	runGit(t, work, "push", "origin", "main")
+
+	return mrHead
+}
+
+func TestCloneLocalGitLabMergeRequest(t *testing.T) {
+	origin := newLocalOrigin(t)
+	mrHead := origin.addMergeRequest(t,
+		map[string]string{"app/main.go": "package main // feature\n"},
+		map[string]string{"lib/lib.go": "package lib // main\n"},
+	)
+
+	dir, ok := origin.clone(t, map[string]string{
+		"CIRRUS_REPO_CLONE_FORGE": "gitlab",
+		"CIRRUS_PR":               "1",
+		"CIRRUS_CHANGE_IN_REPO":   mrHead,
+	})
+	require.True(t, ok)
+
+	contents, err := os.ReadFile(filepath.Join(dir, "app", "main.go"))
+	require.NoError(t, err)
+	require.Equal(t, "package main // feature\n", string(contents))
+
+	contents, err = os.ReadFile(filepath.Join(dir, "lib", "lib.go"))
+	require.NoError(t, err)
+	require.Equal(t, "package lib\n", string(contents))
+}
+
+func TestCloneLocalSyntheticMerge(t *testing.T) {
+	origin := newLocalOrigin(t)
+	mrHead := origin.addMergeRequest(t,
+		map[string]string{"app/main.go": "package main // feature\n"},
+		map[string]string{"lib/lib.go": "package lib // main\n"},
+	)
+
+	dir, ok := origin.clone(t, map[string]string{
+		"CIRRUS_REPO_CLONE_FORGE":    "gitlab",
+		"CIRRUS_PR":                  "1",
+		"CIRRUS_CHANGE_IN_REPO":      mrHead,
+		"CIRRUS_BASE_BRANCH":         "main",
+		"CIRRUS_RESOLUTION_STRATEGY": "MERGE_FOR_PRS",
+	})
+	require.True(t, ok)
+
+	// Both the merge request and the main branch changes should be present
+	contents, err := os.ReadFile(filepath.Join(dir, "app", "main.go"))
+	require.NoError(t, err)
+	require.Equal(t, "package main // feature\n", string(contents))
+
+	contents, err = os.ReadFile(filepath.Join(dir, "lib", "lib.go"))
+	require.NoError(t, err)
+	require.Equal(t, "package lib // main\n", string(contents))
+
+	repo, err := git.PlainOpen(dir)
+	require.NoError(t, err)
+
+	head, err := repo.Head()
+	require.NoError(t, err)
+
+	commit, err := repo.CommitObject(head.Hash())
+	require.NoError(t, err)
+	require.Equal(t, []plumbing.Hash{plumbing.NewHash(origin.head), plumbing.NewHash(mrHead)}, commit.ParentHashes)
+
+	// The merge commit and its trees should be well-formed
+	runGit(t, dir, "fsck", "--strict")
+	require.Empty(t, runGit(t, dir, "status", "--porcelain"))
+}
+
+// pushToMain commits the files to the main branch of the origin.
+func (origin *localOrigin) pushToMain(t *testing.T, files map[string]string) {
+	t.Helper()
+
+	work := filepath.Join(t.TempDir(), "work")
+	runGit(t, filepath.Dir(work), "clone", origin.path, work)
+
+	for name, contents := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(work, filepath.FromSlash(name)), []byte(contents), 0600))
+	}
+	runGit(t, work, "add", "-A")
+	runGit(t, work, "commit", "-m", "Update")
+	runGit(t, work, "push", "origin", "main")
+
+	origin.head = runGit(t, work, "rev-parse", "HEAD")
+}
+
+func TestCloneLocalSyntheticMergeSameFile(t *testing.T) {
+	for _, filter := range []string{"", "blob:none"} {
+		t.Run(fmt.Sprintf("filter=%q", filter), func(t *testing.T) {
+			origin := newLocalOrigin(t)
+			origin.pushToMain(t, map[string]string{
+				"CHANGELOG.md": "# Changelog\n\n## Unreleased\n\n* Initial release\n\n## 1.0.0\n\n* First version\n",
+			})
+			mrHead := origin.addMergeRequest(t,
+				map[string]string{
+					"CHANGELOG.md": "# Changelog\n\n## Unreleased\n\n* Initial release\n\n## 1.0.0\n\n" +
+						"* First version\n* Feature\n",
+				},
+				map[string]string{
+					"CHANGELOG.md": "# Change Log\n\n## Unreleased\n\n* Initial release\n\n## 1.0.0\n\n" +
+						"* First version\n",
+				},
+			)
+
+			env := map[string]string{
+				"CIRRUS_REPO_CLONE_FORGE":    "gitlab",
+				"CIRRUS_PR":                  "1",
+				"CIRRUS_CHANGE_IN_REPO":      mrHead,
+				"CIRRUS_BASE_BRANCH":         "main",
+				"CIRRUS_RESOLUTION_STRATEGY": "MERGE_FOR_PRS",
+			}
+			if filter != "" {
+				env["CIRRUS_CLONE_FILTER"] = filter
+			}
+
+			dir, ok := origin.clone(t, env)
+			require.True(t, ok)
+
+			contents, err := os.ReadFile(filepath.Join(dir, "CHANGELOG.md"))
+			require.NoError(t, err)
+			require.Equal(t, "# Change Log\n\n## Unreleased\n\n* Initial release\n\n## 1.0.0\n\n"+
+				"* First version\n* Feature\n", string(contents))
+
+			// The blobs omitted by the filter are reported as missing by fsck
+			if filter == "" {
+				runGit(t, dir, "fsck", "--strict")
+			}
+			require.Empty(t, runGit(t, dir, "status", "--porcelain"))
+		})
+	}
+}
+
+func TestCloneLocalSyntheticMergeOverlappingChanges(t *testing.T) {
+	origin := newLocalOrigin(t)
+	origin.pushToMain(t, map[string]string{
+		"CHANGELOG.md": "# Changelog\n\n* First version\n",
+	})
+	mrHead := origin.addMergeRequest(t,
+		map[string]string{"CHANGELOG.md": "# Changelog\n\n* First version\n* Feature\n"},
+		map[string]string{"CHANGELOG.md": "# Changelog\n\n* First version\n* Bug fix\n"},
+	)
+
+	_, ok := origin.clone(t, map[string]string{
+		"CIRRUS_REPO_CLONE_FORGE":    "gitlab",
+		"CIRRUS_PR":                  "1",
+		"CIRRUS_CHANGE_IN_REPO":      mrHead,
+		"CIRRUS_BASE_BRANCH":         "main",
+		"CIRRUS_RESOLUTION_STRATEGY": "MERGE_FOR_PRS",
+	})
+	require.False(t, ok)
+}
+
+func TestCloneLocalSyntheticMergeConflict(t *testing.T) {
+	origin := newLocalOrigin(t)
+	mrHead := origin.addMergeRequest(t,
+		map[string]string{"README.md": "# Feature\n"},
+		map[string]string{"README.md": "# Main\n"},
+	)
+
+	_, ok := origin.clone(t, map[string]string{
+		"CIRRUS_REPO_CLONE_FORGE":    "gitlab",
+		"CIRRUS_PR":                  "1",
+		"CIRRUS_CHANGE_IN_REPO":      mrHead,
+		"CIRRUS_BASE_BRANCH":         "main",
+		"CIRRUS_RESOLUTION_STRATEGY": "MERGE_FOR_PRS",
+	})
+	require.False(t, ok)
+}
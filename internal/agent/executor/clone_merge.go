@@ -0,0 +1,538 @@
+package executor
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+var (
+	ErrNoMergeBase   = errors.New("no merge base found")
+	ErrMergeConflict = errors.New("merge conflict")
+)
+
+// syntheticMerge creates a merge commit of the pull request's head into the base
+// for forges that don't provide one, similarly to GitHub's refs/pull/<n>/merge.
+//
+// The merge is first performed on the tree level: each path is taken from the side
+// that changed it relative to the merge base. Only the files changed differently on
+// both sides are then merged line by line, similarly to "git merge", so the contents
+// of the other files are never read and fetchBlobs is only called for the blobs of
+// the files that need to be merged and are missing due to a partial clone.
+func syntheticMerge(
+	repo *git.Repository,
+	base plumbing.Hash,
+	head plumbing.Hash,
+	fetchBlobs func(hashes []plumbing.Hash) error,
+) (plumbing.Hash, error) {
+	baseCommit, err := repo.CommitObject(base)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	headCommit, err := repo.CommitObject(head)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	mergeBases, err := baseCommit.MergeBase(headCommit)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	if len(mergeBases) == 0 {
+		return plumbing.ZeroHash, fmt.Errorf("%w between %s and %s, is the clone depth too small?",
+			ErrNoMergeBase, base, head)
+	}
+
+	ancestorEntries, err := flattenTree(mergeBases[0])
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	baseEntries, err := flattenTree(baseCommit)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	headEntries, err := flattenTree(headCommit)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	merged, changedOnBothSides := mergeEntries(ancestorEntries, baseEntries, headEntries)
+
+	if fetchBlobs != nil {
+		if err := fetchMissingMergeBlobs(repo, changedOnBothSides, ancestorEntries, baseEntries, headEntries,
+			fetchBlobs); err != nil {
+			return plumbing.ZeroHash, err
+		}
+	}
+
+	var conflicts []string
+
+	for _, name := range changedOnBothSides {
+		ancestorEntry, inAncestor := ancestorEntries[name]
+		if !inAncestor {
+			ancestorEntry = object.TreeEntry{}
+		}
+
+		entry, ok, err := mergeFile(repo, ancestorEntry, baseEntries[name], headEntries[name])
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+		if !ok {
+			conflicts = append(conflicts, name)
+
+			continue
+		}
+
+		merged[name] = entry
+	}
+
+	conflicts = append(conflicts, fileDirectoryConflicts(merged)...)
+	sort.Strings(conflicts)
+
+	if len(conflicts) != 0 {
+		return plumbing.ZeroHash, fmt.Errorf("%w in %s", ErrMergeConflict, strings.Join(conflicts, ", "))
+	}
+
+	treeHash, err := writeTree(repo, merged)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	signature := object.Signature{
+		Name:  "Cirrus CI",
+		Email: "hello@cirruslabs.org",
+		When:  time.Now(),
+	}
+
+	commit := &object.Commit{
+		Author:       signature,
+		Committer:    signature,
+		Message:      fmt.Sprintf("Merge %s into %s\n", head, base),
+		TreeHash:     treeHash,
+		ParentHashes: []plumbing.Hash{base, head},
+	}
+
+	return storeObject(repo, commit)
+}
+
+func flattenTree(commit *object.Commit) (map[string]object.TreeEntry, error) {
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	walker := object.NewTreeWalker(tree, true, nil)
+	defer walker.Close()
+
+	result := map[string]object.TreeEntry{}
+
+	for {
+		name, entry, err := walker.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if entry.Mode == filemode.Dir {
+			continue
+		}
+
+		result[name] = entry
+	}
+
+	return result, nil
+}
+
+// mergeEntries merges the paths changed by at most one of the sides relative to
+// the ancestor and returns the paths that were changed differently on both sides.
+func mergeEntries(
+	ancestor map[string]object.TreeEntry,
+	ours map[string]object.TreeEntry,
+	theirs map[string]object.TreeEntry,
+) (map[string]object.TreeEntry, []string) {
+	paths := map[string]struct{}{}
+	for _, entries := range []map[string]object.TreeEntry{ancestor, ours, theirs} {
+		for name := range entries {
+			paths[name] = struct{}{}
+		}
+	}
+
+	merged := map[string]object.TreeEntry{}
+
+	var changedOnBothSides []string
+
+	for name := range paths {
+		ancestorEntry, inAncestor := ancestor[name]
+		ourEntry, inOurs := ours[name]
+		theirEntry, inTheirs := theirs[name]
+
+		sameEntry := func(a object.TreeEntry, aExists bool, b object.TreeEntry, bExists bool) bool {
+			return aExists == bExists && a.Hash == b.Hash && a.Mode == b.Mode
+		}
+
+		var entry object.TreeEntry
+		var exists bool
+
+		switch {
+		case sameEntry(ourEntry, inOurs, theirEntry, inTheirs):
+			entry, exists = ourEntry, inOurs
+		case sameEntry(ancestorEntry, inAncestor, ourEntry, inOurs):
+			entry, exists = theirEntry, inTheirs
+		case sameEntry(ancestorEntry, inAncestor, theirEntry, inTheirs):
+			entry, exists = ourEntry, inOurs
+		default:
+			changedOnBothSides = append(changedOnBothSides, name)
+
+			continue
+		}
+
+		if exists {
+			merged[name] = entry
+		}
+	}
+
+	sort.Strings(changedOnBothSides)
+
+	return merged, changedOnBothSides
+}
+
+// fileDirectoryConflicts returns the paths that are both a file and a directory.
+func fileDirectoryConflicts(merged map[string]object.TreeEntry) []string {
+	conflicts := map[string]struct{}{}
+
+	for name := range merged {
+		for dir := path.Dir(name); dir != "."; dir = path.Dir(dir) {
+			if _, ok := merged[dir]; ok {
+				conflicts[dir] = struct{}{}
+			}
+		}
+	}
+
+	result := make([]string, 0, len(conflicts))
+	for name := range conflicts {
+		result = append(result, name)
+	}
+
+	return result
+}
+
+func fetchMissingMergeBlobs(
+	repo *git.Repository,
+	names []string,
+	ancestor map[string]object.TreeEntry,
+	ours map[string]object.TreeEntry,
+	theirs map[string]object.TreeEntry,
+	fetchBlobs func(hashes []plumbing.Hash) error,
+) error {
+	var missing []plumbing.Hash
+
+	for _, name := range names {
+		for _, entries := range []map[string]object.TreeEntry{ancestor, ours, theirs} {
+			entry, ok := entries[name]
+			if !ok || !entry.Mode.IsFile() {
+				continue
+			}
+
+			if repo.Storer.HasEncodedObject(entry.Hash) != nil {
+				missing = append(missing, entry.Hash)
+			}
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	return fetchBlobs(missing)
+}
+
+// mergeFile merges the contents of a regular file changed on both sides, returning
+// false when the changes overlap or the file can't be merged line by line (e.g. it's
+// binary, a symbolic link or was removed on one of the sides). The ancestor entry
+// has a zero hash when the file was added on both sides.
+func mergeFile(
+	repo *git.Repository,
+	ancestor object.TreeEntry,
+	ours object.TreeEntry,
+	theirs object.TreeEntry,
+) (object.TreeEntry, bool, error) {
+	isRegular := func(mode filemode.FileMode) bool {
+		return mode == filemode.Regular || mode == filemode.Executable
+	}
+
+	if !isRegular(ours.Mode) || !isRegular(theirs.Mode) {
+		return object.TreeEntry{}, false, nil
+	}
+	if !ancestor.Hash.IsZero() && !isRegular(ancestor.Mode) {
+		return object.TreeEntry{}, false, nil
+	}
+
+	// The executable bit is merged separately from the contents
+	mode := ours.Mode
+	if ours.Mode != theirs.Mode {
+		switch {
+		case !ancestor.Hash.IsZero() && ancestor.Mode == ours.Mode:
+			mode = theirs.Mode
+		case !ancestor.Hash.IsZero() && ancestor.Mode == theirs.Mode:
+			mode = ours.Mode
+		default:
+			return object.TreeEntry{}, false, nil
+		}
+	}
+
+	var ancestorContents []byte
+
+	if !ancestor.Hash.IsZero() {
+		var err error
+
+		ancestorContents, err = blobContents(repo, ancestor.Hash)
+		if err != nil {
+			return object.TreeEntry{}, false, err
+		}
+	}
+
+	ourContents, err := blobContents(repo, ours.Hash)
+	if err != nil {
+		return object.TreeEntry{}, false, err
+	}
+
+	theirContents, err := blobContents(repo, theirs.Hash)
+	if err != nil {
+		return object.TreeEntry{}, false, err
+	}
+
+	for _, contents := range [][]byte{ancestorContents, ourContents, theirContents} {
+		if bytes.IndexByte(contents, 0) != -1 {
+			return object.TreeEntry{}, false, nil
+		}
+	}
+
+	mergedLines, ok := mergeLines(splitLines(ancestorContents), splitLines(ourContents), splitLines(theirContents))
+	if !ok {
+		return object.TreeEntry{}, false, nil
+	}
+
+	blob := repo.Storer.NewEncodedObject()
+	blob.SetType(plumbing.BlobObject)
+
+	writer, err := blob.Writer()
+	if err != nil {
+		return object.TreeEntry{}, false, err
+	}
+
+	if _, err := io.WriteString(writer, strings.Join(mergedLines, "")); err != nil {
+		return object.TreeEntry{}, false, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return object.TreeEntry{}, false, err
+	}
+
+	hash, err := repo.Storer.SetEncodedObject(blob)
+	if err != nil {
+		return object.TreeEntry{}, false, err
+	}
+
+	return object.TreeEntry{Mode: mode, Hash: hash}, true, nil
+}
+
+func blobContents(repo *git.Repository, hash plumbing.Hash) ([]byte, error) {
+	blob, err := repo.BlobObject(hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read blob %s: %w", hash, err)
+	}
+
+	reader, err := blob.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return io.ReadAll(reader)
+}
+
+// splitLines splits the contents into lines, keeping the line terminators
+// so that the lack of the trailing newline is preserved.
+func splitLines(contents []byte) []string {
+	lines := strings.SplitAfter(string(contents), "\n")
+
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// mergeLines performs a diff3 merge: the ancestor is matched against both sides and
+// the regions between the lines that match on all three are taken from the side that
+// changed them. Regions changed differently on both sides are conflicting, which
+// includes the adjacent changes, similarly to "git merge".
+func mergeLines(ancestor []string, ours []string, theirs []string) ([]string, bool) {
+	ourMatches := matchLines(ancestor, ours)
+	theirMatches := matchLines(ancestor, theirs)
+
+	var result []string
+
+	ancestorPos, ourPos, theirPos := 0, 0, 0
+
+	for {
+		// Copy the lines that are unchanged on both sides
+		for ancestorPos < len(ancestor) && ourMatches[ancestorPos] == ourPos && theirMatches[ancestorPos] == theirPos {
+			result = append(result, ancestor[ancestorPos])
+			ancestorPos++
+			ourPos++
+			theirPos++
+		}
+
+		if ancestorPos == len(ancestor) && ourPos == len(ours) && theirPos == len(theirs) {
+			return result, true
+		}
+
+		// Find the end of the changed region, which is the next
+		// ancestor line that is still present on both sides
+		nextAncestorPos := ancestorPos
+		for nextAncestorPos < len(ancestor) && (ourMatches[nextAncestorPos] == -1 ||
+			theirMatches[nextAncestorPos] == -1) {
+			nextAncestorPos++
+		}
+
+		nextOurPos, nextTheirPos := len(ours), len(theirs)
+		if nextAncestorPos < len(ancestor) {
+			nextOurPos, nextTheirPos = ourMatches[nextAncestorPos], theirMatches[nextAncestorPos]
+		}
+
+		ancestorRegion := ancestor[ancestorPos:nextAncestorPos]
+		ourRegion := ours[ourPos:nextOurPos]
+		theirRegion := theirs[theirPos:nextTheirPos]
+
+		switch {
+		case slices.Equal(ancestorRegion, ourRegion):
+			result = append(result, theirRegion...)
+		case slices.Equal(ancestorRegion, theirRegion), slices.Equal(ourRegion, theirRegion):
+			result = append(result, ourRegion...)
+		default:
+			return nil, false
+		}
+
+		ancestorPos, ourPos, theirPos = nextAncestorPos, nextOurPos, nextTheirPos
+	}
+}
+
+// matchLines returns the position of each of the ancestor's lines
+// in the other version of the file or -1 if it's not present there.
+func matchLines(ancestor []string, other []string) []int {
+	result := make([]int, len(ancestor))
+	for i := range result {
+		result[i] = -1
+	}
+
+	matcher := difflib.NewMatcherWithJunk(ancestor, other, false, nil)
+
+	for _, block := range matcher.GetMatchingBlocks() {
+		for i := 0; i < block.Size; i++ {
+			result[block.A+i] = block.B + i
+		}
+	}
+
+	return result
+}
+
+// writeTree stores the tree objects for the flat list of entries and returns the root tree's hash.
+func writeTree(repo *git.Repository, entries map[string]object.TreeEntry) (plumbing.Hash, error) {
+	children := map[string][]object.TreeEntry{}
+	dirs := map[string]struct{}{".": {}}
+
+	for name, entry := range entries {
+		dir, base := path.Split(name)
+		dir = path.Clean(dir)
+
+		entry.Name = base
+		children[dir] = append(children[dir], entry)
+
+		for ; dir != "."; dir = path.Dir(dir) {
+			dirs[dir] = struct{}{}
+		}
+	}
+
+	// Write the deepest directories first so that
+	// their hashes are known when writing the parents
+	sortedDirs := make([]string, 0, len(dirs))
+	for dir := range dirs {
+		sortedDirs = append(sortedDirs, dir)
+	}
+	sort.Slice(sortedDirs, func(i, j int) bool {
+		return pathDepth(sortedDirs[i]) > pathDepth(sortedDirs[j])
+	})
+
+	for _, dir := range sortedDirs {
+		treeEntries := children[dir]
+
+		// Git orders the tree entries as if the directory names had a trailing slash
+		sort.Slice(treeEntries, func(i, j int) bool {
+			return treeEntrySortKey(treeEntries[i]) < treeEntrySortKey(treeEntries[j])
+		})
+
+		hash, err := storeObject(repo, &object.Tree{Entries: treeEntries})
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+
+		if dir == "." {
+			return hash, nil
+		}
+
+		parent := path.Dir(dir)
+		children[parent] = append(children[parent], object.TreeEntry{
+			Name: path.Base(dir),
+			Mode: filemode.Dir,
+			Hash: hash,
+		})
+	}
+
+	return plumbing.ZeroHash, fmt.Errorf("no root tree was written")
+}
+
+func pathDepth(dir string) int {
+	if dir == "." {
+		return 0
+	}
+
+	return strings.Count(dir, "/") + 1
+}
+
+func treeEntrySortKey(entry object.TreeEntry) string {
+	if entry.Mode == filemode.Dir {
+		return entry.Name + "/"
+	}
+
+	return entry.Name
+}
+
+func storeObject(repo *git.Repository, obj interface {
+	Encode(plumbing.EncodedObject) error
+}) (plumbing.Hash, error) {
+	encodedObject := repo.Storer.NewEncodedObject()
+
+	if err := obj.Encode(encodedObject); err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	return repo.Storer.SetEncodedObject(encodedObject)
+}
@@ -0,0 +1,364 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/format/packfile"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/protocol/packp"
+	"github.com/go-git/go-git/v5/plumbing/protocol/packp/capability"
+	"github.com/go-git/go-git/v5/plumbing/protocol/packp/sideband"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	gitclient "github.com/go-git/go-git/v5/plumbing/transport/client"
+)
+
+var ErrPartialCloneUnsupported = errors.New("the Git server doesn't support partial clones")
+
+// uploadPackRequest describes a single round of the upload-pack protocol.
+type uploadPackRequest struct {
+	wants    []plumbing.Hash
+	haves    []plumbing.Hash
+	filter   packp.Filter
+	depth    int
+	progress io.Writer
+}
+
+// fetchFiltered is an equivalent of the repo.FetchContext() that supports partial clone filters,
+// which go-git does not expose through its high-level API.
+func fetchFiltered(
+	ctx context.Context,
+	repo *git.Repository,
+	cloneURL string,
+	filter packp.Filter,
+	fetchOptions *git.FetchOptions,
+) error {
+	session, err := newUploadPackSession(cloneURL)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	advRefs, err := session.AdvertisedReferencesContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !advRefs.Capabilities.Supports(capability.Filter) {
+		return ErrPartialCloneUnsupported
+	}
+
+	remoteRefs, err := advRefs.AllReferences()
+	if err != nil {
+		return err
+	}
+
+	// Map the remote references to the local ones
+	updates := map[plumbing.ReferenceName]plumbing.Hash{}
+
+	for _, refSpec := range fetchOptions.RefSpecs {
+		var matched bool
+
+		for name, ref := range remoteRefs {
+			if ref.Type() != plumbing.HashReference || !refSpec.Match(name) {
+				continue
+			}
+
+			updates[refSpec.Dst(name)] = ref.Hash()
+			matched = true
+		}
+
+		if !matched {
+			return git.NoMatchingRefSpecError{}
+		}
+	}
+
+	if fetchOptions.Tags == git.AllTags {
+		for name, ref := range remoteRefs {
+			if ref.Type() == plumbing.HashReference && name.IsTag() {
+				updates[name] = ref.Hash()
+			}
+		}
+	}
+
+	var wants []plumbing.Hash
+
+	for _, hash := range updates {
+		if repo.Storer.HasEncodedObject(hash) != nil {
+			wants = append(wants, hash)
+		}
+	}
+
+	haves, err := localHaves(repo)
+	if err != nil {
+		return err
+	}
+
+	if err := uploadPack(ctx, repo, session, advRefs.Capabilities, &uploadPackRequest{
+		wants:    wants,
+		haves:    haves,
+		filter:   filter,
+		depth:    fetchOptions.Depth,
+		progress: fetchOptions.Progress,
+	}); err != nil {
+		return err
+	}
+
+	for name, hash := range updates {
+		if err := repo.Storer.SetReference(plumbing.NewHashReference(name, hash)); err != nil {
+			return err
+		}
+	}
+
+	return markAsPartialClone(repo, fetchOptions.RemoteName, filter)
+}
+
+// fetchObjects fetches the specified objects (and everything reachable from them) by their hashes,
+// which requires the server to allow this (e.g. via "uploadpack.allowAnySHA1InWant").
+func fetchObjects(
+	ctx context.Context,
+	repo *git.Repository,
+	cloneURL string,
+	hashes []plumbing.Hash,
+	progress io.Writer,
+) error {
+	session, err := newUploadPackSession(cloneURL)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	advRefs, err := session.AdvertisedReferencesContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Don't advertise any haves, otherwise the server will assume
+	// that we already have everything reachable from them
+	return uploadPack(ctx, repo, session, advRefs.Capabilities, &uploadPackRequest{
+		wants:    hashes,
+		progress: progress,
+	})
+}
+
+// missingBlobs returns the blobs of the commit's tree that are
+// checked out into the working tree, but not present locally.
+func missingBlobs(repo *git.Repository, hash plumbing.Hash, sparseDirectories []string) ([]plumbing.Hash, error) {
+	commit, err := repo.CommitObject(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	walker := object.NewTreeWalker(tree, true, nil)
+	defer walker.Close()
+
+	seen := map[plumbing.Hash]struct{}{}
+
+	var result []plumbing.Hash
+
+	for {
+		name, entry, err := walker.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !entry.Mode.IsFile() || !inSparseDirectories(name, sparseDirectories) {
+			continue
+		}
+
+		if _, ok := seen[entry.Hash]; ok {
+			continue
+		}
+		seen[entry.Hash] = struct{}{}
+
+		if repo.Storer.HasEncodedObject(entry.Hash) != nil {
+			result = append(result, entry.Hash)
+		}
+	}
+
+	return result, nil
+}
+
+func inSparseDirectories(name string, sparseDirectories []string) bool {
+	if len(sparseDirectories) == 0 {
+		return true
+	}
+
+	for _, sparseDirectory := range sparseDirectories {
+		if strings.HasPrefix(name, sparseDirectory) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newUploadPackSession(cloneURL string) (transport.UploadPackSession, error) {
+	endpoint, err := transport.NewEndpoint(cloneURL)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := gitclient.NewClient(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authentication is taken from the endpoint's user information
+	return client.NewUploadPackSession(endpoint, nil)
+}
+
+func localHaves(repo *git.Repository) ([]plumbing.Hash, error) {
+	refs, err := repo.References()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[plumbing.Hash]struct{}{}
+
+	var result []plumbing.Hash
+
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Type() != plumbing.HashReference {
+			return nil
+		}
+
+		if _, ok := seen[ref.Hash()]; ok {
+			return nil
+		}
+		seen[ref.Hash()] = struct{}{}
+
+		result = append(result, ref.Hash())
+
+		return nil
+	})
+
+	return result, err
+}
+
+func uploadPack(
+	ctx context.Context,
+	repo *git.Repository,
+	session transport.UploadPackSession,
+	advertised *capability.List,
+	request *uploadPackRequest,
+) (err error) {
+	if len(request.wants) == 0 {
+		return nil
+	}
+
+	req := packp.NewUploadPackRequestFromCapabilities(advertised)
+	req.Wants = request.wants
+	req.Haves = request.haves
+
+	if request.filter != "" {
+		if err := req.Capabilities.Set(capability.Filter); err != nil {
+			return err
+		}
+		req.Filter = request.filter
+	}
+
+	if request.depth > 0 {
+		if err := req.Capabilities.Set(capability.Shallow); err != nil {
+			return err
+		}
+		req.Depth = packp.DepthCommits(request.depth)
+
+		req.Shallows, err = repo.Storer.Shallow()
+		if err != nil {
+			return err
+		}
+	}
+
+	resp, err := session.UploadPack(ctx, req)
+	if err != nil {
+		if errors.Is(err, transport.ErrEmptyUploadPackRequest) {
+			return nil
+		}
+
+		return err
+	}
+	defer func() {
+		if closeErr := resp.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+
+	if request.depth > 0 && len(resp.Shallows) != 0 {
+		if err := repo.Storer.SetShallow(mergeShallows(req.Shallows, resp.Shallows)); err != nil {
+			return err
+		}
+	}
+
+	var reader io.Reader = resp
+
+	switch {
+	case req.Capabilities.Supports(capability.Sideband64k):
+		demuxer := sideband.NewDemuxer(sideband.Sideband64k, resp)
+		demuxer.Progress = request.progress
+		reader = demuxer
+	case req.Capabilities.Supports(capability.Sideband):
+		demuxer := sideband.NewDemuxer(sideband.Sideband, resp)
+		demuxer.Progress = request.progress
+		reader = demuxer
+	}
+
+	return packfile.UpdateObjectStorage(repo.Storer, reader)
+}
+
+func mergeShallows(existing []plumbing.Hash, received []plumbing.Hash) []plumbing.Hash {
+	result := append([]plumbing.Hash{}, existing...)
+
+	for _, hash := range received {
+		var found bool
+
+		for _, existingHash := range existing {
+			if existingHash == hash {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			result = append(result, hash)
+		}
+	}
+
+	return result
+}
+
+// markAsPartialClone configures the remote as a promisor, so that Git commands
+// run later in the task lazily fetch the omitted objects.
+//
+// Unlike "git clone --filter" we don't set the "extensions.partialClone",
+// because go-git refuses to open repositories with unknown extensions.
+func markAsPartialClone(repo *git.Repository, remoteName string, filter packp.Filter) error {
+	cfg, err := repo.Config()
+	if err != nil {
+		return err
+	}
+
+	cfg.Raw.Section("remote").Subsection(remoteName).
+		SetOption("promisor", "true").
+		SetOption("partialclonefilter", string(filter))
+
+	if err := repo.SetConfig(cfg); err != nil {
+		return fmt.Errorf("failed to mark the repository as a partial clone: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,270 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/cirruslabs/cirrus-cli/internal/agent/environment"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/protocol/packp"
+)
+
+var ErrInvalidCloneSettings = errors.New("invalid clone settings")
+
+const (
+	referenceRemoteName = "cirrus-reference"
+	referenceRefsPrefix = "refs/cirrus-reference/"
+)
+
+var blobLimitFilterRegex = regexp.MustCompile(`^blob:limit=\d+[kmg]?$`)
+
+// cloneSettings holds the knobs configured through the CIRRUS_CLONE_* variables
+// that go beyond a plain "git clone".
+type cloneSettings struct {
+	// sparseDirectories are the only directories checked out
+	// into the working tree, each with a trailing slash
+	sparseDirectories []string
+
+	// filter is a partial clone filter such as "blob:none"
+	filter packp.Filter
+
+	// reference is a path to a local repository or a mirror
+	// that is used to seed the objects before fetching
+	reference string
+
+	lfs    bool
+	lfsURL string
+}
+
+func newCloneSettings(env *environment.Environment, cloneURL string) (*cloneSettings, error) {
+	settings := &cloneSettings{
+		reference: strings.TrimSpace(env.Get("CIRRUS_CLONE_REFERENCE")),
+		lfs:       env.Get("CIRRUS_CLONE_LFS") == "true",
+	}
+
+	sparseDirectories, err := parseSparseDirectories(env.Get("CIRRUS_CLONE_SPARSE_PATHS"))
+	if err != nil {
+		return nil, err
+	}
+	settings.sparseDirectories = sparseDirectories
+
+	if filter := strings.TrimSpace(env.Get("CIRRUS_CLONE_FILTER")); filter != "" {
+		if filter != string(packp.FilterBlobNone()) && !blobLimitFilterRegex.MatchString(filter) {
+			return nil, fmt.Errorf("%w: unsupported CIRRUS_CLONE_FILTER %q, only \"blob:none\" "+
+				"and \"blob:limit=<n>[kmg]\" are supported", ErrInvalidCloneSettings, filter)
+		}
+		settings.filter = packp.Filter(filter)
+	}
+
+	if settings.lfs {
+		settings.lfsURL, err = lfsEndpoint(env.Get("CIRRUS_CLONE_LFS_URL"), cloneURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return settings, nil
+}
+
+// parseSparseDirectories splits a list of directories separated by whitespace
+// or commas and normalizes them to the form expected by go-git's sparse checkout.
+func parseSparseDirectories(raw string) ([]string, error) {
+	var result []string
+
+	fields := strings.FieldsFunc(raw, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+	})
+
+	for _, field := range fields {
+		dir := path.Clean(strings.TrimPrefix(field, "/"))
+
+		if dir == "." || dir == ".." || strings.HasPrefix(dir, "../") {
+			return nil, fmt.Errorf("%w: invalid sparse checkout path %q", ErrInvalidCloneSettings, field)
+		}
+
+		result = append(result, dir+"/")
+	}
+
+	return result, nil
+}
+
+func lfsEndpoint(explicitURL string, cloneURL string) (string, error) {
+	if explicitURL != "" {
+		return strings.TrimSuffix(explicitURL, "/"), nil
+	}
+
+	parsedURL, err := url.Parse(cloneURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+		return "", fmt.Errorf("%w: CIRRUS_CLONE_LFS_URL needs to be set when cloning over a non-HTTP(S) URL",
+			ErrInvalidCloneSettings)
+	}
+
+	parsedURL.Path = strings.TrimSuffix(parsedURL.Path, "/")
+	if !strings.HasSuffix(parsedURL.Path, ".git") {
+		parsedURL.Path += ".git"
+	}
+	parsedURL.Path += "/info/lfs"
+
+	return parsedURL.String(), nil
+}
+
+// requiresFetch returns true when the repository cannot
+// be simply cloned and needs to be initialized and fetched instead.
+func (settings *cloneSettings) requiresFetch() bool {
+	return len(settings.sparseDirectories) != 0 || settings.filter != "" || settings.reference != ""
+}
+
+func (settings *cloneSettings) describe(logUploader io.Writer) {
+	if len(settings.sparseDirectories) != 0 {
+		logUploader.Write([]byte(fmt.Sprintf("\nUsing sparse checkout of %s!",
+			strings.Join(settings.sparseDirectories, ", "))))
+	}
+	if settings.filter != "" {
+		logUploader.Write([]byte(fmt.Sprintf("\nUsing partial clone with %s filter!", settings.filter)))
+	}
+	if settings.reference != "" {
+		logUploader.Write([]byte(fmt.Sprintf("\nUsing %s as a reference repository!", settings.reference)))
+	}
+	if settings.lfs {
+		logUploader.Write([]byte("\nGit LFS objects will be fetched!"))
+	}
+}
+
+// fetch fetches from the origin, using a partial clone filter when configured.
+func (settings *cloneSettings) fetch(
+	ctx context.Context,
+	logUploader io.Writer,
+	repo *git.Repository,
+	cloneURL string,
+	fetchOptions *git.FetchOptions,
+) error {
+	if settings.filter != "" {
+		return fetchFiltered(ctx, repo, cloneURL, settings.filter, fetchOptions)
+	}
+
+	if err := repo.FetchContext(ctx, fetchOptions); err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return err
+	}
+
+	return nil
+}
+
+// fetchMissingBlobs fetches the blobs that were omitted by the partial clone filter
+// and are needed to check out the commit, it's a no-op for non-partial clones.
+func (settings *cloneSettings) fetchMissingBlobs(
+	ctx context.Context,
+	logUploader io.Writer,
+	repo *git.Repository,
+	cloneURL string,
+	hash plumbing.Hash,
+) bool {
+	if settings.filter == "" {
+		return true
+	}
+
+	missing, err := missingBlobs(repo, hash, settings.sparseDirectories)
+	if err != nil {
+		logUploader.Write([]byte(fmt.Sprintf("\nFailed to determine missing blobs for %s: %s!", hash, err)))
+		return false
+	}
+	if len(missing) == 0 {
+		return true
+	}
+
+	logUploader.Write([]byte(fmt.Sprintf("\nFetching %d blobs omitted by the filter...\n", len(missing))))
+
+	if err := fetchObjects(ctx, repo, cloneURL, missing, logUploader); err != nil {
+		// Not all servers allow fetching objects by hash,
+		// so fall back to fetching the commit without a filter
+		logUploader.Write([]byte(fmt.Sprintf("\nFailed to fetch blobs: %s! "+
+			"Fetching %s without a filter...\n", err, hash)))
+
+		if err := fetchObjects(ctx, repo, cloneURL, []plumbing.Hash{hash}, logUploader); err != nil {
+			logUploader.Write([]byte(fmt.Sprintf("\nFailed to fetch %s: %s!", hash, err)))
+			return false
+		}
+	}
+
+	return true
+}
+
+// seedFromReference fetches all objects from a local reference repository so that
+// the subsequent fetch from the origin only needs to transfer the missing ones.
+//
+// Similarly to "git clone --reference --dissociate", the objects are copied,
+// so the returned function only needs to clean up the temporary references.
+func seedFromReference(
+	ctx context.Context,
+	logUploader io.Writer,
+	repo *git.Repository,
+	reference string,
+) func() {
+	cleanup := func() {
+		_ = repo.DeleteRemote(referenceRemoteName)
+
+		refs, err := repo.References()
+		if err != nil {
+			return
+		}
+		_ = refs.ForEach(func(ref *plumbing.Reference) error {
+			if strings.HasPrefix(ref.Name().String(), referenceRefsPrefix) {
+				_ = repo.Storer.RemoveReference(ref.Name())
+			}
+
+			return nil
+		})
+	}
+
+	remote, err := repo.CreateRemote(&config.RemoteConfig{
+		Name: referenceRemoteName,
+		URLs: []string{reference},
+	})
+	if err != nil {
+		logUploader.Write([]byte(fmt.Sprintf("\nFailed to use %s as a reference repository: %s!",
+			reference, err)))
+
+		return cleanup
+	}
+
+	logUploader.Write([]byte(fmt.Sprintf("\nFetching objects from the reference repository %s...\n", reference)))
+
+	err = remote.FetchContext(ctx, &git.FetchOptions{
+		RemoteName: referenceRemoteName,
+		RefSpecs:   []config.RefSpec{config.RefSpec("+refs/*:" + referenceRefsPrefix + "*")},
+		Tags:       git.NoTags,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		logUploader.Write([]byte(fmt.Sprintf("\nFailed to fetch from the reference repository %s: %s! "+
+			"Continuing without it...", reference, err)))
+	}
+
+	return cleanup
+}
+
+// resolveCommit resolves a reference to a commit hash, peeling annotated tags.
+func resolveCommit(repo *git.Repository, name plumbing.ReferenceName) (plumbing.Hash, error) {
+	ref, err := repo.Reference(name, true)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	tag, err := repo.TagObject(ref.Hash())
+	if err == nil {
+		commit, err := tag.Commit()
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+
+		return commit.Hash, nil
+	}
+
+	return ref.Hash(), nil
+}
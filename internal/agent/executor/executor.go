@@ -21,6 +21,7 @@ import (
 	"github.com/cirruslabs/cirrus-cli/internal/agent/environment"
 	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics"
 	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/terminalwrapper"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/unboxer"
 	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/updatebatcher"
 	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/vaultunboxer"
 	agentstorage "github.com/cirruslabs/cirrus-cli/internal/agent/storage"
@@ -54,6 +55,7 @@ type Executor struct {
 type StepResult struct {
 	Success        bool
 	SignaledToExit bool
+	OOMKilled      bool
 	Duration       time.Duration
 }
 
@@ -140,47 +142,23 @@ func (executor *Executor) RunBuild(ctx context.Context) {
 	//   SERVER_FQDN: "${HOSTNAME}.local"
 	scriptEnvironment := getScriptEnvironment(executor, response.Environment)
 
-	// However, expand the environment just for the Vault-unboxer, so that
+	// However, expand the environment just for the unboxers, so that
 	// things like "CIRRUS_VAULT_URL: ${CIRRUS_VAULT_URL_GLOBAL}" and
 	// "PASSWORD: VAULT[$PATH $ARGS]" would work.
-	vaultUnboxerEnv := environment.New(scriptEnvironment)
+	unboxerEnv := environment.New(scriptEnvironment)
 
-	slog.Info("Unboxing VAULT[...] environment variables, if any")
+	slog.Info("Unboxing VAULT[...] and other boxed environment variables, if any")
 
-	var vaultUnboxer *vaultunboxer.VaultUnboxer
+	unboxers := unboxer.NewDefaultRegistry(unboxerEnv)
 
-	for key, value := range vaultUnboxerEnv.Items() {
-		boxedValue, err := vaultunboxer.NewBoxedValue(value)
+	for key, value := range unboxerEnv.Items() {
+		unboxedValue, err := unboxers.Unbox(ctx, value)
 		if err != nil {
 			if errors.Is(err, vaultunboxer.ErrNotABoxedValue) {
 				continue
 			}
 
-			message := fmt.Sprintf("failed to parse a Vault-boxed value %s: %v", value, err)
-			slog.Error(message)
-			executor.reportError(message)
-
-			return
-		}
-
-		if vaultUnboxer == nil {
-			slog.Info("Found at least one VAULT[...] environment variable, initializing Vault client")
-
-			vaultUnboxer, err = vaultunboxer.NewFromEnvironment(ctx, vaultUnboxerEnv)
-			if err != nil {
-				message := fmt.Sprintf("failed to initialize a Vault client: %v", err)
-				slog.Error(message)
-				executor.reportError(message)
-
-				return
-			}
-
-			slog.Info("Vault client successfully initialized")
-		}
-
-		unboxedValue, err := vaultUnboxer.Unbox(ctx, boxedValue)
-		if err != nil {
-			message := fmt.Sprintf("failed to unbox a Vault-boxed value %s: %v", value, err)
+			message := err.Error()
 			slog.Error(message)
 			executor.reportError(message)
 
@@ -350,6 +328,7 @@ func (executor *Executor) RunBuild(ctx context.Context) {
 			Status:          currentCommandStatus,
 			DurationInNanos: stepResult.Duration.Nanoseconds(),
 			SignaledToExit:  stepResult.SignaledToExit,
+			OomKilled:       stepResult.OOMKilled,
 		})
 	}
 
@@ -379,6 +358,7 @@ func (executor *Executor) RunBuild(ctx context.Context) {
 			slog.Info("Received metrics",
 				"cpu_points", len(metricsResult.ResourceUtilization.CpuChart),
 				"memory_points", len(metricsResult.ResourceUtilization.MemoryChart),
+				"commands", len(metricsResult.ResourceUtilization.CommandUtilization),
 				"errors", len(metricsResult.Errors()))
 		} else {
 			slog.Info("Received no metrics (this OS/architecture likely doesn't support metric gathering)")
@@ -499,6 +479,10 @@ func (executor *Executor) performStep(ctx context.Context, currentStep *api.Comm
 	signaledToExit := false
 	start := time.Now()
 
+	// Attribute the resource utilization to this step
+	executor.metrics.BeginStep(currentStep.Name)
+	defer executor.metrics.EndStep(currentStep.Name)
+
 	logUploader, err := NewLogUploader(ctx, executor, currentStep.Name)
 	if err != nil {
 		message := fmt.Sprintf("Failed to initialize command %s log upload: %v", currentStep.Name, err)
@@ -598,6 +582,22 @@ func (executor *Executor) performStep(ctx context.Context, currentStep *api.Comm
 		success = false
 	}
 
+	// The OOM killer sends SIGKILL, which is indistinguishable from
+	// other kills, so consult the cgroup memory events to tell them apart
+	oomKilled := false
+	if oomKill := executor.metrics.StepOOMKill(); oomKill != nil {
+		oomKilled = true
+		success = false
+
+		message := fmt.Sprintf("Command %s was killed by the OOM killer, %s", currentStep.Name, oomKill)
+		slog.Warn(message)
+		_, _ = fmt.Fprintf(logUploader, "\n%s!\n", message)
+		_, _ = client.CirrusClient.ReportAgentWarning(ctx, &api.ReportAgentProblemRequest{
+			TaskIdentification: executor.taskIdentification(),
+			Message:            message,
+		})
+	}
+
 	cirrusEnvVariables, err := cirrusEnv.Consume()
 	if err != nil {
 		message := fmt.Sprintf("Failed collect CIRRUS_ENV subsystem results: %v", err)
@@ -612,6 +612,7 @@ func (executor *Executor) performStep(ctx context.Context, currentStep *api.Comm
 	return &StepResult{
 		Success:        success,
 		SignaledToExit: signaledToExit,
+		OOMKilled:      oomKilled,
 		Duration:       time.Since(start),
 	}, nil
 }
@@ -0,0 +1,142 @@
+// Package forge describes the conventions of the Git hosting services ("forges")
+// that the built-in Git relies upon when cloning pull requests.
+package forge
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cirruslabs/cirrus-cli/internal/agent/environment"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+var ErrUnknownForge = errors.New("unknown forge")
+
+const (
+	// EnvForge selects the forge, defaults to GitHub.
+	EnvForge = "CIRRUS_REPO_CLONE_FORGE"
+
+	// EnvCloneURLTemplate overrides the forge's authenticated clone URL template.
+	EnvCloneURLTemplate = "CIRRUS_REPO_CLONE_URL_TEMPLATE"
+)
+
+type Forge interface {
+	// Name returns a human-readable name of the forge.
+	Name() string
+
+	// HeadRef returns the reference that points to the pull request's head.
+	HeadRef(number string) plumbing.ReferenceName
+
+	// MergeRef returns the reference that points to the forge-generated merge
+	// commit of the pull request, or false if the forge doesn't provide one.
+	MergeRef(number string) (plumbing.ReferenceName, bool)
+
+	// CloneURLTemplate returns an authenticated clone URL template
+	// that is expanded using the task's environment variables.
+	CloneURLTemplate() string
+}
+
+var forges = map[string]Forge{
+	"github":  &GitHub{},
+	"gitlab":  &GitLab{},
+	"gitea":   &Gitea{},
+	"forgejo": &Gitea{},
+}
+
+// New returns a forge by its name, an empty name corresponds to GitHub.
+func New(name string) (Forge, error) {
+	if name == "" {
+		name = "github"
+	}
+
+	forge, ok := forges[strings.ToLower(name)]
+	if !ok {
+		names := make([]string, 0, len(forges))
+		for name := range forges {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		return nil, fmt.Errorf("%w %q, supported forges are: %s", ErrUnknownForge, name,
+			strings.Join(names, ", "))
+	}
+
+	return forge, nil
+}
+
+// NewFromEnvironment returns a forge selected by the CIRRUS_REPO_CLONE_FORGE variable.
+func NewFromEnvironment(env *environment.Environment) (Forge, error) {
+	return New(env.Get(EnvForge))
+}
+
+// CloneURL expands the forge's authenticated clone URL template,
+// unless it was overridden with the CIRRUS_REPO_CLONE_URL_TEMPLATE variable.
+func CloneURL(forge Forge, env *environment.Environment) string {
+	template, ok := env.Lookup(EnvCloneURLTemplate)
+	if !ok {
+		template = forge.CloneURLTemplate()
+	}
+
+	return env.ExpandText(template)
+}
+
+// GitHub provides both head and merge references for each pull request.
+type GitHub struct{}
+
+func (*GitHub) Name() string {
+	return "GitHub"
+}
+
+func (*GitHub) HeadRef(number string) plumbing.ReferenceName {
+	return plumbing.ReferenceName(fmt.Sprintf("refs/pull/%s/head", number))
+}
+
+func (*GitHub) MergeRef(number string) (plumbing.ReferenceName, bool) {
+	return plumbing.ReferenceName(fmt.Sprintf("refs/pull/%s/merge", number)), true
+}
+
+func (*GitHub) CloneURLTemplate() string {
+	return "https://x-access-token:${CIRRUS_REPO_CLONE_TOKEN}@${CIRRUS_REPO_CLONE_HOST}/${CIRRUS_REPO_FULL_NAME}.git"
+}
+
+// GitLab names pull requests "merge requests", its merge references are only
+// generated on demand and can be stale, so they're not relied upon.
+type GitLab struct{}
+
+func (*GitLab) Name() string {
+	return "GitLab"
+}
+
+func (*GitLab) HeadRef(number string) plumbing.ReferenceName {
+	return plumbing.ReferenceName(fmt.Sprintf("refs/merge-requests/%s/head", number))
+}
+
+func (*GitLab) MergeRef(string) (plumbing.ReferenceName, bool) {
+	return "", false
+}
+
+func (*GitLab) CloneURLTemplate() string {
+	return "https://oauth2:${CIRRUS_REPO_CLONE_TOKEN}@${CIRRUS_REPO_CLONE_HOST}/${CIRRUS_REPO_FULL_NAME}.git"
+}
+
+// Gitea (and its fork Forgejo) follow GitHub's head references,
+// but don't provide merge references.
+type Gitea struct{}
+
+func (*Gitea) Name() string {
+	return "Gitea"
+}
+
+func (*Gitea) HeadRef(number string) plumbing.ReferenceName {
+	return plumbing.ReferenceName(fmt.Sprintf("refs/pull/%s/head", number))
+}
+
+func (*Gitea) MergeRef(string) (plumbing.ReferenceName, bool) {
+	return "", false
+}
+
+func (*Gitea) CloneURLTemplate() string {
+	return "https://${CIRRUS_REPO_CLONE_TOKEN}@${CIRRUS_REPO_CLONE_HOST}/${CIRRUS_REPO_FULL_NAME}.git"
+}
@@ -0,0 +1,129 @@
+//go:build !(openbsd || netbsd)
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source"
+)
+
+var (
+	ErrFailedToQueryBlockIO  = errors.New("failed to query block I/O usage")
+	ErrFailedToQueryNetwork  = errors.New("failed to query network usage")
+	ErrFailedToQueryPressure = errors.New("failed to query pressure stall information")
+)
+
+// counters are the cumulative values from which the rates are derived.
+type counters struct {
+	timestamp time.Time
+	blockIO   *source.BlockIOStat
+	network   *source.NetworkStat
+	pressure  *source.PressureStat
+}
+
+// rates are the per-second values derived from two consecutive counters.
+type rates struct {
+	hasBlockIO bool
+	diskRead   float64
+	diskWrite  float64
+
+	hasNetwork      bool
+	networkReceive  float64
+	networkTransmit float64
+
+	hasPressure    bool
+	cpuPressure    float64
+	memoryPressure float64
+	ioPressure     float64
+}
+
+func (collector *Collector) readCounters(ctx context.Context) (counters, []error) {
+	result := counters{
+		timestamp: time.Now(),
+	}
+
+	var errs []error
+
+	if collector.blockIOSource != nil {
+		blockIO, err := collector.blockIOSource.BlockIO(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%w using %s: %w", ErrFailedToQueryBlockIO,
+				collector.blockIOSource.Name(), err))
+		}
+		result.blockIO = blockIO
+	}
+
+	if collector.networkSource != nil {
+		network, err := collector.networkSource.Network(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%w using %s: %w", ErrFailedToQueryNetwork,
+				collector.networkSource.Name(), err))
+		}
+		result.network = network
+	}
+
+	if collector.pressureSource != nil {
+		pressure, err := collector.pressureSource.Pressure(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%w using %s: %w", ErrFailedToQueryPressure,
+				collector.pressureSource.Name(), err))
+		}
+		result.pressure = pressure
+	}
+
+	return result, errs
+}
+
+func computeRates(before counters, after counters) rates {
+	var result rates
+
+	elapsed := after.timestamp.Sub(before.timestamp)
+	if elapsed <= 0 {
+		return result
+	}
+
+	if before.blockIO != nil && after.blockIO != nil {
+		result.hasBlockIO = true
+		result.diskRead = counterRate(before.blockIO.ReadBytes, after.blockIO.ReadBytes, elapsed)
+		result.diskWrite = counterRate(before.blockIO.WriteBytes, after.blockIO.WriteBytes, elapsed)
+	}
+
+	if before.network != nil && after.network != nil {
+		result.hasNetwork = true
+		result.networkReceive = counterRate(before.network.ReceiveBytes, after.network.ReceiveBytes, elapsed)
+		result.networkTransmit = counterRate(before.network.TransmitBytes, after.network.TransmitBytes, elapsed)
+	}
+
+	if before.pressure != nil && after.pressure != nil {
+		result.hasPressure = true
+		result.cpuPressure = stallPercentage(before.pressure.CPU, after.pressure.CPU, elapsed)
+		result.memoryPressure = stallPercentage(before.pressure.Memory, after.pressure.Memory, elapsed)
+		result.ioPressure = stallPercentage(before.pressure.IO, after.pressure.IO, elapsed)
+	}
+
+	return result
+}
+
+// counterDelta guards against the counters going backwards,
+// e.g. when a network interface or a block device disappears.
+func counterDelta(before uint64, after uint64) uint64 {
+	if after < before {
+		return 0
+	}
+
+	return after - before
+}
+
+func counterRate(before uint64, after uint64, elapsed time.Duration) float64 {
+	return float64(counterDelta(before, after)) / elapsed.Seconds()
+}
+
+func stallPercentage(before time.Duration, after time.Duration, elapsed time.Duration) float64 {
+	stalled := max(after-before, 0)
+
+	return min(stalled.Seconds()/elapsed.Seconds()*100.0, 100.0)
+}
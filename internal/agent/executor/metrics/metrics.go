@@ -12,13 +12,16 @@ import (
 	"time"
 
 	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source/cgroup/blockio"
 	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source/cgroup/cpu"
 	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source/cgroup/memory"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source/cgroup/pressure"
 	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source/cgroup/resolver"
 	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source/system"
 	"github.com/cirruslabs/cirrus-cli/pkg/api"
 	gopsutilcpu "github.com/shirou/gopsutil/v3/cpu"
 	gopsutilmem "github.com/shirou/gopsutil/v3/mem"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -53,24 +56,49 @@ type Snapshot struct {
 	CPUError       error
 	MemoryError    error
 	TotalsError    error
+
+	// CurrentStep is the name of the step that is currently running, if any
+	CurrentStep string
+
+	// Bytes per second, only set when the corresponding source is available
+	DiskReadRate        float64
+	DiskWriteRate       float64
+	NetworkReceiveRate  float64
+	NetworkTransmitRate float64
+
+	// Percentage of time at least some tasks were stalled,
+	// only set when the pressure stall information is available
+	CPUPressure    float64
+	MemoryPressure float64
+	IOPressure     float64
 }
 
 type Collector struct {
-	cpuSource    source.CPU
-	memorySource source.Memory
-	logger       *slog.Logger
-	mu           sync.RWMutex
-	snapshot     Snapshot
-	utilization  *api.ResourceUtilization
+	cpuSource          source.CPU
+	memorySource       source.Memory
+	blockIOSource      source.BlockIO
+	networkSource      source.Network
+	pressureSource     source.Pressure
+	memoryEventsSource source.MemoryEvents
+	logger             *slog.Logger
+	mu                 sync.RWMutex
+	snapshot           Snapshot
+	utilization        *api.ResourceUtilization
+	startTime          time.Time
+	currentStep        *stepAccumulator
 }
 
 func NewCollector(logger *slog.Logger) *Collector {
 	var cpuSource source.CPU
 	var memorySource source.Memory
+	var blockIOSource source.BlockIO
+	var pressureSource source.Pressure
+	var memoryEventsSource source.MemoryEvents
 
 	systemSource := system.New()
 	cpuSource = systemSource
 	memorySource = systemSource
+	blockIOSource = systemSource
 
 	resolver, err := resolver.New()
 	if err != nil {
@@ -94,12 +122,60 @@ func NewCollector(logger *slog.Logger) *Collector {
 			}
 			memorySource = memorySrc
 		}
+
+		blockIOSrc, err := blockio.NewBlockIO(resolver)
+		if err == nil {
+			if logger != nil {
+				logger.Info("block I/O metrics are now cgroup-aware")
+			}
+			blockIOSource = blockIOSrc
+		}
+
+		memoryEventsSrc, err := memory.NewMemoryEvents(resolver)
+		if err == nil {
+			if logger != nil {
+				logger.Info("OOM kills are now detected using cgroup memory events")
+			}
+			memoryEventsSource = memoryEventsSrc
+		}
+
+		pressureSrc, err := pressure.NewPressure(resolver)
+		if err == nil {
+			if logger != nil {
+				logger.Info("pressure stall metrics are now cgroup-aware")
+			}
+			pressureSource = pressureSrc
+		}
+	}
+
+	if pressureSource == nil {
+		// Not every kernel has the pressure stall information enabled
+		if pressureSrc, err := system.NewPressure(); err == nil {
+			pressureSource = pressureSrc
+		}
+	}
+
+	// Network interfaces are not accounted for by the cgroups,
+	// so the network metrics are always system-wide
+	var networkSource source.Network = systemSource
+
+	// Disable the sources that don't work on this system
+	// to avoid reporting the same error on each poll
+	if _, err := blockIOSource.BlockIO(context.Background()); err != nil {
+		blockIOSource = nil
+	}
+	if _, err := networkSource.Network(context.Background()); err != nil {
+		networkSource = nil
 	}
 
 	return &Collector{
-		cpuSource:    cpuSource,
-		memorySource: memorySource,
-		logger:       logger,
+		cpuSource:          cpuSource,
+		memorySource:       memorySource,
+		blockIOSource:      blockIOSource,
+		networkSource:      networkSource,
+		pressureSource:     pressureSource,
+		memoryEventsSource: memoryEventsSource,
+		logger:             logger,
 		snapshot: Snapshot{
 			CPUIsCgroup:    isCgroupCPU(cpuSource),
 			MemoryIsCgroup: isCgroupMemory(memorySource),
@@ -124,38 +200,7 @@ func (collector *Collector) ResourceUtilizationSnapshot() *api.ResourceUtilizati
 	}
 
 	// Return a deep copy so callers can read without holding the lock.
-	snapshot := &api.ResourceUtilization{
-		CpuTotal:    collector.utilization.CpuTotal,
-		MemoryTotal: collector.utilization.MemoryTotal,
-	}
-
-	if len(collector.utilization.CpuChart) > 0 {
-		snapshot.CpuChart = make([]*api.ChartPoint, len(collector.utilization.CpuChart))
-		for i, point := range collector.utilization.CpuChart {
-			if point == nil {
-				continue
-			}
-			snapshot.CpuChart[i] = &api.ChartPoint{
-				SecondsFromStart: point.SecondsFromStart,
-				Value:            point.Value,
-			}
-		}
-	}
-
-	if len(collector.utilization.MemoryChart) > 0 {
-		snapshot.MemoryChart = make([]*api.ChartPoint, len(collector.utilization.MemoryChart))
-		for i, point := range collector.utilization.MemoryChart {
-			if point == nil {
-				continue
-			}
-			snapshot.MemoryChart[i] = &api.ChartPoint{
-				SecondsFromStart: point.SecondsFromStart,
-				Value:            point.Value,
-			}
-		}
-	}
-
-	return snapshot
+	return proto.Clone(collector.utilization).(*api.ResourceUtilization)
 }
 
 func (collector *Collector) Run(ctx context.Context) chan *Result {
@@ -199,6 +244,15 @@ func (collector *Collector) Run(ctx context.Context) chan *Result {
 		pollInterval := 1 * time.Second
 		startTime := time.Now()
 
+		collector.mu.Lock()
+		collector.startTime = startTime
+		collector.mu.Unlock()
+
+		previousCounters, countersErrs := collector.readCounters(ctx)
+		for _, err := range countersErrs {
+			result.errors[err.Error()] = err
+		}
+
 		for {
 			cycleStartTime := time.Now()
 
@@ -228,6 +282,19 @@ func (collector *Collector) Run(ctx context.Context) chan *Result {
 				result.errors[err.Error()] = err
 			}
 
+			// Block I/O, network and pressure stall information
+			currentCounters, countersErrs := collector.readCounters(ctx)
+			if ctx.Err() != nil {
+				resultChan <- result
+
+				return
+			}
+			for _, err := range countersErrs {
+				result.errors[err.Error()] = err
+			}
+			rates := computeRates(previousCounters, currentCounters)
+			previousCounters = currentCounters
+
 			timeSinceStart := time.Since(startTime)
 			func() {
 				collector.mu.Lock()
@@ -243,8 +310,31 @@ func (collector *Collector) Run(ctx context.Context) chan *Result {
 				if memoryErr == nil {
 					snapshot.MemoryUsed = amountMemoryUsed
 				}
+				if rates.hasBlockIO {
+					snapshot.DiskReadRate = rates.diskRead
+					snapshot.DiskWriteRate = rates.diskWrite
+				}
+				if rates.hasNetwork {
+					snapshot.NetworkReceiveRate = rates.networkReceive
+					snapshot.NetworkTransmitRate = rates.networkTransmit
+				}
+				if rates.hasPressure {
+					snapshot.CPUPressure = rates.cpuPressure
+					snapshot.MemoryPressure = rates.memoryPressure
+					snapshot.IOPressure = rates.ioPressure
+				}
 				collector.snapshot = snapshot
 
+				if step := collector.currentStep; step != nil {
+					if cpuErr == nil {
+						step.cpu.add(numCpusUsed)
+					}
+					if memoryErr == nil {
+						step.memory.add(amountMemoryUsed)
+					}
+					step.addRates(rates)
+				}
+
 				if collector.utilization != nil {
 					if cpuErr == nil {
 						collector.utilization.CpuChart = append(collector.utilization.CpuChart, &api.ChartPoint{
@@ -258,6 +348,7 @@ func (collector *Collector) Run(ctx context.Context) chan *Result {
 							Value:            amountMemoryUsed,
 						})
 					}
+					collector.appendRates(uint32(timeSinceStart.Seconds()), rates)
 				}
 			}()
 
@@ -283,6 +374,31 @@ func (collector *Collector) Run(ctx context.Context) chan *Result {
 	return resultChan
 }
 
+func (collector *Collector) appendRates(secondsFromStart uint32, rates rates) {
+	appendPoint := func(chart *[]*api.ChartPoint, value float64) {
+		*chart = append(*chart, &api.ChartPoint{
+			SecondsFromStart: secondsFromStart,
+			Value:            value,
+		})
+	}
+
+	if rates.hasBlockIO {
+		appendPoint(&collector.utilization.DiskReadChart, rates.diskRead)
+		appendPoint(&collector.utilization.DiskWriteChart, rates.diskWrite)
+	}
+
+	if rates.hasNetwork {
+		appendPoint(&collector.utilization.NetworkReceiveChart, rates.networkReceive)
+		appendPoint(&collector.utilization.NetworkTransmitChart, rates.networkTransmit)
+	}
+
+	if rates.hasPressure {
+		appendPoint(&collector.utilization.CpuPressureChart, rates.cpuPressure)
+		appendPoint(&collector.utilization.MemoryPressureChart, rates.memoryPressure)
+		appendPoint(&collector.utilization.IoPressureChart, rates.ioPressure)
+	}
+}
+
 func Run(ctx context.Context, logger *slog.Logger) chan *Result {
 	return NewCollector(logger).Run(ctx)
 }
@@ -44,3 +44,45 @@ func TestTotals(t *testing.T) {
 	assert.EqualValues(t, expectedNumCpusTotal, numCpusTotal)
 	assert.EqualValues(t, expectedAmountMemory.Total, amountMemoryTotal)
 }
+
+func TestStepAttribution(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	collector := metrics.NewCollector(nil)
+	resultChan := collector.Run(ctx)
+
+	collector.BeginStep("main")
+	require.Equal(t, "main", collector.Snapshot().CurrentStep)
+	time.Sleep(2*time.Second + 500*time.Millisecond)
+	collector.EndStep("main")
+	require.Empty(t, collector.Snapshot().CurrentStep)
+
+	// Ending a step that was never started is a no-op
+	collector.EndStep("unknown")
+
+	cancel()
+	result := <-resultChan
+
+	require.Len(t, result.ResourceUtilization.CommandUtilization, 1)
+	command := result.ResourceUtilization.CommandUtilization[0]
+	require.Equal(t, "main", command.Name)
+	require.GreaterOrEqual(t, command.FinishedAtSecondsFromStart, command.StartedAtSecondsFromStart+2)
+	require.NotNil(t, command.Cpu)
+	require.NotNil(t, command.Memory)
+	require.Greater(t, command.Memory.Peak, 0.0)
+	require.GreaterOrEqual(t, command.Memory.Peak, command.Memory.Average)
+
+	if command.NetworkReceive != nil {
+		require.Len(t, result.ResourceUtilization.NetworkReceiveChart, len(result.ResourceUtilization.CpuChart))
+		require.GreaterOrEqual(t, command.NetworkReceive.Peak, command.NetworkReceive.Average)
+	}
+}
+
+func TestOOMKillString(t *testing.T) {
+	oomKill := &metrics.OOMKill{Count: 1, Peak: 1_900_000_000, Limit: 2_000_000_000}
+	require.Equal(t, "out of memory: peak 1.9 GB of limit 2.0 GB", oomKill.String())
+
+	oomKill = &metrics.OOMKill{Count: 1}
+	require.Equal(t, "out of memory: peak unknown of limit unlimited", oomKill.String())
+}
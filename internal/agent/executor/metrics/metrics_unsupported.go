@@ -28,6 +28,17 @@ type Snapshot struct {
 	CPUError       error
 	MemoryError    error
 	TotalsError    error
+
+	CurrentStep string
+
+	DiskReadRate        float64
+	DiskWriteRate       float64
+	NetworkReceiveRate  float64
+	NetworkTransmitRate float64
+
+	CPUPressure    float64
+	MemoryPressure float64
+	IOPressure     float64
 }
 
 type Collector struct{}
@@ -44,6 +55,14 @@ func (collector *Collector) ResourceUtilizationSnapshot() *api.ResourceUtilizati
 	return nil
 }
 
+func (collector *Collector) BeginStep(name string) {}
+
+func (collector *Collector) EndStep(name string) {}
+
+func (collector *Collector) StepOOMKill() *OOMKill {
+	return nil
+}
+
 func (collector *Collector) Run(ctx context.Context) chan *Result {
 	resultChan := make(chan *Result, 1)
 
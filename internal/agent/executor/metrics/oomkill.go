@@ -0,0 +1,32 @@
+package metrics
+
+import (
+	"fmt"
+
+	"github.com/dustin/go-humanize"
+)
+
+// OOMKill describes the processes killed by the kernel's OOM killer during a step.
+type OOMKill struct {
+	Count uint64
+
+	// Peak memory usage, 0 when unknown
+	Peak float64
+
+	// Memory limit, 0 when unlimited
+	Limit float64
+}
+
+func (oomKill *OOMKill) String() string {
+	peak := "unknown"
+	if oomKill.Peak > 0 {
+		peak = humanize.Bytes(uint64(oomKill.Peak))
+	}
+
+	limit := "unlimited"
+	if oomKill.Limit > 0 {
+		limit = humanize.Bytes(uint64(oomKill.Limit))
+	}
+
+	return fmt.Sprintf("out of memory: peak %s of limit %s", peak, limit)
+}
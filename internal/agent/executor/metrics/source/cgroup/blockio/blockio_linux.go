@@ -0,0 +1,69 @@
+package blockio
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source/cgroup"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source/cgroup/parser"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source/cgroup/resolver"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source/cgroup/subsystem"
+)
+
+type V2BlockIO struct {
+	path string
+}
+
+func NewBlockIO(resolver resolver.Resolver) (source.BlockIO, error) {
+	const desiredSubsystem = subsystem.IO
+
+	_, v2path, err := resolver.Resolve(desiredSubsystem)
+	if err != nil {
+		return nil, err
+	}
+
+	if v2path == "" {
+		return nil, fmt.Errorf("%w for subsystem %s", cgroup.ErrUnconfigured, desiredSubsystem)
+	}
+
+	blockIO := &V2BlockIO{
+		path: v2path,
+	}
+
+	// Make sure that the io controller is enabled for our cgroup
+	if _, err := blockIO.BlockIO(context.Background()); err != nil {
+		return nil, err
+	}
+
+	return blockIO, nil
+}
+
+func (blockIO *V2BlockIO) Name() string {
+	return fmt.Sprintf("cgroup block I/O resolver on %s/%s", runtime.GOOS, runtime.GOARCH)
+}
+
+func (blockIO *V2BlockIO) BlockIO(ctx context.Context) (*source.BlockIOStat, error) {
+	file, err := os.Open(filepath.Join(blockIO.path, "io.stat"))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	devices, err := parser.ParseNestedKeyedFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var result source.BlockIOStat
+
+	for _, values := range devices {
+		result.ReadBytes += values["rbytes"]
+		result.WriteBytes += values["wbytes"]
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+
+package blockio
+
+import (
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source/cgroup"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source/cgroup/resolver"
+)
+
+func NewBlockIO(resolver resolver.Resolver) (source.BlockIO, error) {
+	return nil, cgroup.ErrUnsupportedPlatform
+}
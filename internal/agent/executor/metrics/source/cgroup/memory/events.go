@@ -0,0 +1,100 @@
+//go:build linux
+
+package memory
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source/cgroup/parser"
+)
+
+// v1UnlimitedThreshold is used to detect the "unlimited" memory.limit_in_bytes,
+// which is reported as the PAGE_COUNTER_MAX rounded to a page size.
+const v1UnlimitedThreshold = 1 << 62
+
+func (memory *V1Memory) MemoryEvents() (*source.MemoryEventsStat, error) {
+	oomControl, err := parseKeyValueFile(filepath.Join(memory.path, "memory.oom_control"))
+	if err != nil {
+		return nil, err
+	}
+
+	// The "oom_kill" counter is only available since Linux 4.13
+	const oomKillFieldName = "oom_kill"
+	oomKills, ok := oomControl[oomKillFieldName]
+	if !ok {
+		return nil, fmt.Errorf("%w: missing %s field", parser.ErrInvalidFormat, oomKillFieldName)
+	}
+
+	result := &source.MemoryEventsStat{
+		OOMKills: oomKills,
+	}
+
+	if peak, err := parseSingleValueFile(filepath.Join(memory.path, "memory.max_usage_in_bytes")); err == nil {
+		result.Peak = peak
+	}
+
+	if limit, err := parseSingleValueFile(filepath.Join(memory.path, "memory.limit_in_bytes")); err == nil &&
+		limit < v1UnlimitedThreshold {
+		result.Limit = limit
+	}
+
+	return result, nil
+}
+
+func (memory *V2Memory) MemoryEvents() (*source.MemoryEventsStat, error) {
+	events, err := parseKeyValueFile(filepath.Join(memory.path, "memory.events"))
+	if err != nil {
+		return nil, err
+	}
+
+	const oomKillFieldName = "oom_kill"
+	oomKills, ok := events[oomKillFieldName]
+	if !ok {
+		return nil, fmt.Errorf("%w: missing %s field", parser.ErrInvalidFormat, oomKillFieldName)
+	}
+
+	result := &source.MemoryEventsStat{
+		OOMKills: oomKills,
+	}
+
+	// memory.peak is only available since Linux 5.19
+	if peak, err := parseSingleValueFile(filepath.Join(memory.path, "memory.peak")); err == nil {
+		result.Peak = peak
+	}
+
+	file, err := os.Open(filepath.Join(memory.path, "memory.max"))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	result.Limit, err = parser.ParseLimitFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func parseKeyValueFile(path string) (map[string]uint64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return parser.ParseKeyValueFile(file)
+}
+
+func parseSingleValueFile(path string) (uint64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	return parser.ParseSingleValueFile(file)
+}
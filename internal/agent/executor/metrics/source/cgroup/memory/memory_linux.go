@@ -46,3 +46,48 @@ func (cpu *VersionlessMemory) Name() string {
 func (memory *VersionlessMemory) AmountMemoryUsed(ctx context.Context) (float64, error) {
 	return memory.versionedMemory.MemoryUsage()
 }
+
+type VersionlessMemoryEvents struct {
+	versionedMemory interface {
+		MemoryEvents() (*source.MemoryEventsStat, error)
+	}
+}
+
+// NewMemoryEvents returns a source of the out-of-memory events of our cgroup.
+func NewMemoryEvents(resolver resolver.Resolver) (source.MemoryEvents, error) {
+	memoryEvents := &VersionlessMemoryEvents{}
+
+	const desiredSubsystem = subsystem.Memory
+
+	v1path, v2path, err := resolver.Resolve(desiredSubsystem)
+	if err != nil {
+		return nil, err
+	}
+
+	if v1path != "" {
+		memoryEvents.versionedMemory, err = NewV1(v1path)
+	} else if v2path != "" {
+		memoryEvents.versionedMemory, err = NewV2(v2path)
+	} else {
+		err = fmt.Errorf("%w for subsystem %s", cgroup.ErrUnconfigured, desiredSubsystem)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure that the OOM kill counter is available
+	if _, err := memoryEvents.MemoryEvents(context.Background()); err != nil {
+		return nil, err
+	}
+
+	return memoryEvents, nil
+}
+
+func (memoryEvents *VersionlessMemoryEvents) Name() string {
+	return fmt.Sprintf("cgroup memory events resolver on %s/%s", runtime.GOOS, runtime.GOARCH)
+}
+
+func (memoryEvents *VersionlessMemoryEvents) MemoryEvents(ctx context.Context) (*source.MemoryEventsStat, error) {
+	return memoryEvents.versionedMemory.MemoryEvents()
+}
@@ -11,3 +11,7 @@ import (
 func NewMemory(resolver resolver.Resolver) (source.Memory, error) {
 	return nil, cgroup.ErrUnsupportedPlatform
 }
+
+func NewMemoryEvents(resolver resolver.Resolver) (source.MemoryEvents, error) {
+	return nil, cgroup.ErrUnsupportedPlatform
+}
@@ -43,6 +43,21 @@ func ParseSingleValueFile(input io.Reader) (uint64, error) {
 	return parsed, nil
 }
 
+// ParseLimitFile parses the single value files that may also
+// contain "max" to signify that there is no limit, in which case 0 is returned.
+func ParseLimitFile(input io.Reader) (uint64, error) {
+	contents, err := io.ReadAll(input)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInternal, err)
+	}
+
+	if strings.TrimSpace(string(contents)) == "max" {
+		return 0, nil
+	}
+
+	return ParseSingleValueFile(strings.NewReader(string(contents)))
+}
+
 func ParseKeyValueFile(input io.Reader) (map[string]uint64, error) {
 	result := map[string]uint64{}
 
@@ -74,3 +89,77 @@ func ParseKeyValueFile(input io.Reader) (map[string]uint64, error) {
 
 	return result, nil
 }
+
+// ParseNestedKeyedFile parses the files in which each line starts with
+// a key that is followed by the "subkey=value" pairs, such as io.stat.
+func ParseNestedKeyedFile(input io.Reader) (map[string]map[string]uint64, error) {
+	result := map[string]map[string]uint64{}
+
+	scanner := bufio.NewScanner(input)
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		values := map[string]uint64{}
+
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				return nil, fmt.Errorf("%w: expected a \"key=value\" pair, got %q", ErrInvalidFormat, field)
+			}
+
+			parsedValue, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: failed to parse value %q: %v", ErrInvalidFormat, value, err)
+			}
+
+			values[key] = parsedValue
+		}
+
+		result[fields[0]] = values
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInternal, err)
+	}
+
+	return result, nil
+}
+
+// ParsePressureFile parses the pressure stall information files (e.g. cpu.pressure)
+// and returns the total amount of microseconds during which at least some tasks were stalled.
+func ParsePressureFile(input io.Reader) (uint64, error) {
+	scanner := bufio.NewScanner(input)
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || fields[0] != "some" {
+			continue
+		}
+
+		for _, field := range fields[1:] {
+			value, ok := strings.CutPrefix(field, "total=")
+			if !ok {
+				continue
+			}
+
+			total, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("%w: failed to parse total %q: %v", ErrInvalidFormat, value, err)
+			}
+
+			return total, nil
+		}
+
+		return 0, fmt.Errorf("%w: missing total field", ErrInvalidFormat)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInternal, err)
+	}
+
+	return 0, fmt.Errorf("%w: missing \"some\" line", ErrInvalidFormat)
+}
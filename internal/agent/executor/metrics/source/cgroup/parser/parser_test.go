@@ -18,6 +18,20 @@ func TestParseSingleValueFile(t *testing.T) {
 	assert.EqualValues(t, 252387328, result)
 }
 
+func TestParseLimitFile(t *testing.T) {
+	result, err := ParseLimitFile(bytes.NewBufferString("536870912\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.EqualValues(t, 536870912, result)
+
+	result, err = ParseLimitFile(bytes.NewBufferString("max\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.EqualValues(t, 0, result)
+}
+
 func TestParseKeyValueFile(t *testing.T) {
 	memoryStatFile := `cache 25182208
 rss 2654208
@@ -63,3 +77,34 @@ total_unevictable 0
 	assert.True(t, ok)
 	assert.EqualValues(t, totalInactiveFile, 165294080)
 }
+
+func TestParseNestedKeyedFile(t *testing.T) {
+	ioStatFile := `8:16 rbytes=1459200 wbytes=314773504 rios=192 wios=353 dbytes=0 dios=0
+8:0 rbytes=90430464 wbytes=299008000 rios=8950 wios=1252 dbytes=50331648 dios=3021
+`
+
+	result, err := ParseNestedKeyedFile(bytes.NewBufferString(ioStatFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, result, 2)
+	assert.EqualValues(t, 1459200, result["8:16"]["rbytes"])
+	assert.EqualValues(t, 299008000, result["8:0"]["wbytes"])
+}
+
+func TestParsePressureFile(t *testing.T) {
+	cpuPressureFile := `some avg10=0.00 avg60=0.12 avg300=0.05 total=2087634
+full avg10=0.00 avg60=0.00 avg300=0.00 total=1043817
+`
+
+	result, err := ParsePressureFile(bytes.NewBufferString(cpuPressureFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.EqualValues(t, 2087634, result)
+
+	_, err = ParsePressureFile(bytes.NewBufferString("full avg10=0.00 avg60=0.00 avg300=0.00 total=1\n"))
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+}
@@ -0,0 +1,88 @@
+package pressure
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source/cgroup"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source/cgroup/parser"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source/cgroup/resolver"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source/cgroup/subsystem"
+)
+
+type Pressure struct {
+	dir string
+}
+
+// NewPressure returns a source of the pressure stall information of our cgroup,
+// which is only available in cgroup version 2.
+func NewPressure(resolver resolver.Resolver) (source.Pressure, error) {
+	// PSI files live in the unified hierarchy, same as the io controller
+	_, v2path, err := resolver.Resolve(subsystem.IO)
+	if err != nil {
+		return nil, err
+	}
+
+	if v2path == "" {
+		return nil, fmt.Errorf("%w for pressure stall information", cgroup.ErrUnconfigured)
+	}
+
+	return NewFromDir(v2path)
+}
+
+// NewFromDir returns a source of the pressure stall information from a directory
+// containing cpu.pressure, memory.pressure and io.pressure files, e.g. /proc/pressure.
+func NewFromDir(dir string) (source.Pressure, error) {
+	pressure := &Pressure{
+		dir: dir,
+	}
+
+	// PSI might be disabled in the kernel
+	if _, err := pressure.Pressure(context.Background()); err != nil {
+		return nil, err
+	}
+
+	return pressure, nil
+}
+
+func (pressure *Pressure) Name() string {
+	return fmt.Sprintf("pressure stall information from %s on %s/%s", pressure.dir, runtime.GOOS, runtime.GOARCH)
+}
+
+func (pressure *Pressure) Pressure(ctx context.Context) (*source.PressureStat, error) {
+	var result source.PressureStat
+
+	for name, target := range map[string]*time.Duration{
+		"cpu":    &result.CPU,
+		"memory": &result.Memory,
+		"io":     &result.IO,
+	} {
+		totalUsec, err := pressure.parse(name)
+		if err != nil {
+			return nil, err
+		}
+
+		*target = time.Duration(totalUsec) * time.Microsecond
+	}
+
+	return &result, nil
+}
+
+func (pressure *Pressure) parse(name string) (uint64, error) {
+	file, err := os.Open(filepath.Join(pressure.dir, name+".pressure"))
+	if err != nil {
+		// /proc/pressure uses file names without the suffix
+		file, err = os.Open(filepath.Join(pressure.dir, name))
+		if err != nil {
+			return 0, err
+		}
+	}
+	defer file.Close()
+
+	return parser.ParsePressureFile(file)
+}
@@ -0,0 +1,17 @@
+//go:build !linux
+
+package pressure
+
+import (
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source/cgroup"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source/cgroup/resolver"
+)
+
+func NewPressure(resolver resolver.Resolver) (source.Pressure, error) {
+	return nil, cgroup.ErrUnsupportedPlatform
+}
+
+func NewFromDir(dir string) (source.Pressure, error) {
+	return nil, cgroup.ErrUnsupportedPlatform
+}
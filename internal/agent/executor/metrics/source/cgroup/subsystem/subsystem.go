@@ -5,4 +5,7 @@ type SubsystemName string
 const (
 	Cpuacct SubsystemName = "cpuacct"
 	Memory  SubsystemName = "memory"
+
+	// IO is only available in cgroup version 2
+	IO SubsystemName = "io"
 )
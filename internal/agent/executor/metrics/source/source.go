@@ -14,3 +14,55 @@ type Memory interface {
 	Name() string
 	AmountMemoryUsed(ctx context.Context) (float64, error)
 }
+
+// BlockIOStat contains the cumulative amounts since an unspecified point in time.
+type BlockIOStat struct {
+	ReadBytes  uint64
+	WriteBytes uint64
+}
+
+type BlockIO interface {
+	Name() string
+	BlockIO(ctx context.Context) (*BlockIOStat, error)
+}
+
+// NetworkStat contains the cumulative amounts since an unspecified point in time.
+type NetworkStat struct {
+	ReceiveBytes  uint64
+	TransmitBytes uint64
+}
+
+type Network interface {
+	Name() string
+	Network(ctx context.Context) (*NetworkStat, error)
+}
+
+// PressureStat contains the cumulative amounts of time during
+// which at least some tasks were stalled on a given resource.
+type PressureStat struct {
+	CPU    time.Duration
+	Memory time.Duration
+	IO     time.Duration
+}
+
+type Pressure interface {
+	Name() string
+	Pressure(ctx context.Context) (*PressureStat, error)
+}
+
+// MemoryEventsStat describes the out-of-memory events of a cgroup.
+type MemoryEventsStat struct {
+	// OOMKills is the cumulative number of processes killed by the OOM killer
+	OOMKills uint64
+
+	// Peak is the highest recorded memory usage, 0 when unknown
+	Peak uint64
+
+	// Limit is the memory limit, 0 when unlimited
+	Limit uint64
+}
+
+type MemoryEvents interface {
+	Name() string
+	MemoryEvents(ctx context.Context) (*MemoryEventsStat, error)
+}
@@ -0,0 +1,11 @@
+package system
+
+import (
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source/cgroup/pressure"
+)
+
+// NewPressure returns a source of the system-wide pressure stall information.
+func NewPressure() (source.Pressure, error) {
+	return pressure.NewFromDir("/proc/pressure")
+}
@@ -0,0 +1,15 @@
+//go:build !linux
+
+package system
+
+import (
+	"errors"
+
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source"
+)
+
+var ErrPressureUnsupported = errors.New("pressure stall information is only available on Linux")
+
+func NewPressure() (source.Pressure, error) {
+	return nil, ErrPressureUnsupported
+}
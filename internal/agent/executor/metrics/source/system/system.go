@@ -3,9 +3,14 @@ package system
 import (
 	"context"
 	"fmt"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics/source"
 	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/disk"
 	"github.com/shirou/gopsutil/v3/mem"
+	"github.com/shirou/gopsutil/v3/net"
 	"runtime"
+	"sort"
+	"strings"
 	"time"
 )
 
@@ -39,6 +44,73 @@ func (system *System) AmountMemoryUsed(ctx context.Context) (float64, error) {
 	return float64(virtualMemoryStat.Used), nil
 }
 
+func (system *System) BlockIO(ctx context.Context) (*source.BlockIOStat, error) {
+	counters, err := disk.IOCountersWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result source.BlockIOStat
+
+	for _, name := range wholeDisks(counters) {
+		result.ReadBytes += counters[name].ReadBytes
+		result.WriteBytes += counters[name].WriteBytes
+	}
+
+	return &result, nil
+}
+
+func (system *System) Network(ctx context.Context) (*source.NetworkStat, error) {
+	counters, err := net.IOCountersWithContext(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var result source.NetworkStat
+
+	for _, counter := range counters {
+		if counter.Name == "lo" || counter.Name == "lo0" {
+			continue
+		}
+
+		result.ReceiveBytes += counter.BytesRecv
+		result.TransmitBytes += counter.BytesSent
+	}
+
+	return &result, nil
+}
+
+// wholeDisks filters out virtual devices and partitions, whose I/O
+// is already accounted for in the disks they belong to.
+func wholeDisks(counters map[string]disk.IOCountersStat) []string {
+	var candidates []string
+
+	for name := range counters {
+		if strings.HasPrefix(name, "loop") || strings.HasPrefix(name, "ram") ||
+			strings.HasPrefix(name, "dm-") || strings.HasPrefix(name, "md") {
+			continue
+		}
+
+		candidates = append(candidates, name)
+	}
+
+	sort.Strings(candidates)
+
+	var result []string
+
+	for _, name := range candidates {
+		// Partitions are named after their disk (e.g. sda1 or nvme0n1p1),
+		// and sort right after it
+		if len(result) != 0 && strings.HasPrefix(name, result[len(result)-1]) {
+			continue
+		}
+
+		result = append(result, name)
+	}
+
+	return result
+}
+
 func (system *System) Name() string {
 	return fmt.Sprintf("gopsutil on %s/%s", runtime.GOOS, runtime.GOARCH)
 }
@@ -0,0 +1,224 @@
+//go:build !(openbsd || netbsd)
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
+)
+
+type summaryAccumulator struct {
+	peak  float64
+	sum   float64
+	count int
+}
+
+func (accumulator *summaryAccumulator) add(value float64) {
+	if accumulator.count == 0 || value > accumulator.peak {
+		accumulator.peak = value
+	}
+	accumulator.sum += value
+	accumulator.count++
+}
+
+func (accumulator *summaryAccumulator) summary() *api.UtilizationSummary {
+	if accumulator.count == 0 {
+		return nil
+	}
+
+	return &api.UtilizationSummary{
+		Peak:    accumulator.peak,
+		Average: accumulator.sum / float64(accumulator.count),
+	}
+}
+
+// cumulativeSummary summarizes a rate metric using the exact amount
+// transferred between the step boundaries, so that steps shorter
+// than the poll interval are still accounted for.
+func (accumulator *summaryAccumulator) cumulativeSummary(total uint64, duration time.Duration) *api.UtilizationSummary {
+	summary := &api.UtilizationSummary{
+		Total: float64(total),
+	}
+
+	if duration > 0 {
+		summary.Average = float64(total) / duration.Seconds()
+	}
+
+	summary.Peak = summary.Average
+	if accumulator.count != 0 {
+		summary.Peak = max(summary.Peak, accumulator.peak)
+	}
+
+	return summary
+}
+
+// stepAccumulator tracks the resource utilization of the currently running step.
+type stepAccumulator struct {
+	name      string
+	startedAt time.Time
+	before    counters
+
+	// oomKillsBefore is nil when the OOM kills can't be detected
+	oomKillsBefore *uint64
+
+	cpu             summaryAccumulator
+	memory          summaryAccumulator
+	diskRead        summaryAccumulator
+	diskWrite       summaryAccumulator
+	networkReceive  summaryAccumulator
+	networkTransmit summaryAccumulator
+	cpuPressure     summaryAccumulator
+	memoryPressure  summaryAccumulator
+	ioPressure      summaryAccumulator
+}
+
+func (step *stepAccumulator) addRates(rates rates) {
+	if rates.hasBlockIO {
+		step.diskRead.add(rates.diskRead)
+		step.diskWrite.add(rates.diskWrite)
+	}
+
+	if rates.hasNetwork {
+		step.networkReceive.add(rates.networkReceive)
+		step.networkTransmit.add(rates.networkTransmit)
+	}
+
+	if rates.hasPressure {
+		step.cpuPressure.add(rates.cpuPressure)
+		step.memoryPressure.add(rates.memoryPressure)
+		step.ioPressure.add(rates.ioPressure)
+	}
+}
+
+// BeginStep marks the start of a step, all the samples collected
+// until the EndStep() call are attributed to it.
+func (collector *Collector) BeginStep(name string) {
+	before, _ := collector.readCounters(context.Background())
+	oomKillsBefore := collector.oomKills()
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+
+	collector.currentStep = &stepAccumulator{
+		name:      name,
+		startedAt: before.timestamp,
+		before:    before,
+
+		oomKillsBefore: oomKillsBefore,
+	}
+	collector.snapshot.CurrentStep = name
+}
+
+// EndStep marks the end of a step started with BeginStep() and records its resource utilization.
+func (collector *Collector) EndStep(name string) {
+	after, _ := collector.readCounters(context.Background())
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+
+	step := collector.currentStep
+	if step == nil || step.name != name {
+		return
+	}
+
+	collector.currentStep = nil
+	collector.snapshot.CurrentStep = ""
+
+	if collector.utilization == nil {
+		return
+	}
+
+	duration := after.timestamp.Sub(step.startedAt)
+
+	commandUtilization := &api.CommandResourceUtilization{
+		Name:                       name,
+		StartedAtSecondsFromStart:  collector.secondsFromStart(step.startedAt),
+		FinishedAtSecondsFromStart: collector.secondsFromStart(after.timestamp),
+		Cpu:                        step.cpu.summary(),
+		Memory:                     step.memory.summary(),
+		CpuPressure:                step.cpuPressure.summary(),
+		MemoryPressure:             step.memoryPressure.summary(),
+		IoPressure:                 step.ioPressure.summary(),
+	}
+
+	if step.before.blockIO != nil && after.blockIO != nil {
+		commandUtilization.DiskRead = step.diskRead.cumulativeSummary(
+			counterDelta(step.before.blockIO.ReadBytes, after.blockIO.ReadBytes), duration)
+		commandUtilization.DiskWrite = step.diskWrite.cumulativeSummary(
+			counterDelta(step.before.blockIO.WriteBytes, after.blockIO.WriteBytes), duration)
+	}
+
+	if step.before.network != nil && after.network != nil {
+		commandUtilization.NetworkReceive = step.networkReceive.cumulativeSummary(
+			counterDelta(step.before.network.ReceiveBytes, after.network.ReceiveBytes), duration)
+		commandUtilization.NetworkTransmit = step.networkTransmit.cumulativeSummary(
+			counterDelta(step.before.network.TransmitBytes, after.network.TransmitBytes), duration)
+	}
+
+	collector.utilization.CommandUtilization = append(collector.utilization.CommandUtilization,
+		commandUtilization)
+}
+
+func (collector *Collector) secondsFromStart(timestamp time.Time) uint32 {
+	if collector.startTime.IsZero() || timestamp.Before(collector.startTime) {
+		return 0
+	}
+
+	return uint32(timestamp.Sub(collector.startTime).Seconds())
+}
+
+// StepOOMKill returns the processes killed by the OOM killer since the current step
+// has started or since the last call to StepOOMKill(), or nil if there were none.
+func (collector *Collector) StepOOMKill() *OOMKill {
+	if collector.memoryEventsSource == nil {
+		return nil
+	}
+
+	stat, err := collector.memoryEventsSource.MemoryEvents(context.Background())
+	if err != nil {
+		return nil
+	}
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+
+	step := collector.currentStep
+	if step == nil || step.oomKillsBefore == nil {
+		return nil
+	}
+
+	count := counterDelta(*step.oomKillsBefore, stat.OOMKills)
+	step.oomKillsBefore = &stat.OOMKills
+
+	if count == 0 {
+		return nil
+	}
+
+	oomKill := &OOMKill{
+		Count: count,
+		Peak:  float64(stat.Peak),
+		Limit: float64(stat.Limit),
+	}
+
+	// Fall back to the sampled memory usage when the kernel doesn't track the peak
+	if oomKill.Peak == 0 && step.memory.count != 0 {
+		oomKill.Peak = step.memory.peak
+	}
+
+	return oomKill
+}
+
+func (collector *Collector) oomKills() *uint64 {
+	if collector.memoryEventsSource == nil {
+		return nil
+	}
+
+	stat, err := collector.memoryEventsSource.MemoryEvents(context.Background())
+	if err != nil {
+		return nil
+	}
+
+	return &stat.OOMKills
+}
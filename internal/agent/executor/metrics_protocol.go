@@ -12,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/metrics"
+	"github.com/cirruslabs/cirrus-cli/internal/githubactions"
 	"github.com/cirruslabs/cirrus-cli/pkg/api"
 	"github.com/cirruslabs/omni-cache/pkg/protocols"
 	"github.com/dustin/go-humanize"
@@ -89,6 +90,15 @@ type metricsSnapshot struct {
 	CPUError    string  `json:"cpu_error,omitempty"`
 	MemoryError string  `json:"memory_error,omitempty"`
 	TotalsError string  `json:"totals_error,omitempty"`
+
+	CurrentStep         string  `json:"current_step,omitempty"`
+	DiskReadRate        float64 `json:"disk_read_rate,omitempty"`
+	DiskWriteRate       float64 `json:"disk_write_rate,omitempty"`
+	NetworkReceiveRate  float64 `json:"network_receive_rate,omitempty"`
+	NetworkTransmitRate float64 `json:"network_transmit_rate,omitempty"`
+	CPUPressure         float64 `json:"cpu_pressure,omitempty"`
+	MemoryPressure      float64 `json:"memory_pressure,omitempty"`
+	IOPressure          float64 `json:"io_pressure,omitempty"`
 }
 
 func snapshotToResponse(snapshot metrics.Snapshot) metricsSnapshot {
@@ -97,6 +107,15 @@ func snapshotToResponse(snapshot metrics.Snapshot) metricsSnapshot {
 		MemoryUsed:  snapshot.MemoryUsed,
 		CPUTotal:    snapshot.CPUTotal,
 		MemoryTotal: snapshot.MemoryTotal,
+
+		CurrentStep:         snapshot.CurrentStep,
+		DiskReadRate:        snapshot.DiskReadRate,
+		DiskWriteRate:       snapshot.DiskWriteRate,
+		NetworkReceiveRate:  snapshot.NetworkReceiveRate,
+		NetworkTransmitRate: snapshot.NetworkTransmitRate,
+		CPUPressure:         snapshot.CPUPressure,
+		MemoryPressure:      snapshot.MemoryPressure,
+		IOPressure:          snapshot.IOPressure,
 	}
 
 	if !snapshot.Timestamp.IsZero() {
@@ -151,8 +170,33 @@ func formatMetricsSummary(snapshot metrics.Snapshot, utilization *api.ResourceUt
 		fmt.Fprintf(&builder, "memory: %s\n", humanize.Bytes(memoryUsed))
 	}
 
+	if utilization != nil && len(utilization.DiskReadChart) > 0 {
+		fmt.Fprintf(&builder, "disk: read %s/s, write %s/s\n",
+			humanize.Bytes(uint64(max(snapshot.DiskReadRate, 0.0))),
+			humanize.Bytes(uint64(max(snapshot.DiskWriteRate, 0.0))))
+	}
+	if utilization != nil && len(utilization.NetworkReceiveChart) > 0 {
+		fmt.Fprintf(&builder, "network: receive %s/s, transmit %s/s\n",
+			humanize.Bytes(uint64(max(snapshot.NetworkReceiveRate, 0.0))),
+			humanize.Bytes(uint64(max(snapshot.NetworkTransmitRate, 0.0))))
+	}
+	if utilization != nil && len(utilization.CpuPressureChart) > 0 {
+		fmt.Fprintf(&builder, "pressure: cpu=%.2f%% memory=%.2f%% io=%.2f%%\n",
+			snapshot.CPUPressure, snapshot.MemoryPressure, snapshot.IOPressure)
+	}
+	if snapshot.CurrentStep != "" {
+		fmt.Fprintf(&builder, "step: %s\n", snapshot.CurrentStep)
+	}
+
 	if utilization != nil {
 		fmt.Fprintf(&builder, "points: cpu=%d memory=%d\n", len(utilization.CpuChart), len(utilization.MemoryChart))
+
+		if len(utilization.CommandUtilization) > 0 {
+			builder.WriteString("commands:\n")
+			for _, command := range utilization.CommandUtilization {
+				fmt.Fprintf(&builder, "  %s\n", formatCommandUtilization(command))
+			}
+		}
 	}
 
 	if snapshot.CPUError != nil || snapshot.MemoryError != nil || snapshot.TotalsError != nil {
@@ -209,6 +253,10 @@ func formatGithubActionsNotice(snapshot metrics.Snapshot, utilization *api.Resou
 	notice := fmt.Sprintf("::notice title=Resource Utilization::%s", message)
 	lines := []string{notice}
 
+	if commandsNotice := formatGithubActionsCommandsNotice(utilization); commandsNotice != "" {
+		lines = append(lines, commandsNotice)
+	}
+
 	warnOnTotals := !(snapshot.CPUIsCgroup || snapshot.MemoryIsCgroup)
 	cpuBelow := warnOnTotals && cpuOK && cpuTotal > 0 && cpuPeak < (cpuTotal*0.5)
 	memBelow := warnOnTotals && memOK && memTotal > 0 && memPeak < (memTotal*0.5)
@@ -224,6 +272,56 @@ func formatGithubActionsNotice(snapshot metrics.Snapshot, utilization *api.Resou
 	return strings.Join(lines, "\n")
 }
 
+func formatGithubActionsCommandsNotice(utilization *api.ResourceUtilization) string {
+	if utilization == nil || len(utilization.CommandUtilization) == 0 {
+		return ""
+	}
+
+	parts := make([]string, 0, len(utilization.CommandUtilization))
+	for _, command := range utilization.CommandUtilization {
+		parts = append(parts, githubactions.EscapeData(formatCommandUtilization(command)))
+	}
+
+	return fmt.Sprintf("::notice title=Resource Utilization per Command::%s", strings.Join(parts, "%0A"))
+}
+
+// formatCommandUtilization renders a single line summary of the command's resource utilization.
+func formatCommandUtilization(command *api.CommandResourceUtilization) string {
+	duration := command.FinishedAtSecondsFromStart - min(command.StartedAtSecondsFromStart,
+		command.FinishedAtSecondsFromStart)
+
+	var parts []string
+	if cpu := command.Cpu; cpu != nil {
+		parts = append(parts, fmt.Sprintf("CPU peak %.2f cores, average %.2f cores", cpu.Peak, cpu.Average))
+	}
+	if memory := command.Memory; memory != nil {
+		parts = append(parts, fmt.Sprintf("memory peak %s, average %s",
+			humanize.Bytes(uint64(max(memory.Peak, 0.0))),
+			humanize.Bytes(uint64(max(memory.Average, 0.0)))))
+	}
+	if command.DiskRead != nil && command.DiskWrite != nil {
+		parts = append(parts, fmt.Sprintf("disk %s read, %s written",
+			humanize.Bytes(uint64(max(command.DiskRead.Total, 0.0))),
+			humanize.Bytes(uint64(max(command.DiskWrite.Total, 0.0)))))
+	}
+	if command.NetworkReceive != nil && command.NetworkTransmit != nil {
+		parts = append(parts, fmt.Sprintf("network %s received, %s sent",
+			humanize.Bytes(uint64(max(command.NetworkReceive.Total, 0.0))),
+			humanize.Bytes(uint64(max(command.NetworkTransmit.Total, 0.0)))))
+	}
+	if command.CpuPressure != nil && command.MemoryPressure != nil && command.IoPressure != nil {
+		parts = append(parts, fmt.Sprintf("peak pressure cpu=%.2f%% memory=%.2f%% io=%.2f%%",
+			command.CpuPressure.Peak, command.MemoryPressure.Peak, command.IoPressure.Peak))
+	}
+
+	summary := fmt.Sprintf("%s (%ds)", command.Name, duration)
+	if len(parts) == 0 {
+		return summary + ": no samples"
+	}
+
+	return summary + ": " + strings.Join(parts, "; ")
+}
+
 func formatGithubActionsASCIIChart(
 	utilization *api.ResourceUtilization,
 	cpuTotal float64,
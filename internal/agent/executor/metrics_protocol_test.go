@@ -321,3 +321,82 @@ func TestNormalizeXCoordinatesKeepsLatestValuePerSecond(t *testing.T) {
 		require.InDelta(t, expected[i], normalized[i], 0.0001)
 	}
 }
+
+func TestFormatGithubActionsNoticeWithCommands(t *testing.T) {
+	utilization := &api.ResourceUtilization{
+		CpuChart: []*api.ChartPoint{
+			{SecondsFromStart: 2, Value: 1.5},
+		},
+		CommandUtilization: []*api.CommandResourceUtilization{
+			{
+				Name:                       "clone",
+				StartedAtSecondsFromStart:  0,
+				FinishedAtSecondsFromStart: 3,
+				Cpu:                        &api.UtilizationSummary{Peak: 1.5, Average: 0.75},
+				Memory:                     &api.UtilizationSummary{Peak: 200_000_000, Average: 100_000_000},
+				NetworkReceive:             &api.UtilizationSummary{Total: 50_000_000},
+				NetworkTransmit:            &api.UtilizationSummary{Total: 1_000_000},
+			},
+			{
+				Name:                       "100% coverage",
+				StartedAtSecondsFromStart:  3,
+				FinishedAtSecondsFromStart: 3,
+			},
+		},
+	}
+
+	lines := strings.Split(formatGithubActionsNotice(metrics.Snapshot{}, utilization), "\n")
+
+	require.Len(t, lines, 2)
+	require.Equal(t, "::notice title=Resource Utilization per Command::"+
+		"clone (3s): CPU peak 1.50 cores, average 0.75 cores; memory peak 200 MB, average 100 MB; "+
+		"network 50 MB received, 1.0 MB sent%0A"+
+		"100%25 coverage (0s): no samples", lines[1])
+}
+
+func TestFormatMetricsSummaryWithSteps(t *testing.T) {
+	snapshot := metrics.Snapshot{
+		CPUUsed:             1,
+		CPUTotal:            2,
+		MemoryUsed:          1_000_000_000,
+		MemoryTotal:         2_000_000_000,
+		CurrentStep:         "test",
+		DiskReadRate:        1_000_000,
+		DiskWriteRate:       2_000_000,
+		NetworkReceiveRate:  3_000_000,
+		NetworkTransmitRate: 4_000_000,
+		CPUPressure:         10,
+		MemoryPressure:      20,
+		IOPressure:          30,
+	}
+	utilization := &api.ResourceUtilization{
+		CpuChart:            []*api.ChartPoint{{SecondsFromStart: 1, Value: 1}},
+		MemoryChart:         []*api.ChartPoint{{SecondsFromStart: 1, Value: 1_000_000_000}},
+		DiskReadChart:       []*api.ChartPoint{{SecondsFromStart: 1, Value: 1_000_000}},
+		NetworkReceiveChart: []*api.ChartPoint{{SecondsFromStart: 1, Value: 3_000_000}},
+		CpuPressureChart:    []*api.ChartPoint{{SecondsFromStart: 1, Value: 10}},
+		CommandUtilization: []*api.CommandResourceUtilization{
+			{
+				Name:                       "build",
+				FinishedAtSecondsFromStart: 1,
+				DiskRead:                   &api.UtilizationSummary{Total: 5_000_000},
+				DiskWrite:                  &api.UtilizationSummary{Total: 6_000_000},
+				CpuPressure:                &api.UtilizationSummary{Peak: 1},
+				MemoryPressure:             &api.UtilizationSummary{Peak: 2},
+				IoPressure:                 &api.UtilizationSummary{Peak: 3},
+			},
+		},
+	}
+
+	require.Equal(t, `agent metrics
+cpu: 1.00 cores (50.00% of 2.00)
+memory: 1.0 GB / 2.0 GB (50.00%)
+disk: read 1.0 MB/s, write 2.0 MB/s
+network: receive 3.0 MB/s, transmit 4.0 MB/s
+pressure: cpu=10.00% memory=20.00% io=30.00%
+step: test
+points: cpu=1 memory=1
+commands:
+  build (1s): disk 5.0 MB read, 6.0 MB written; peak pressure cpu=1.00% memory=2.00% io=3.00%
+`, formatMetricsSummary(snapshot, utilization))
+}
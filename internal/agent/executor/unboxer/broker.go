@@ -0,0 +1,151 @@
+package unboxer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/cirruslabs/cirrus-cli/internal/agent/environment"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/vaultunboxer"
+)
+
+const (
+	PrefixBroker = "BROKER"
+
+	EnvCirrusSecretBrokerURL      = "CIRRUS_SECRET_BROKER_URL"
+	EnvCirrusSecretBrokerToken    = "CIRRUS_SECRET_BROKER_TOKEN"
+	EnvCirrusSecretBrokerAudience = "CIRRUS_SECRET_BROKER_AUDIENCE"
+
+	// https://datatracker.ietf.org/doc/html/rfc8693
+	tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	jwtTokenType           = "urn:ietf:params:oauth:token-type:jwt"
+)
+
+var ErrBroker = errors.New("secret broker request failed")
+
+// Broker retrieves the secrets from an HTTP secret broker, which serves them as JSON documents
+// at /v1/secrets/<path> to the bearers of the access tokens issued by its /v1/token endpoint
+// in exchange for the task's OIDC token.
+type Broker struct {
+	baseURL     *url.URL
+	accessToken string
+	httpClient  *http.Client
+	cache       *cache
+}
+
+func NewBrokerFromEnvironment(ctx context.Context, env *environment.Environment) (Unboxer, error) {
+	rawURL, ok := env.Lookup(EnvCirrusSecretBrokerURL)
+	if !ok {
+		return nil, fmt.Errorf("found %s[...] environment variables, but no %s variable was provided",
+			PrefixBroker, EnvCirrusSecretBrokerURL)
+	}
+
+	baseURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	broker := &Broker{
+		baseURL:    baseURL,
+		httpClient: http.DefaultClient,
+		cache:      newCache(),
+	}
+
+	if accessToken, ok := env.Lookup(EnvCirrusSecretBrokerToken); ok {
+		broker.accessToken = accessToken
+	} else if jwtToken, ok := env.Lookup("CIRRUS_OIDC_TOKEN"); ok {
+		broker.accessToken, err = broker.exchangeToken(ctx, jwtToken, env.Get(EnvCirrusSecretBrokerAudience))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		return nil, fmt.Errorf("neither %s nor CIRRUS_OIDC_TOKEN variable was provided",
+			EnvCirrusSecretBrokerToken)
+	}
+
+	return broker, nil
+}
+
+func (broker *Broker) exchangeToken(ctx context.Context, jwtToken string, audience string) (string, error) {
+	form := url.Values{
+		"grant_type":         {tokenExchangeGrantType},
+		"subject_token":      {jwtToken},
+		"subject_token_type": {jwtTokenType},
+	}
+	if audience != "" {
+		form.Set("audience", audience)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, broker.baseURL.JoinPath("v1", "token").String(),
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var response struct {
+		AccessToken string `json:"access_token"`
+	}
+
+	if err := broker.do(request, &response); err != nil {
+		return "", fmt.Errorf("failed to exchange the OIDC token: %w", err)
+	}
+
+	if response.AccessToken == "" {
+		return "", fmt.Errorf("%w: token exchange response contains no access token", ErrBroker)
+	}
+
+	return response.AccessToken, nil
+}
+
+func (broker *Broker) Unbox(ctx context.Context, value *vaultunboxer.BoxedValue) (string, error) {
+	data, err := broker.cache.fetch(value, func() (interface{}, error) {
+		secretURL := broker.baseURL.JoinPath("v1", "secrets", value.Path())
+		secretURL.RawQuery = url.Values(value.VaultPathArgs()).Encode()
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, secretURL.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		request.Header.Set("Authorization", "Bearer "+broker.accessToken)
+
+		var data interface{}
+
+		if err := broker.do(request, &data); err != nil {
+			return nil, err
+		}
+
+		return data, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return value.Select(data)
+}
+
+func (broker *Broker) do(request *http.Request, result interface{}) error {
+	response, err := broker.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrBroker, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+
+		return fmt.Errorf("%w: %s %s returned HTTP %d: %s", ErrBroker, request.Method, request.URL.Path,
+			response.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(result); err != nil {
+		return fmt.Errorf("%w: failed to decode the response: %v", ErrBroker, err)
+	}
+
+	return nil
+}
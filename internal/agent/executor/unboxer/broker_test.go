@@ -0,0 +1,82 @@
+package unboxer_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/agent/environment"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/unboxer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrokerTokenExchange(t *testing.T) {
+	var secretRequests int
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/token", func(writer http.ResponseWriter, request *http.Request) {
+		require.NoError(t, request.ParseForm())
+
+		if request.Form.Get("grant_type") != "urn:ietf:params:oauth:grant-type:token-exchange" ||
+			request.Form.Get("subject_token") != "oidc-token" || request.Form.Get("audience") != "ci" {
+			writer.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		_ = json.NewEncoder(writer).Encode(map[string]string{"access_token": "access-token"})
+	})
+	mux.HandleFunc("GET /v1/secrets/team/deploy", func(writer http.ResponseWriter, request *http.Request) {
+		if request.Header.Get("Authorization") != "Bearer access-token" {
+			writer.WriteHeader(http.StatusForbidden)
+
+			return
+		}
+
+		secretRequests++
+
+		_ = json.NewEncoder(writer).Encode(map[string]interface{}{
+			"data": map[string]string{"password": "version " + request.URL.Query().Get("version")},
+		})
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	registry := unboxer.NewDefaultRegistry(environment.New(map[string]string{
+		unboxer.EnvCirrusSecretBrokerURL:      server.URL,
+		unboxer.EnvCirrusSecretBrokerAudience: "ci",
+		"CIRRUS_OIDC_TOKEN":                   "oidc-token",
+	}))
+
+	for range 2 {
+		value, err := registry.Unbox(context.Background(), "BROKER[team/deploy data.password version=2]")
+		require.NoError(t, err)
+		require.Equal(t, "version 2", value)
+	}
+	require.Equal(t, 1, secretRequests)
+
+	_, err := registry.Unbox(context.Background(), "BROKER_NOCACHE[team/deploy data.password version=2]")
+	require.NoError(t, err)
+	require.Equal(t, 2, secretRequests)
+
+	_, err = registry.Unbox(context.Background(), "BROKER[team/missing data.password]")
+	require.ErrorIs(t, err, unboxer.ErrBroker)
+}
+
+func TestBrokerFailedTokenExchange(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	registry := unboxer.NewDefaultRegistry(environment.New(map[string]string{
+		unboxer.EnvCirrusSecretBrokerURL: server.URL,
+		"CIRRUS_OIDC_TOKEN":              "oidc-token",
+	}))
+
+	_, err := registry.Unbox(context.Background(), "BROKER[team/deploy data.password]")
+	require.ErrorIs(t, err, unboxer.ErrBroker)
+}
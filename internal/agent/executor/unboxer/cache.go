@@ -0,0 +1,46 @@
+package unboxer
+
+import (
+	"fmt"
+
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/vaultunboxer"
+)
+
+type cachedData struct {
+	data interface{}
+	err  error
+}
+
+// cache mimics the Vault unboxer's caching: the results are always cached,
+// even the negative ones, but only PREFIX[...] values are served from cache.
+type cache struct {
+	entries map[string]*cachedData
+}
+
+func newCache() *cache {
+	return &cache{
+		entries: map[string]*cachedData{},
+	}
+}
+
+func (cache *cache) fetch(
+	value *vaultunboxer.BoxedValue,
+	retrieve func() (interface{}, error),
+) (interface{}, error) {
+	cacheKey := fmt.Sprintf("%s %v", value.Path(), value.VaultPathArgs())
+
+	if value.UseCache() {
+		if cached, ok := cache.entries[cacheKey]; ok {
+			return cached.data, cached.err
+		}
+	}
+
+	data, err := retrieve()
+
+	cache.entries[cacheKey] = &cachedData{
+		data: data,
+		err:  err,
+	}
+
+	return data, err
+}
@@ -0,0 +1,74 @@
+package unboxer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cirruslabs/cirrus-cli/internal/agent/environment"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/vaultunboxer"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	PrefixHostFile = "HOSTFILE"
+
+	EnvCirrusHostSecretsDir = "CIRRUS_HOST_SECRETS_DIR"
+)
+
+var ErrHostFile = errors.New("failed to read the host secret file")
+
+// HostFile retrieves the secrets from the JSON or YAML files in a directory
+// that the worker mounts from its host, e.g. using Tart's "dirs:".
+type HostFile struct {
+	dir   string
+	cache *cache
+}
+
+func NewHostFileFromEnvironment(_ context.Context, env *environment.Environment) (Unboxer, error) {
+	dir, ok := env.Lookup(EnvCirrusHostSecretsDir)
+	if !ok {
+		return nil, fmt.Errorf("found %s[...] environment variables, but no %s variable was provided",
+			PrefixHostFile, EnvCirrusHostSecretsDir)
+	}
+
+	return &HostFile{
+		dir:   dir,
+		cache: newCache(),
+	}, nil
+}
+
+func (hostFile *HostFile) Unbox(_ context.Context, value *vaultunboxer.BoxedValue) (string, error) {
+	if len(value.VaultPathArgs()) != 0 {
+		return "", fmt.Errorf("%w: arguments are not supported", ErrHostFile)
+	}
+
+	// Only allow the files inside of the secrets directory
+	if !filepath.IsLocal(value.Path()) {
+		return "", fmt.Errorf("%w: path %q should be relative to the %s and cannot escape it",
+			ErrHostFile, value.Path(), EnvCirrusHostSecretsDir)
+	}
+
+	data, err := hostFile.cache.fetch(value, func() (interface{}, error) {
+		fileBytes, err := os.ReadFile(filepath.Join(hostFile.dir, value.Path()))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrHostFile, err)
+		}
+
+		// YAML is a superset of JSON, so this handles both
+		var data interface{}
+
+		if err := yaml.Unmarshal(fileBytes, &data); err != nil {
+			return nil, fmt.Errorf("%w: failed to parse %s: %v", ErrHostFile, value.Path(), err)
+		}
+
+		return data, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return value.Select(data)
+}
@@ -0,0 +1,52 @@
+package unboxer_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/agent/environment"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/unboxer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostFile(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "secrets.json"),
+		[]byte(`{"aws": {"access_key": "from-json"}}`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "secrets.yml"),
+		[]byte("aws:\n  access_key: from-yaml\n"), 0600))
+
+	registry := unboxer.NewDefaultRegistry(environment.New(map[string]string{
+		unboxer.EnvCirrusHostSecretsDir: dir,
+	}))
+
+	value, err := registry.Unbox(context.Background(), "HOSTFILE[secrets.json aws.access_key]")
+	require.NoError(t, err)
+	require.Equal(t, "from-json", value)
+
+	value, err = registry.Unbox(context.Background(), "HOSTFILE[secrets.yml aws.access_key]")
+	require.NoError(t, err)
+	require.Equal(t, "from-yaml", value)
+
+	// Updates are only picked up when not using the cache
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "secrets.yml"),
+		[]byte("aws:\n  access_key: updated\n"), 0600))
+
+	value, err = registry.Unbox(context.Background(), "HOSTFILE[secrets.yml aws.access_key]")
+	require.NoError(t, err)
+	require.Equal(t, "from-yaml", value)
+
+	value, err = registry.Unbox(context.Background(), "HOSTFILE_NOCACHE[secrets.yml aws.access_key]")
+	require.NoError(t, err)
+	require.Equal(t, "updated", value)
+
+	// Files outside of the secrets directory are not accessible
+	_, err = registry.Unbox(context.Background(), "HOSTFILE[../secrets.json aws.access_key]")
+	require.ErrorIs(t, err, unboxer.ErrHostFile)
+
+	_, err = registry.Unbox(context.Background(), "HOSTFILE[/etc/passwd root]")
+	require.ErrorIs(t, err, unboxer.ErrHostFile)
+}
@@ -0,0 +1,139 @@
+package unboxer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cirruslabs/cirrus-cli/internal/agent/environment"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/vaultunboxer"
+	vault "github.com/hashicorp/vault/api"
+)
+
+const (
+	PrefixOpenBao = "OPENBAO"
+
+	EnvCirrusOpenBaoURL       = "CIRRUS_OPENBAO_URL"
+	EnvCirrusOpenBaoToken     = "CIRRUS_OPENBAO_TOKEN"
+	EnvCirrusOpenBaoNamespace = "CIRRUS_OPENBAO_NAMESPACE"
+	EnvCirrusOpenBaoRole      = "CIRRUS_OPENBAO_ROLE"
+	EnvCirrusOpenBaoAuthPath  = "CIRRUS_OPENBAO_AUTH_PATH"
+	EnvCirrusOpenBaoWrapTTL   = "CIRRUS_OPENBAO_WRAP_TTL"
+
+	defaultWrapTTL = "60s"
+)
+
+var ErrOpenBao = errors.New("OpenBao request failed")
+
+// OpenBao retrieves the secrets from an OpenBao or any other KV-compatible API
+// using response wrapping[1], so that the secret is only ever transmitted
+// in the response to a single-use unwrap request.
+//
+// [1]: https://openbao.org/docs/concepts/response-wrapping/
+type OpenBao struct {
+	client *vault.Client
+	cache  *cache
+}
+
+func NewOpenBaoFromEnvironment(ctx context.Context, env *environment.Environment) (Unboxer, error) {
+	client, err := vault.NewClient(vault.DefaultConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	url, ok := env.Lookup(EnvCirrusOpenBaoURL)
+	if !ok {
+		return nil, fmt.Errorf("found %s[...] environment variables, but no %s variable was provided",
+			PrefixOpenBao, EnvCirrusOpenBaoURL)
+	}
+
+	if err := client.SetAddress(url); err != nil {
+		return nil, err
+	}
+
+	if namespace, ok := env.Lookup(EnvCirrusOpenBaoNamespace); ok {
+		client.SetNamespace(namespace)
+	}
+
+	if token, ok := env.Lookup(EnvCirrusOpenBaoToken); ok {
+		client.SetToken(token)
+	} else if jwtToken, ok := env.Lookup("CIRRUS_OIDC_TOKEN"); ok {
+		auth := &vaultunboxer.JWTAuth{
+			Token: jwtToken,
+			Role:  env.Get(EnvCirrusOpenBaoRole),
+			Path:  env.Get(EnvCirrusOpenBaoAuthPath),
+		}
+
+		if _, err := client.Auth().Login(ctx, auth); err != nil {
+			return nil, err
+		}
+	} else {
+		// Don't fall back to the VAULT_TOKEN from the agent's own environment
+		return nil, fmt.Errorf("neither %s nor CIRRUS_OIDC_TOKEN variable was provided",
+			EnvCirrusOpenBaoToken)
+	}
+
+	wrapTTL := defaultWrapTTL
+	if value, ok := env.Lookup(EnvCirrusOpenBaoWrapTTL); ok {
+		wrapTTL = value
+	}
+
+	// Only wrap the reads, the login and the unwrap requests should be left as is
+	client.SetWrappingLookupFunc(func(operation, _ string) string {
+		if operation == http.MethodGet {
+			return wrapTTL
+		}
+
+		return ""
+	})
+
+	return &OpenBao{
+		client: client,
+		cache:  newCache(),
+	}, nil
+}
+
+func (openBao *OpenBao) Unbox(ctx context.Context, value *vaultunboxer.BoxedValue) (string, error) {
+	data, err := openBao.cache.fetch(value, func() (interface{}, error) {
+		return openBao.read(ctx, value)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return value.Select(data)
+}
+
+func (openBao *OpenBao) read(ctx context.Context, value *vaultunboxer.BoxedValue) (interface{}, error) {
+	wrappedSecret, err := openBao.client.Logical().ReadWithDataWithContext(ctx, value.Path(), value.VaultPathArgs())
+	if err != nil {
+		return nil, err
+	}
+
+	if wrappedSecret == nil {
+		return nil, fmt.Errorf("%w: associated secret doesn't exist", ErrOpenBao)
+	}
+
+	if wrappedSecret.WrapInfo == nil {
+		return nil, fmt.Errorf("%w: response wasn't wrapped", ErrOpenBao)
+	}
+
+	// Unwrap using the wrapping token itself, which can only be used once
+	unwrapClient, err := openBao.client.CloneWithHeaders()
+	if err != nil {
+		return nil, err
+	}
+	unwrapClient.SetToken(wrappedSecret.WrapInfo.Token)
+
+	secret, err := unwrapClient.Logical().UnwrapWithContext(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to unwrap the response: %v", ErrOpenBao, err)
+	}
+
+	if secret == nil || secret.Data == nil {
+		return nil, fmt.Errorf("%w: associated secret contains no data", ErrOpenBao)
+	}
+
+	return secret.Data, nil
+}
@@ -0,0 +1,93 @@
+package unboxer_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/agent/environment"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/unboxer"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeOpenBao is a minimal KV v2 API that only responds with wrapped secrets.
+type fakeOpenBao struct {
+	wrapped map[string]map[string]interface{}
+	mtx     sync.Mutex
+}
+
+func (fake *fakeOpenBao) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	fake.mtx.Lock()
+	defer fake.mtx.Unlock()
+
+	switch {
+	case request.Method == http.MethodGet && request.URL.Path == "/v1/secret/data/keys":
+		if request.Header.Get("X-Vault-Token") != "root" || request.Header.Get("X-Vault-Wrap-TTL") != "30s" {
+			writer.WriteHeader(http.StatusForbidden)
+
+			return
+		}
+
+		wrappingToken := uuid.NewString()
+		fake.wrapped[wrappingToken] = map[string]interface{}{
+			"data": map[string]interface{}{"admin": "secret key value"},
+		}
+
+		_ = json.NewEncoder(writer).Encode(map[string]interface{}{
+			"wrap_info": map[string]interface{}{"token": wrappingToken, "ttl": 30},
+		})
+	case request.Method == http.MethodPut && request.URL.Path == "/v1/sys/wrapping/unwrap":
+		// Wrapping tokens are single-use
+		data, ok := fake.wrapped[request.Header.Get("X-Vault-Token")]
+		if !ok {
+			writer.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(writer).Encode(map[string]interface{}{"errors": []string{"wrapping token is not valid"}})
+
+			return
+		}
+		delete(fake.wrapped, request.Header.Get("X-Vault-Token"))
+
+		_ = json.NewEncoder(writer).Encode(map[string]interface{}{"data": data})
+	default:
+		writer.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestOpenBaoResponseWrapping(t *testing.T) {
+	fake := &fakeOpenBao{wrapped: map[string]map[string]interface{}{}}
+
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	registry := unboxer.NewDefaultRegistry(environment.New(map[string]string{
+		unboxer.EnvCirrusOpenBaoURL:     server.URL,
+		unboxer.EnvCirrusOpenBaoToken:   "root",
+		unboxer.EnvCirrusOpenBaoWrapTTL: "30s",
+	}))
+
+	value, err := registry.Unbox(context.Background(), "OPENBAO[secret/data/keys data.admin]")
+	require.NoError(t, err)
+	require.Equal(t, "secret key value", value)
+
+	// All wrapping tokens were consumed
+	require.Empty(t, fake.wrapped)
+
+	_, err = registry.Unbox(context.Background(), "OPENBAO[secret/data/missing data.admin]")
+	require.Error(t, err)
+}
+
+func TestOpenBaoRequiresToken(t *testing.T) {
+	t.Setenv("VAULT_TOKEN", "agent-token")
+
+	registry := unboxer.NewDefaultRegistry(environment.New(map[string]string{
+		unboxer.EnvCirrusOpenBaoURL: "http://127.0.0.1:8200",
+	}))
+
+	_, err := registry.Unbox(context.Background(), "OPENBAO[secret/data/keys data.admin]")
+	require.ErrorContains(t, err, unboxer.EnvCirrusOpenBaoToken)
+	require.ErrorContains(t, err, "CIRRUS_OIDC_TOKEN")
+}
@@ -0,0 +1,105 @@
+// Package unboxer resolves the boxed environment variable values,
+// such as VAULT[...], using the registered secret backends.
+package unboxer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/cirruslabs/cirrus-cli/internal/agent/environment"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/vaultunboxer"
+)
+
+const PrefixVault = "VAULT"
+
+// Unboxer retrieves the secret that the boxed value points to.
+type Unboxer interface {
+	Unbox(ctx context.Context, value *vaultunboxer.BoxedValue) (string, error)
+}
+
+// Factory initializes an Unboxer from the task's environment, it's only
+// called once the first value boxed with the corresponding prefix is found.
+type Factory func(ctx context.Context, env *environment.Environment) (Unboxer, error)
+
+type backend struct {
+	prefix  string
+	name    string
+	factory Factory
+}
+
+type Registry struct {
+	env      *environment.Environment
+	backends []backend
+	unboxers map[string]Unboxer
+}
+
+func NewRegistry(env *environment.Environment) *Registry {
+	return &Registry{
+		env:      env,
+		unboxers: map[string]Unboxer{},
+	}
+}
+
+// NewDefaultRegistry returns a registry with all the supported secret backends registered.
+func NewDefaultRegistry(env *environment.Environment) *Registry {
+	registry := NewRegistry(env)
+
+	registry.Register(PrefixVault, "Vault", func(ctx context.Context, env *environment.Environment) (Unboxer, error) {
+		return vaultunboxer.NewFromEnvironment(ctx, env)
+	})
+	registry.Register(PrefixBroker, "secret broker", NewBrokerFromEnvironment)
+	registry.Register(PrefixHostFile, "host file", NewHostFileFromEnvironment)
+	registry.Register(PrefixOpenBao, "OpenBao", NewOpenBaoFromEnvironment)
+
+	return registry
+}
+
+// Register adds a backend that handles the PREFIX[...] and PREFIX_NOCACHE[...] values.
+func (registry *Registry) Register(prefix string, name string, factory Factory) {
+	registry.backends = append(registry.backends, backend{
+		prefix:  prefix,
+		name:    name,
+		factory: factory,
+	})
+}
+
+// Unbox returns vaultunboxer.ErrNotABoxedValue when the raw value
+// is not boxed with any of the registered prefixes.
+func (registry *Registry) Unbox(ctx context.Context, rawValue string) (string, error) {
+	for _, backend := range registry.backends {
+		boxedValue, err := vaultunboxer.NewBoxedValueWithPrefix(rawValue, backend.prefix)
+		if err != nil {
+			if errors.Is(err, vaultunboxer.ErrNotABoxedValue) {
+				continue
+			}
+
+			return "", fmt.Errorf("failed to parse a %s[...] value %s: %w", backend.prefix, rawValue, err)
+		}
+
+		unboxer, ok := registry.unboxers[backend.prefix]
+		if !ok {
+			slog.Info(fmt.Sprintf("Found at least one %s[...] environment variable, initializing %s client",
+				backend.prefix, backend.name))
+
+			unboxer, err = backend.factory(ctx, registry.env)
+			if err != nil {
+				return "", fmt.Errorf("failed to initialize a %s client: %w", backend.name, err)
+			}
+
+			registry.unboxers[backend.prefix] = unboxer
+
+			slog.Info(fmt.Sprintf("%s client successfully initialized", backend.name))
+		}
+
+		unboxedValue, err := unboxer.Unbox(ctx, boxedValue)
+		if err != nil {
+			return "", fmt.Errorf("failed to unbox a %s[...] value %s: %w", backend.prefix, rawValue, err)
+		}
+
+		return unboxedValue, nil
+	}
+
+	return "", vaultunboxer.ErrNotABoxedValue
+}
@@ -0,0 +1,50 @@
+package unboxer_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/agent/environment"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/unboxer"
+	"github.com/cirruslabs/cirrus-cli/internal/agent/executor/vaultunboxer"
+	"github.com/stretchr/testify/require"
+)
+
+type staticUnboxer struct {
+	data map[string]interface{}
+}
+
+func (staticUnboxer *staticUnboxer) Unbox(_ context.Context, value *vaultunboxer.BoxedValue) (string, error) {
+	return value.Select(staticUnboxer.data[value.Path()])
+}
+
+func TestRegistry(t *testing.T) {
+	var initializations int
+
+	registry := unboxer.NewRegistry(environment.NewEmpty())
+	registry.Register("STATIC", "static", func(context.Context, *environment.Environment) (unboxer.Unboxer, error) {
+		initializations++
+
+		return &staticUnboxer{data: map[string]interface{}{
+			"path": map[string]interface{}{"key": "value"},
+		}}, nil
+	})
+
+	_, err := registry.Unbox(context.Background(), "not boxed")
+	require.ErrorIs(t, err, vaultunboxer.ErrNotABoxedValue)
+
+	_, err = registry.Unbox(context.Background(), "VAULT[path key]")
+	require.ErrorIs(t, err, vaultunboxer.ErrNotABoxedValue)
+
+	_, err = registry.Unbox(context.Background(), "STATIC[path]")
+	require.ErrorIs(t, err, vaultunboxer.ErrInvalidBoxedValue)
+
+	for _, rawValue := range []string{"STATIC[path key]", "STATIC_NOCACHE[path key]"} {
+		value, err := registry.Unbox(context.Background(), rawValue)
+		require.NoError(t, err)
+		require.Equal(t, "value", value)
+	}
+
+	// Backends are initialized lazily and only once
+	require.Equal(t, 1, initializations)
+}
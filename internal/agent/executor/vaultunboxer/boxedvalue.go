@@ -15,8 +15,8 @@ type BoxedValue struct {
 }
 
 const (
-	prefixNormal  = "VAULT["
-	prefixNocache = "VAULT_NOCACHE["
+	prefixVault   = "VAULT"
+	nocacheSuffix = "_NOCACHE"
 	suffix        = "]"
 )
 
@@ -26,12 +26,19 @@ var (
 )
 
 func NewBoxedValue(rawBoxedValue string) (*BoxedValue, error) {
+	return NewBoxedValueWithPrefix(rawBoxedValue, prefixVault)
+}
+
+// NewBoxedValueWithPrefix parses the values in the form of PREFIX[path selector args...]
+// and PREFIX_NOCACHE[path selector args...], which allows other secret backends
+// to reuse the same syntax, caching and data path selection semantics.
+func NewBoxedValueWithPrefix(rawBoxedValue string, prefix string) (*BoxedValue, error) {
 	var useCache bool
 
-	if trimmed := strings.TrimPrefix(rawBoxedValue, prefixNormal); trimmed != rawBoxedValue {
+	if trimmed := strings.TrimPrefix(rawBoxedValue, prefix+"["); trimmed != rawBoxedValue {
 		rawBoxedValue = trimmed
 		useCache = true
-	} else if trimmed := strings.TrimPrefix(rawBoxedValue, prefixNocache); trimmed != rawBoxedValue {
+	} else if trimmed := strings.TrimPrefix(rawBoxedValue, prefix+nocacheSuffix+"["); trimmed != rawBoxedValue {
 		rawBoxedValue = trimmed
 		useCache = false
 	} else {
@@ -83,6 +90,10 @@ func NewBoxedValue(rawBoxedValue string) (*BoxedValue, error) {
 	}, nil
 }
 
+func (value *BoxedValue) Path() string {
+	return value.vaultPath
+}
+
 func (value *BoxedValue) UseCache() bool {
 	return value.useCache
 }
@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 )
 
 const DEFAULT_BUFFER_SIZE = 1024 * 1024
@@ -24,21 +25,40 @@ func Archive(baseFolder string, folderPaths []string, dest string) error {
 	gzipWriter := gzip.NewWriter(out)
 	defer gzipWriter.Close()
 
-	tarWriter := tar.NewWriter(gzipWriter)
+	return writeTar(baseFolder, folderPaths, gzipWriter, false)
+}
+
+// ArchiveTar writes an uncompressed tar stream of the folders to the writer.
+//
+// Unlike Archive(), the time-related fields are omitted from the headers to make
+// the stream only depend on the contents of the folders, since they are not
+// restored by the Unarchive() anyway.
+func ArchiveTar(baseFolder string, folderPaths []string, writer io.Writer) error {
+	return writeTar(baseFolder, folderPaths, writer, true)
+}
+
+func writeTar(baseFolder string, folderPaths []string, writer io.Writer, reproducible bool) error {
+	tarWriter := tar.NewWriter(writer)
 	defer tarWriter.Close()
 
 	buffer := make([]byte, DEFAULT_BUFFER_SIZE)
 
 	for _, folderPath := range folderPaths {
-		if err := archiveSingleFolder(baseFolder, folderPath, tarWriter, buffer); err != nil {
+		if err := archiveSingleFolder(baseFolder, folderPath, tarWriter, buffer, reproducible); err != nil {
 			return err
 		}
 	}
 
-	return nil
+	return tarWriter.Close()
 }
 
-func archiveSingleFolder(baseFolder string, folderPath string, tarWriter *tar.Writer, buffer []byte) error {
+func archiveSingleFolder(
+	baseFolder string,
+	folderPath string,
+	tarWriter *tar.Writer,
+	buffer []byte,
+	reproducible bool,
+) error {
 	return filepath.Walk(folderPath, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return fmt.Errorf("error walking folder %s: %v", path, err)
@@ -50,6 +70,12 @@ func archiveSingleFolder(baseFolder string, folderPath string, tarWriter *tar.Wr
 		}
 		header.Name = filepath.ToSlash(strings.TrimPrefix(path, baseFolder))
 
+		if reproducible {
+			header.ModTime = time.Time{}
+			header.AccessTime = time.Time{}
+			header.ChangeTime = time.Time{}
+		}
+
 		if header.Typeflag == tar.TypeSymlink {
 			linkDest, _ := os.Readlink(path)
 			if filepath.IsAbs(linkDest) && strings.HasPrefix(linkDest, baseFolder) {
@@ -102,19 +128,24 @@ func Unarchive(tarPath string, destFolder string) error {
 	}
 	defer gzipReader.Close()
 
-	gzipTar := tar.NewReader(gzipReader)
+	return UnarchiveTar(gzipReader, destFolder)
+}
+
+// UnarchiveTar extracts an uncompressed tar stream into the destination folder.
+func UnarchiveTar(reader io.Reader, destFolder string) error {
+	tarReader := tar.NewReader(reader)
 
 	buffer := make([]byte, DEFAULT_BUFFER_SIZE)
 
 	for {
-		header, err := gzipTar.Next()
+		header, err := tarReader.Next()
 		if err == io.EOF {
 			break
 		} else if err != nil {
 			return err
 		}
 
-		if err := untarFile(gzipTar, header, destFolder, buffer); err != nil {
+		if err := untarFile(tarReader, header, destFolder, buffer); err != nil {
 			return err
 		}
 	}
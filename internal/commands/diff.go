@@ -0,0 +1,126 @@
+//go:build linux || darwin || windows
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cirruslabs/cirrus-cli/internal/commands/helpers"
+	eenvironment "github.com/cirruslabs/cirrus-cli/internal/executor/environment"
+	"github.com/cirruslabs/cirrus-cli/internal/taskdiff"
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
+	gitfs "github.com/cirruslabs/cirrus-cli/pkg/larker/fs/git"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser"
+	"github.com/go-git/go-git/v5"
+	"github.com/spf13/cobra"
+)
+
+var ErrDiff = errors.New("diff failed")
+
+var diffFormat string
+
+func diff(cmd *cobra.Command, args []string) error {
+	if monorepo {
+		return fmt.Errorf("%w: --monorepo is not supported", ErrDiff)
+	}
+
+	baseEnvironment, err := makeBaseEnvironment()
+	if err != nil {
+		return err
+	}
+
+	userSpecifiedEnvironment, err := makeUserSpecifiedEnvironment()
+	if err != nil {
+		return err
+	}
+
+	// https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	repo, err := git.PlainOpen(projectDir)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDiff, err)
+	}
+
+	oldTasks, err := evaluateRevision(cmd.Context(), repo, args[0], baseEnvironment, userSpecifiedEnvironment)
+	if err != nil {
+		return err
+	}
+
+	var newTasks []*api.Task
+
+	if len(args) == 2 {
+		newTasks, err = evaluateRevision(cmd.Context(), repo, args[1], baseEnvironment, userSpecifiedEnvironment)
+		if err != nil {
+			return err
+		}
+	} else {
+		// Compare against the working tree, the same way "cirrus run" sees it
+		result, err := readYaml(cmd.Context(), baseEnvironment, userSpecifiedEnvironment)
+		if err != nil {
+			return err
+		}
+
+		newTasks = result.Tasks
+	}
+
+	return taskdiff.New(oldTasks, newTasks).Write(cmd.OutOrStdout(), diffFormat)
+}
+
+// evaluateRevision evaluates the configuration at the specified Git revision, with the
+// Starlark modules, included files and Dockerfiles retrieved from the same revision.
+func evaluateRevision(
+	ctx context.Context,
+	repo *git.Repository,
+	revision string,
+	baseEnvironment map[string]string,
+	userSpecifiedEnvironment map[string]string,
+) ([]*api.Task, error) {
+	revisionFS, err := gitfs.NewRevision(repo, revision)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDiff, err)
+	}
+
+	config, err := helpers.ReadCombinedConfigFromFS(ctx, revisionFS,
+		eenvironment.Merge(baseEnvironment, userSpecifiedEnvironment))
+	if err != nil {
+		// The configuration might've been added or removed by one of the revisions
+		if errors.Is(err, helpers.ErrConfigurationReadFailed) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("%w: %s: %v", ErrDiff, revision, err)
+	}
+
+	parserOpts, err := evaluationParserOptions(baseEnvironment, userSpecifiedEnvironment)
+	if err != nil {
+		return nil, err
+	}
+	parserOpts = append(parserOpts, parser.WithFileSystem(revisionFS))
+
+	result, err := parser.New(parserOpts...).Parse(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrDiff, revision, err)
+	}
+
+	return result.Tasks, nil
+}
+
+func newDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [flags] REVISION [REVISION]",
+		Short: "Compare the tasks evaluated at two Git revisions (or a revision and the working tree)",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE:  diff,
+	}
+
+	cmd.PersistentFlags().StringVarP(&diffFormat, "format", "f", taskdiff.FormatText,
+		fmt.Sprintf("output format of the differences (%s)", strings.Join(taskdiff.Formats, ", ")))
+
+	addEvaluationFlags(cmd)
+
+	return cmd
+}
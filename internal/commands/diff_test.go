@@ -0,0 +1,125 @@
+//go:build !windows
+
+package commands_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/commands"
+	"github.com/cirruslabs/cirrus-cli/internal/taskdiff"
+	"github.com/cirruslabs/cirrus-cli/internal/testutil"
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const diffOldConfig = `container:
+  image: golang:1.24
+
+lint_task:
+  script: golangci-lint run
+
+test_task:
+  env:
+    GOFLAGS: -mod=readonly
+  script: go test ./...
+`
+
+const diffNewConfig = `container:
+  image: golang:1.25
+
+test_task:
+  depends_on: build
+  env:
+    GOFLAGS: -mod=mod
+    CGO_ENABLED: 0
+  script:
+    - go test ./...
+    - go test -race ./...
+`
+
+const diffStarlarkConfig = `def main(ctx):
+    return [{"name": "build", "container": {"image": "golang:1.25"}, "script": "go build ./..."}]
+`
+
+func TestDiff(t *testing.T) {
+	testutil.TempChdir(t)
+
+	repo, err := git.PlainInit(".", false)
+	require.NoError(t, err)
+
+	workTree, err := repo.Worktree()
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(".cirrus.yml", []byte(diffOldConfig), 0600))
+	commitAll(t, workTree)
+
+	require.NoError(t, os.WriteFile(".cirrus.yml", []byte(diffNewConfig), 0600))
+	require.NoError(t, os.WriteFile(".cirrus.star", []byte(diffStarlarkConfig), 0600))
+	commitAll(t, workTree)
+
+	buf := bytes.NewBufferString("")
+
+	command := commands.NewRootCmd()
+	command.SetArgs([]string{"diff", "HEAD~1", "HEAD", "--format", "json"})
+	command.SetOut(buf)
+	command.SetErr(buf)
+	require.NoError(t, command.Execute())
+
+	var diff taskdiff.Diff
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &diff))
+
+	assert.Equal(t, []string{"build"}, diff.Added)
+	assert.Equal(t, []string{"lint"}, diff.Removed)
+	require.Len(t, diff.Changed, 1)
+	assert.Equal(t, "test", diff.Changed[0].Task)
+	assert.Equal(t, []*taskdiff.Change{
+		{Field: "image", Type: taskdiff.ChangeChanged, Old: "golang:1.24", New: "golang:1.25"},
+		{Field: "env.CGO_ENABLED", Type: taskdiff.ChangeAdded, New: "0"},
+		{Field: "env.GOFLAGS", Type: taskdiff.ChangeChanged, Old: "-mod=readonly", New: "-mod=mod"},
+		{Field: "script main", Type: taskdiff.ChangeChanged, Old: "go test ./...",
+			New: "go test ./...\ngo test -race ./..."},
+		{Field: "depends_on", Type: taskdiff.ChangeAdded, New: "build"},
+	}, diff.Changed[0].Changes)
+}
+
+func TestDiffWorkingTree(t *testing.T) {
+	testutil.TempChdir(t)
+
+	repo, err := git.PlainInit(".", false)
+	require.NoError(t, err)
+
+	workTree, err := repo.Worktree()
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(".cirrus.yml", []byte(diffOldConfig), 0600))
+	commitAll(t, workTree)
+
+	buf := bytes.NewBufferString("")
+
+	command := commands.NewRootCmd()
+	command.SetArgs([]string{"diff", "HEAD"})
+	command.SetOut(buf)
+	command.SetErr(buf)
+	require.NoError(t, command.Execute())
+	assert.Equal(t, "No task-level differences\n", buf.String())
+
+	require.NoError(t, os.WriteFile(".cirrus.yml", []byte(diffNewConfig), 0600))
+	require.NoError(t, os.WriteFile(".cirrus.star", []byte(diffStarlarkConfig), 0600))
+	buf.Reset()
+
+	command = commands.NewRootCmd()
+	command.SetArgs([]string{"diff", "HEAD"})
+	command.SetOut(buf)
+	command.SetErr(buf)
+	require.NoError(t, command.Execute())
+	assert.Contains(t, buf.String(), "+ build\n")
+	assert.Contains(t, buf.String(), "- lint\n")
+	assert.Contains(t, buf.String(), "~ test\n")
+	assert.Contains(t, buf.String(), "    image: \"golang:1.24\" -> \"golang:1.25\"\n")
+	assert.Contains(t, buf.String(), "    script main: changed\n      - go test ./...\n"+
+		"      + go test ./...\n      + go test -race ./...\n")
+}
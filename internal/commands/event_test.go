@@ -0,0 +1,80 @@
+//go:build !windows
+
+package commands_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cirruslabs/cirrus-cli/internal/commands"
+	"github.com/cirruslabs/cirrus-cli/internal/testutil"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEventPR ensures that the simulated PR is visible to the "only_if" expressions
+// and that only the files committed since the base branch are considered affected.
+func TestEventPR(t *testing.T) {
+	testutil.TempChdirPopulatedWith(t, "testdata/event")
+
+	repo, err := git.PlainInit(".", false)
+	require.NoError(t, err)
+
+	workTree, err := repo.Worktree()
+	require.NoError(t, err)
+
+	commitAll(t, workTree)
+
+	require.NoError(t, workTree.Checkout(&git.CheckoutOptions{Branch: "refs/heads/feature", Create: true}))
+	require.NoError(t, os.MkdirAll("docs", 0700))
+	require.NoError(t, os.WriteFile(filepath.Join("docs", "index.md"), []byte("# Docs\n"), 0600))
+	commitAll(t, workTree)
+
+	// Uncommitted changes should not be considered affected
+	require.NoError(t, os.WriteFile(filepath.Join("docs", "draft.md"), []byte("# Draft\n"), 0600))
+
+	buf := bytes.NewBufferString("")
+
+	command := commands.NewRootCmd()
+	command.SetArgs([]string{"explain", "docs", "--event", "pr:7", "--base", "master"})
+	command.SetOut(buf)
+	command.SetErr(buf)
+	require.NoError(t, command.Execute())
+
+	assert.Contains(t, buf.String(), "Affected files: docs/index.md\n")
+	assert.Contains(t, buf.String(), "$CIRRUS_PR = \"7\"")
+	assert.Contains(t, buf.String(), "Decision: included\n")
+}
+
+func TestEventCron(t *testing.T) {
+	testutil.TempChdirPopulatedWith(t, "testdata/event")
+
+	buf := bytes.NewBufferString("")
+
+	command := commands.NewRootCmd()
+	command.SetArgs([]string{"explain", "nightly", "--event", "cron:nightly"})
+	command.SetOut(buf)
+	command.SetErr(buf)
+	require.NoError(t, command.Execute())
+
+	assert.Contains(t, buf.String(), "$CIRRUS_CRON = \"nightly\"")
+	assert.Contains(t, buf.String(), "Decision: included\n")
+}
+
+func commitAll(t *testing.T, workTree *git.Worktree) {
+	require.NoError(t, workTree.AddGlob("."))
+
+	_, err := workTree.Commit("Update", &git.CommitOptions{
+		Author: &object.Signature{
+			Name:  "Charlie Root",
+			Email: "root@localhost",
+			When:  time.Now(),
+		},
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,214 @@
+//go:build linux || darwin || windows
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/cirruslabs/cirrus-cli/pkg/parser"
+	"github.com/spf13/cobra"
+)
+
+var ErrExplain = errors.New("explain failed")
+
+func explain(cmd *cobra.Command, args []string) error {
+	baseEnvironment, err := makeBaseEnvironment()
+	if err != nil {
+		return err
+	}
+
+	userSpecifiedEnvironment, err := makeUserSpecifiedEnvironment()
+	if err != nil {
+		return err
+	}
+
+	// https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	// Evaluate the configuration the same way "cirrus run" does, but record the evaluations
+	var trace parser.Trace
+
+	if _, err := readYaml(cmd.Context(), baseEnvironment, userSpecifiedEnvironment,
+		parser.WithTrace(&trace)); err != nil {
+		return err
+	}
+
+	var explained []*parser.TaskTrace
+	var names []string
+
+	for _, taskTrace := range trace.Tasks {
+		if taskTrace.Name == args[0] || (taskTrace.Alias != "" && taskTrace.Alias == args[0]) {
+			explained = append(explained, taskTrace)
+		}
+
+		names = append(names, taskTrace.Name)
+	}
+
+	if len(explained) == 0 {
+		slices.Sort(names)
+
+		return fmt.Errorf("%w: there's no task named %q, available tasks are: %s", ErrExplain,
+			args[0], strings.Join(slices.Compact(names), ", "))
+	}
+
+	writer := cmd.OutOrStdout()
+
+	if len(trace.AffectedFiles) == 0 {
+		fmt.Fprintln(writer, "Affected files: none (see --affected-files and --affected-files-git)")
+	} else {
+		fmt.Fprintf(writer, "Affected files: %s\n", strings.Join(trace.AffectedFiles, ", "))
+	}
+
+	for _, taskTrace := range explained {
+		fmt.Fprintln(writer)
+		explainTask(writer, taskTrace)
+		explainDependents(writer, taskTrace, trace.Tasks)
+	}
+
+	return nil
+}
+
+func explainTask(writer io.Writer, taskTrace *parser.TaskTrace) {
+	fmt.Fprintf(writer, "Task %q (line %d):\n", taskTrace.Name, taskTrace.Line)
+
+	if len(taskTrace.Evaluations) == 0 {
+		fmt.Fprintln(writer, "  no conditions were evaluated")
+	}
+
+	for _, evaluation := range taskTrace.Evaluations {
+		fmt.Fprintf(writer, "  %s: %s\n", evaluation.Field, evaluation.Expression)
+
+		for _, variable := range evaluation.Variables {
+			if variable.Defined {
+				fmt.Fprintf(writer, "    $%s = %q\n", variable.Name, variable.Value)
+			} else {
+				fmt.Fprintf(writer, "    $%s is not defined\n", variable.Name)
+			}
+		}
+
+		for _, call := range evaluation.Calls {
+			fmt.Fprintf(writer, "    %s(%s) = %s\n", call.Function, quoteAll(call.ExpandedArguments), call.Result)
+		}
+
+		for _, match := range evaluation.MatchedFiles {
+			if len(match.Files) == 0 {
+				fmt.Fprintf(writer, "      %q matched no affected files\n", match.Pattern)
+			} else {
+				fmt.Fprintf(writer, "      %q matched %s\n", match.Pattern, strings.Join(match.Files, ", "))
+			}
+		}
+
+		if evaluation.Err != nil {
+			fmt.Fprintf(writer, "    => error: %v\n", evaluation.Err)
+		} else {
+			fmt.Fprintf(writer, "    => %t\n", evaluation.Result)
+		}
+	}
+
+	if taskTrace.TriggerType != "" {
+		fmt.Fprintf(writer, "  trigger_type: %s", taskTrace.TriggerType)
+
+		if strings.EqualFold(taskTrace.TriggerType, "manual") {
+			fmt.Fprint(writer, " (Cirrus CI waits for a manual trigger, \"cirrus run\" runs the task right away)")
+		}
+
+		fmt.Fprintln(writer)
+	}
+
+	fmt.Fprintf(writer, "  Decision: %s\n", decision(taskTrace))
+}
+
+func explainDependents(writer io.Writer, taskTrace *parser.TaskTrace, taskTraces []*parser.TaskTrace) {
+	type dependent struct {
+		taskTrace  *parser.TaskTrace
+		dependency *parser.TaskTrace
+	}
+
+	var dependents []dependent
+
+	// Breadth-first search of the tasks that depend on the explained task, directly or not
+	queue := []*parser.TaskTrace{taskTrace}
+	seen := map[*parser.TaskTrace]struct{}{taskTrace: {}}
+
+	for len(queue) != 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, candidate := range taskTraces {
+			if _, ok := seen[candidate]; ok {
+				continue
+			}
+
+			if !slices.Contains(candidate.DependsOn, current.Name) &&
+				(current.Alias == "" || !slices.Contains(candidate.DependsOn, current.Alias)) {
+				continue
+			}
+
+			seen[candidate] = struct{}{}
+			queue = append(queue, candidate)
+			dependents = append(dependents, dependent{taskTrace: candidate, dependency: current})
+		}
+	}
+
+	if len(dependents) == 0 {
+		return
+	}
+
+	fmt.Fprintln(writer, "  Dependents:")
+
+	for _, dependent := range dependents {
+		fmt.Fprintf(writer, "    %s (depends on %s): %s", dependent.taskTrace.Name, dependent.dependency.Name,
+			decision(dependent.taskTrace))
+
+		if dependent.taskTrace.Enabled {
+			switch {
+			case !dependent.dependency.Enabled:
+				fmt.Fprint(writer, ", runs without waiting for the excluded dependency")
+			case dependent.dependency.Skipped:
+				fmt.Fprint(writer, ", runs once the dependency is skipped")
+			default:
+				fmt.Fprint(writer, ", waits for the dependency to complete")
+			}
+		}
+
+		fmt.Fprintln(writer)
+	}
+}
+
+func decision(taskTrace *parser.TaskTrace) string {
+	switch {
+	case !taskTrace.Enabled:
+		return "excluded by only_if"
+	case taskTrace.Skipped:
+		return "included, but skipped"
+	default:
+		return "included"
+	}
+}
+
+func quoteAll(values []string) string {
+	var quoted []string
+
+	for _, value := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+func newExplainCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "explain [flags] TASK",
+		Short: "Explain why a task is included, excluded or skipped",
+		Args:  cobra.ExactArgs(1),
+		RunE:  explain,
+	}
+
+	addEvaluationFlags(cmd)
+
+	return cmd
+}
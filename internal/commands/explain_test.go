@@ -0,0 +1,60 @@
+//go:build !windows
+
+package commands_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/commands"
+	"github.com/cirruslabs/cirrus-cli/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplain(t *testing.T) {
+	testutil.TempChdirPopulatedWith(t, "testdata/explain")
+
+	buf := bytes.NewBufferString("")
+
+	command := commands.NewRootCmd()
+	command.SetArgs([]string{"explain", "docs", "--affected-files", "README.md,docs/index.md"})
+	command.SetOut(buf)
+	command.SetErr(buf)
+	require.NoError(t, command.Execute())
+
+	assert.Contains(t, buf.String(), "changesInclude(\"docs/**\") = true")
+	assert.Contains(t, buf.String(), "\"docs/**\" matched docs/index.md\n")
+	assert.Contains(t, buf.String(), "Decision: included\n")
+	assert.Contains(t, buf.String(), "publish (depends on docs): included, waits for the dependency to complete")
+	assert.Contains(t, buf.String(), "announce (depends on publish): included")
+}
+
+func TestExplainSkipped(t *testing.T) {
+	testutil.TempChdirPopulatedWith(t, "testdata/explain")
+
+	buf := bytes.NewBufferString("")
+
+	command := commands.NewRootCmd()
+	command.SetArgs([]string{"explain", "lint", "-e", "CIRRUS_BRANCH=main"})
+	command.SetOut(buf)
+	command.SetErr(buf)
+	require.NoError(t, command.Execute())
+
+	assert.Contains(t, buf.String(), "$CIRRUS_BRANCH = \"main\"")
+	assert.Contains(t, buf.String(), "Decision: included, but skipped")
+	assert.Contains(t, buf.String(), "publish (depends on lint): included, runs once the dependency is skipped")
+}
+
+func TestExplainUnknownTask(t *testing.T) {
+	testutil.TempChdirPopulatedWith(t, "testdata/explain")
+
+	command := commands.NewRootCmd()
+	command.SetArgs([]string{"explain", "nonexistent"})
+	command.SetOut(bytes.NewBufferString(""))
+	command.SetErr(bytes.NewBufferString(""))
+
+	err := command.Execute()
+	require.ErrorIs(t, err, commands.ErrExplain)
+	assert.Contains(t, err.Error(), "announce, docs, lint, publish")
+}
@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/cirruslabs/cirrus-cli/internal/commands/helpers"
+	"github.com/cirruslabs/cirrus-cli/pkg/formatter"
+	"github.com/spf13/cobra"
+)
+
+var ErrFmt = errors.New("fmt failed")
+
+var fmtCheck bool
+var fmtAnchors string
+
+func runFmt(cmd *cobra.Command, args []string) error {
+	// https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	anchors, err := formatter.ParseAnchors(fmtAnchors)
+	if err != nil {
+		return err
+	}
+
+	paths := args
+	if len(paths) == 0 {
+		paths = []string{helpers.YAMLConfigPath(".")}
+	}
+
+	configFormatter := formatter.New(formatter.WithAnchors(anchors))
+
+	var unformatted int
+
+	for _, path := range paths {
+		source, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrFmt, err)
+		}
+
+		formatted, err := configFormatter.Format(source)
+		if err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrFmt, path, err)
+		}
+
+		if bytes.Equal(source, formatted) {
+			continue
+		}
+
+		unformatted++
+
+		if fmtCheck {
+			fmt.Fprintln(cmd.OutOrStdout(), path)
+
+			continue
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrFmt, err)
+		}
+
+		if err := os.WriteFile(path, formatted, info.Mode().Perm()); err != nil {
+			return fmt.Errorf("%w: %v", ErrFmt, err)
+		}
+	}
+
+	if fmtCheck && unformatted != 0 {
+		return fmt.Errorf("%w: %d file(s) are not formatted", ErrFmt, unformatted)
+	}
+
+	return nil
+}
+
+func newFmtCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fmt [flags] [FILE...]",
+		Short: "Rewrite the YAML configuration (.cirrus.yml or .cirrus.yaml by default) into a canonical form",
+		RunE:  runFmt,
+	}
+
+	cmd.PersistentFlags().BoolVar(&fmtCheck, "check", false,
+		"don't rewrite the files, only print the ones that are not formatted and fail if there are any")
+	cmd.PersistentFlags().StringVar(&fmtAnchors, "anchors", string(formatter.AnchorsKeep),
+		fmt.Sprintf("what to do with the YAML anchors: %s them as is, %s the aliases or %s "+
+			"the repeated mappings and sequences into aliases", formatter.AnchorsKeep, formatter.AnchorsExpand,
+			formatter.AnchorsCollapse))
+
+	return cmd
+}
@@ -0,0 +1,79 @@
+package commands_test
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/commands"
+	"github.com/cirruslabs/cirrus-cli/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const unformattedConfig = `task:
+  script: [make]
+  container:
+    image: debian:latest
+`
+
+const formattedConfig = `task:
+  container:
+    image: debian:latest
+  script: make
+`
+
+func TestFmt(t *testing.T) {
+	testutil.TempChdir(t)
+
+	require.NoError(t, os.WriteFile(".cirrus.yml", []byte(unformattedConfig), 0600))
+
+	// --check reports the unformatted file without touching it
+	buf := bytes.NewBufferString("")
+
+	command := commands.NewRootCmd()
+	command.SetArgs([]string{"fmt", "--check"})
+	command.SetOut(buf)
+	command.SetErr(buf)
+	require.ErrorIs(t, command.Execute(), commands.ErrFmt)
+	assert.Contains(t, buf.String(), ".cirrus.yml\n")
+
+	config, err := os.ReadFile(".cirrus.yml")
+	require.NoError(t, err)
+	assert.Equal(t, unformattedConfig, string(config))
+
+	// Formatting rewrites it
+	command = commands.NewRootCmd()
+	command.SetArgs([]string{"fmt"})
+	command.SetOut(buf)
+	command.SetErr(buf)
+	require.NoError(t, command.Execute())
+
+	config, err = os.ReadFile(".cirrus.yml")
+	require.NoError(t, err)
+	assert.Equal(t, formattedConfig, string(config))
+
+	// After which --check is happy
+	command = commands.NewRootCmd()
+	command.SetArgs([]string{"fmt", "--check"})
+	command.SetOut(buf)
+	command.SetErr(buf)
+	require.NoError(t, command.Execute())
+}
+
+func TestFmtYAMLExtension(t *testing.T) {
+	testutil.TempChdir(t)
+
+	require.NoError(t, os.WriteFile(".cirrus.yaml", []byte(unformattedConfig), 0600))
+
+	command := commands.NewRootCmd()
+	command.SetArgs([]string{"fmt"})
+	command.SetOut(bytes.NewBufferString(""))
+	command.SetErr(bytes.NewBufferString(""))
+	require.NoError(t, command.Execute())
+
+	config, err := os.ReadFile(".cirrus.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, formattedConfig, string(config))
+	assert.NoFileExists(t, ".cirrus.yml")
+}
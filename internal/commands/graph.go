@@ -0,0 +1,63 @@
+//go:build linux || darwin || windows
+
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cirruslabs/cirrus-cli/internal/executor/taskfilter"
+	"github.com/cirruslabs/cirrus-cli/internal/taskgraph"
+	"github.com/spf13/cobra"
+)
+
+var graphFormat string
+
+func graph(cmd *cobra.Command, args []string) error {
+	baseEnvironment, err := makeBaseEnvironment()
+	if err != nil {
+		return err
+	}
+
+	userSpecifiedEnvironment, err := makeUserSpecifiedEnvironment()
+	if err != nil {
+		return err
+	}
+
+	// https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	// Evaluate the configuration the same way "cirrus run" does
+	result, err := readYaml(cmd.Context(), baseEnvironment, userSpecifiedEnvironment)
+	if err != nil {
+		return err
+	}
+
+	taskFilter := taskfilter.MatchAnyTask()
+	if len(args) == 1 {
+		taskFilter = taskfilter.MatchExactTask(args[0])
+	}
+
+	tasks, err := taskFilter(result.Tasks)
+	if err != nil {
+		return err
+	}
+
+	return taskgraph.New(tasks).Write(cmd.OutOrStdout(), graphFormat)
+}
+
+func newGraphCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "graph [flags] [task]",
+		Short: "Render the graph of the tasks that \"cirrus run\" would execute",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  graph,
+	}
+
+	cmd.PersistentFlags().StringVarP(&graphFormat, "format", "f", taskgraph.FormatDOT,
+		fmt.Sprintf("output format of the graph (%s)", strings.Join(taskgraph.Formats, ", ")))
+
+	addEvaluationFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,74 @@
+//go:build !windows
+
+package commands_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/commands"
+	"github.com/cirruslabs/cirrus-cli/internal/taskgraph"
+	"github.com/cirruslabs/cirrus-cli/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGraph ensures that the graph includes the skipped tasks and the service tasks building the Dockerfiles.
+func TestGraph(t *testing.T) {
+	testutil.TempChdirPopulatedWith(t, "testdata/graph")
+
+	buf := bytes.NewBufferString("")
+
+	command := commands.NewRootCmd()
+	command.SetArgs([]string{"graph", "--format", "json"})
+	command.SetOut(buf)
+	command.SetErr(buf)
+	require.NoError(t, command.Execute())
+
+	var graph taskgraph.Graph
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &graph))
+	require.Len(t, graph.Nodes, 4)
+
+	nodes := map[string]*taskgraph.Node{}
+	for _, node := range graph.Nodes {
+		nodes[node.Name] = node
+	}
+
+	prebuild := nodes["Prebuild ci/Dockerfile"]
+	require.NotNil(t, prebuild)
+	assert.True(t, prebuild.Service)
+
+	assert.Equal(t, []int64{prebuild.ID}, nodes["build"].DependsOn)
+	assert.Equal(t, "ci/Dockerfile", nodes["build"].Dockerfile)
+	assert.Equal(t, taskgraph.StatusSkipped, nodes["lint"].Status)
+	assert.Equal(t, []int64{nodes["build"].ID, nodes["lint"].ID}, nodes["release"].DependsOn)
+}
+
+// TestGraphSingleTask ensures that the graph of a single task has no edges to the tasks that are not rendered.
+func TestGraphSingleTask(t *testing.T) {
+	testutil.TempChdirPopulatedWith(t, "testdata/graph")
+
+	buf := bytes.NewBufferString("")
+
+	command := commands.NewRootCmd()
+	command.SetArgs([]string{"graph", "--format", "json", "release"})
+	command.SetOut(buf)
+	command.SetErr(buf)
+	require.NoError(t, command.Execute())
+
+	var graph taskgraph.Graph
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &graph))
+	require.Len(t, graph.Nodes, 1)
+	assert.Equal(t, "release", graph.Nodes[0].Name)
+	assert.Empty(t, graph.Nodes[0].DependsOn)
+
+	buf.Reset()
+
+	command = commands.NewRootCmd()
+	command.SetArgs([]string{"graph", "release"})
+	command.SetOut(buf)
+	command.SetErr(buf)
+	require.NoError(t, command.Execute())
+	assert.NotContains(t, buf.String(), "->")
+}
@@ -13,6 +13,56 @@ import (
 	"strings"
 )
 
+// GitDiffCommits is a simplified "git diff FROM TO" implementation using go-git,
+// which unlike GitDiff ignores the changes that are not committed yet.
+func GitDiffCommits(dir string, fromRevision string, toRevision string) ([]string, error) {
+	repo, err := git.PlainOpen(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fromTree, err := revisionTree(repo, fromRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	toTree, err := revisionTree(repo, toRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := object.DiffTree(fromTree, toTree)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+
+	for _, change := range changes {
+		if change.To.Name != "" {
+			result = append(result, change.To.Name)
+		} else {
+			result = append(result, change.From.Name)
+		}
+	}
+
+	return result, nil
+}
+
+func revisionTree(repo *git.Repository, revision string) (*object.Tree, error) {
+	hash, err := repo.ResolveRevision(plumbing.Revision(revision))
+	if err != nil {
+		return nil, err
+	}
+
+	commit, err := repo.CommitObject(*hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return commit.Tree()
+}
+
 // GitDiff is a simplified "git diff" and "git diff --cached" implementation using go-git.
 //
 // GitDiff closely resembles the implementation of go-git Worktree's Status() method.
@@ -72,3 +72,37 @@ func TestGitDiff(t *testing.T) {
 	}
 	require.Empty(t, affectedFiles)
 }
+
+func TestGitDiffCommits(t *testing.T) {
+	testutil.TempChdir(t)
+
+	repo, err := git.PlainInit(".", false)
+	require.NoError(t, err)
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+
+	author := &object.Signature{
+		Name:  "John Doe",
+		Email: "john@example.com",
+	}
+
+	require.NoError(t, os.WriteFile("canary", []byte("original content"), 0600))
+	_, err = worktree.Add("canary")
+	require.NoError(t, err)
+	originalCommit, err := worktree.Commit("Add canary", &git.CommitOptions{Author: author})
+	require.NoError(t, err)
+
+	require.NoError(t, os.Mkdir("docs", 0700))
+	require.NoError(t, os.WriteFile("docs/index.md", []byte("# Docs"), 0600))
+	_, err = worktree.Add("docs/index.md")
+	require.NoError(t, err)
+	_, err = worktree.Commit("Add docs", &git.CommitOptions{Author: author})
+	require.NoError(t, err)
+
+	// Uncommitted changes should be ignored
+	require.NoError(t, os.WriteFile("canary", []byte("modified content"), 0600))
+
+	affectedFiles, err := helpers.GitDiffCommits(".", originalCommit.String(), "HEAD")
+	require.NoError(t, err)
+	require.Equal(t, []string{"docs/index.md"}, affectedFiles)
+}
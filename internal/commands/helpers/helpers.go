@@ -6,9 +6,11 @@ import (
 	"errors"
 	"fmt"
 	"github.com/cirruslabs/cirrus-cli/pkg/larker"
+	"github.com/cirruslabs/cirrus-cli/pkg/larker/fs"
 	"github.com/cirruslabs/cirrus-cli/pkg/larker/fs/local"
 	"github.com/spf13/cobra"
 	"os"
+	"path/filepath"
 	"strings"
 )
 
@@ -107,12 +109,16 @@ func ReadYAMLConfig(path string) (string, error) {
 }
 
 func EvaluateStarlarkConfig(ctx context.Context, path string, env map[string]string) (string, error) {
+	return evaluateStarlarkConfig(ctx, ".", path, env)
+}
+
+func evaluateStarlarkConfig(ctx context.Context, dir string, path string, env map[string]string) (string, error) {
 	starlarkSource, err := os.ReadFile(path)
 	if err != nil {
 		return "", err
 	}
 
-	lrk := larker.New(larker.WithFileSystem(local.New(".")), larker.WithEnvironment(env))
+	lrk := larker.New(larker.WithFileSystem(local.New(dir)), larker.WithEnvironment(env))
 
 	result, err := lrk.MainOptional(ctx, string(starlarkSource))
 	if err != nil {
@@ -123,25 +129,72 @@ func EvaluateStarlarkConfig(ctx context.Context, path string, env map[string]str
 }
 
 func ReadCombinedConfig(ctx context.Context, env map[string]string) (string, error) {
-	// Here we read the .cirrus.yaml first so that if the error would arise
+	return ReadCombinedConfigFrom(ctx, ".", env)
+}
+
+// YAMLConfigPath returns the path of the YAML configuration located in the specified directory,
+// preferring the .cirrus.yaml over the .cirrus.yml when both exist.
+func YAMLConfigPath(dir string) string {
+	// Here we check the .cirrus.yaml first so that if the error would arise
 	// and will be inspected it would indicate the preferable extension
-	yamlConfig, yamlErr := ReadYAMLConfig(".cirrus.yaml")
+	path := filepath.Join(dir, ".cirrus.yaml")
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		return path
+	}
+
+	return filepath.Join(dir, ".cirrus.yml")
+}
+
+// ReadCombinedConfigFrom reads the YAML and Starlark configurations located in the specified directory.
+func ReadCombinedConfigFrom(ctx context.Context, dir string, env map[string]string) (string, error) {
+	yamlConfig, yamlErr := ReadYAMLConfig(YAMLConfigPath(dir))
+	if yamlErr != nil && !os.IsNotExist(yamlErr) {
+		return "", yamlErr
+	}
+
+	starlarkConfig, starlarkErr := evaluateStarlarkConfig(ctx, dir, filepath.Join(dir, ".cirrus.star"), env)
+	if starlarkErr != nil && !os.IsNotExist(starlarkErr) {
+		return "", starlarkErr
+	}
+
+	return combineConfigs(yamlConfig, yamlErr, starlarkConfig, starlarkErr)
+}
+
+// ReadCombinedConfigFromFS reads the YAML and Starlark configurations located
+// in the root of the file system, e.g. the one of a specific Git revision.
+func ReadCombinedConfigFromFS(ctx context.Context, fileSystem fs.FileSystem, env map[string]string) (string, error) {
+	yamlConfig, yamlErr := fileSystem.Get(ctx, ".cirrus.yaml")
 	if yamlErr != nil {
 		if !os.IsNotExist(yamlErr) {
 			return "", yamlErr
 		}
 
-		yamlConfig, yamlErr = ReadYAMLConfig(".cirrus.yml")
+		yamlConfig, yamlErr = fileSystem.Get(ctx, ".cirrus.yml")
 		if yamlErr != nil && !os.IsNotExist(yamlErr) {
 			return "", yamlErr
 		}
 	}
 
-	starlarkConfig, starlarkErr := EvaluateStarlarkConfig(ctx, ".cirrus.star", env)
-	if starlarkErr != nil && !os.IsNotExist(starlarkErr) {
+	var starlarkConfig string
+
+	starlarkSource, starlarkErr := fileSystem.Get(ctx, ".cirrus.star")
+	if starlarkErr == nil {
+		lrk := larker.New(larker.WithFileSystem(fileSystem), larker.WithEnvironment(env))
+
+		result, err := lrk.MainOptional(ctx, string(starlarkSource))
+		if err != nil {
+			return "", err
+		}
+
+		starlarkConfig = result.YAMLConfig
+	} else if !os.IsNotExist(starlarkErr) {
 		return "", starlarkErr
 	}
 
+	return combineConfigs(string(yamlConfig), yamlErr, starlarkConfig, starlarkErr)
+}
+
+func combineConfigs(yamlConfig string, yamlErr error, starlarkConfig string, starlarkErr error) (string, error) {
 	switch {
 	case yamlErr == nil && starlarkErr == nil:
 		return yamlConfig + "\n" + starlarkConfig, nil
@@ -1,10 +1,13 @@
 package helpers_test
 
 import (
+	"context"
 	"fmt"
 	"github.com/cirruslabs/cirrus-cli/internal/commands/helpers"
+	"github.com/go-git/go-git/v5"
 	"github.com/stretchr/testify/require"
 	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -28,3 +31,49 @@ func TestEnvFileToMap(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, map[string]string{"A": "B", "C": "D"}, env)
 }
+
+func TestDiscoverProjects(t *testing.T) {
+	projects, err := helpers.DiscoverProjects("../testdata/monorepo")
+	require.NoError(t, err)
+	require.Equal(t, []string{".", "services/api", "services/web"}, projects)
+}
+
+func TestReadProjectsConfigFile(t *testing.T) {
+	projects, err := helpers.ReadProjects(context.Background(), "../testdata/monorepo", nil)
+	require.NoError(t, err)
+
+	var configFiles []string
+
+	for _, project := range projects {
+		configFiles = append(configFiles, project.ConfigFile)
+	}
+
+	require.Equal(t, []string{".cirrus.yml", ".cirrus.yml", ".cirrus.star"}, configFiles)
+}
+
+func TestDiscoverProjectsSkipsIgnored(t *testing.T) {
+	root := t.TempDir()
+
+	_, err := git.PlainInit(root, false)
+	require.NoError(t, err)
+
+	for _, name := range []string{
+		".cirrus.yml",
+		"services/api/.cirrus.yml",
+		"services/api/node_modules/left-pad/.cirrus.yml",
+		"vendor/github.com/example/dependency/.cirrus.yml",
+		"build/generated/.cirrus.yml",
+		"services/web/.cirrus.star",
+	} {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, os.WriteFile(path, []byte("task:\n  script: true\n"), 0600))
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, ".gitignore"), []byte("/build/\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "services", ".gitignore"), []byte("*.star\n"), 0600))
+
+	projects, err := helpers.DiscoverProjects(root)
+	require.NoError(t, err)
+	require.Equal(t, []string{".", "services/api"}, projects)
+}
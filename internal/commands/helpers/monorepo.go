@@ -0,0 +1,168 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/cirruslabs/cirrus-cli/pkg/parser"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+)
+
+var configFileNames = []string{".cirrus.yml", ".cirrus.yaml", ".cirrus.star"}
+
+// Directories containing the third-party code, which might come with its own Cirrus configuration
+var dependencyDirNames = []string{"node_modules", "vendor"}
+
+// DiscoverProjects returns the directories (relative to the root) that contain a Cirrus configuration,
+// skipping the hidden and dependency directories, and the ones ignored by Git.
+func DiscoverProjects(root string) ([]string, error) {
+	ignored, err := gitIgnored(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+
+	err = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.IsDir() {
+			return nil
+		}
+
+		if path != root && (strings.HasPrefix(entry.Name(), ".") || slices.Contains(dependencyDirNames, entry.Name()) ||
+			ignored(path, true)) {
+			return filepath.SkipDir
+		}
+
+		if !hasConfig(path, ignored) {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		result = append(result, filepath.ToSlash(relativePath))
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// gitIgnored returns a function that checks whether the path is ignored by the .gitignore
+// files of the Git repository containing the root, if any.
+func gitIgnored(root string) (func(path string, isDir bool) bool, error) {
+	notIgnored := func(string, bool) bool {
+		return false
+	}
+
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := git.PlainOpenWithOptions(absRoot, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		if errors.Is(err, git.ErrRepositoryNotExists) {
+			return notIgnored, nil
+		}
+
+		return nil, err
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		if errors.Is(err, git.ErrIsBareRepository) {
+			return notIgnored, nil
+		}
+
+		return nil, err
+	}
+
+	patterns, err := gitignore.ReadPatterns(worktree.Filesystem, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	matcher := gitignore.NewMatcher(patterns)
+
+	return func(path string, isDir bool) bool {
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return false
+		}
+
+		relativePath, err := filepath.Rel(worktree.Filesystem.Root(), absPath)
+		if err != nil || relativePath == "." {
+			return false
+		}
+
+		return matcher.Match(strings.Split(filepath.ToSlash(relativePath), "/"), isDir)
+	}, nil
+}
+
+func hasConfig(dir string, ignored func(path string, isDir bool) bool) bool {
+	for _, configFileName := range configFileNames {
+		path := filepath.Join(dir, configFileName)
+
+		if info, err := os.Stat(path); err == nil && !info.IsDir() && !ignored(path, false) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ReadProjects reads the configuration of each project discovered in the root.
+func ReadProjects(ctx context.Context, root string, env map[string]string) ([]parser.Project, error) {
+	dirs, err := DiscoverProjects(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []parser.Project
+
+	for _, dir := range dirs {
+		config, err := ReadCombinedConfigFrom(ctx, filepath.Join(root, dir), env)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, parser.Project{
+			Dir:        dir,
+			ConfigFile: configFileName(filepath.Join(root, dir)),
+			Config:     config,
+		})
+	}
+
+	return result, nil
+}
+
+// configFileName returns the name of the configuration file that ReadCombinedConfigFrom()
+// reads first in the directory, which is the one the parser errors and issues refer to.
+func configFileName(dir string) string {
+	yamlConfigPath := YAMLConfigPath(dir)
+	if _, err := os.Stat(yamlConfigPath); err == nil {
+		return filepath.Base(yamlConfigPath)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, ".cirrus.star")); err == nil {
+		return ".cirrus.star"
+	}
+
+	return filepath.Base(yamlConfigPath)
+}
@@ -0,0 +1,59 @@
+package logs_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/commands/logs"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/annotations"
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
+	"github.com/cirruslabs/echelon/renderers"
+	"github.com/stretchr/testify/require"
+)
+
+var annotationWithoutLocation = &api.Annotation{
+	Type:       api.Annotation_GENERIC,
+	Level:      api.Annotation_WARNING,
+	Message:    "coverage has decreased",
+	RawDetails: "from 80% to 75%",
+}
+
+func TestGithubActionsAnnotationWithoutLocation(t *testing.T) {
+	var buf bytes.Buffer
+
+	renderer := logs.NewGithubActionsLogsRenderer(renderers.NewSimpleRenderer(&buf, nil))
+	renderer.(annotations.Renderer).RenderAnnotation("main", annotationWithoutLocation)
+
+	require.Equal(t, "::warning::coverage has decreased%0Afrom 80%25 to 75%25\n", buf.String())
+}
+
+func TestTeamCityAnnotationWithoutLocation(t *testing.T) {
+	var buf bytes.Buffer
+
+	renderer := logs.NewTeamCityLogsRenderer(renderers.NewSimpleRenderer(&buf, nil))
+	renderer.(annotations.Renderer).RenderAnnotation("main", annotationWithoutLocation)
+
+	require.Equal(t, "##teamcity[message text='coverage has decreased|nfrom 80% to 75%' status='WARNING']\n",
+		buf.String())
+}
+
+func TestGithubActionsAnnotationWithLocationIsEscaped(t *testing.T) {
+	var buf bytes.Buffer
+
+	renderer := logs.NewGithubActionsLogsRenderer(renderers.NewSimpleRenderer(&buf, nil))
+	renderer.(annotations.Renderer).RenderAnnotation("main", &api.Annotation{
+		Type:    api.Annotation_TEST_RESULT,
+		Level:   api.Annotation_FAILURE,
+		Message: "TestParse(a, b)\n::error::injected",
+		FileLocation: &api.Annotation_FileLocation{
+			Path:      "pkg/parser,v2.go",
+			StartLine: 10,
+			EndLine:   12,
+		},
+		RawDetails: "expected 1, got 2\n::error::injected",
+	})
+
+	require.Equal(t, "::error file=pkg/parser%2Cv2.go,line=10,endLine=12,"+
+		"title=TestParse(a%2C b)%0A%3A%3Aerror%3A%3Ainjected::expected 1, got 2%0A::error::injected\n",
+		buf.String())
+}
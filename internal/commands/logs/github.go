@@ -1,6 +1,11 @@
 package logs
 
 import (
+	"fmt"
+
+	"github.com/cirruslabs/cirrus-cli/internal/executor/annotations"
+	"github.com/cirruslabs/cirrus-cli/internal/githubactions"
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
 	"github.com/cirruslabs/echelon"
 	"github.com/cirruslabs/echelon/renderers"
 )
@@ -18,3 +23,26 @@ func NewGithubActionsLogsRenderer(renderer *renderers.SimpleRenderer) echelon.Lo
 		},
 	}
 }
+
+// RenderAnnotation renders the annotation as a workflow command[1].
+//
+// [1]: https://docs.github.com/en/actions/using-workflows/workflow-commands-for-github-actions
+func (r *GithubActionsLogsRenderer) RenderAnnotation(_ string, annotation *api.Annotation) {
+	if annotation.FileLocation == nil {
+		message := annotation.Message
+		if annotation.RawDetails != "" {
+			message += "\n" + annotation.RawDetails
+		}
+
+		r.RenderRawMessage(fmt.Sprintf("::%s::%s\n", annotations.Level(annotation),
+			githubactions.EscapeData(message)))
+
+		return
+	}
+
+	rawMessage := fmt.Sprintf("::%s file=%s,line=%d,endLine=%d,title=%s::%s\n", annotations.Level(annotation),
+		githubactions.EscapeProperty(annotation.FileLocation.Path), annotation.FileLocation.StartLine,
+		annotation.FileLocation.EndLine, githubactions.EscapeProperty(annotation.Message),
+		githubactions.EscapeData(annotation.RawDetails))
+	r.RenderRawMessage(rawMessage)
+}
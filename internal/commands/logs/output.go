@@ -6,6 +6,7 @@ import (
 	"github.com/cirruslabs/echelon/renderers/config"
 	"io"
 	"os"
+	"sync"
 )
 
 const (
@@ -91,7 +92,9 @@ func GetLogger(format string, verbose bool, logWriter io.Writer, logFile *os.Fil
 		logger = echelon.NewLogger(echelon.DebugLevel, renderer)
 	}
 
-	return logger, cancelFunc
+	// Allow stopping the renderer early (e.g. to print something below
+	// the interactive renderer's output) while still deferring the cancellation
+	return logger, sync.OnceFunc(cancelFunc)
 }
 
 func envVariableIsSet(name string) bool {
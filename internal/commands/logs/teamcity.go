@@ -1,11 +1,23 @@
 package logs
 
 import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
 	"github.com/cirruslabs/echelon"
 	"github.com/cirruslabs/echelon/renderers"
-	"strings"
 )
 
+type TeamCityLogsRenderer struct {
+	*FoldableLogsRenderer
+
+	// Inspection types need to be declared before the first inspection of that type is reported
+	inspectionTypes    map[string]struct{}
+	inspectionTypesMtx sync.Mutex
+}
+
 func NewTeamCityLogsRenderer(renderer *renderers.SimpleRenderer) echelon.LogRendered {
 	replacer := strings.NewReplacer(
 		"'", "|'",
@@ -13,12 +25,76 @@ func NewTeamCityLogsRenderer(renderer *renderers.SimpleRenderer) echelon.LogRend
 		"]", "|]",
 		"|", "||",
 		"\n", "|n",
+		"\r", "|r",
 	)
 
-	return &FoldableLogsRenderer{
-		delegate:          renderer,
-		startFoldTemplate: "##teamcity[blockOpened name='%s']",
-		endFoldTemplate:   "##teamcity[blockClosed name='%s']",
-		escapeFunc:        replacer.Replace,
+	return &TeamCityLogsRenderer{
+		FoldableLogsRenderer: &FoldableLogsRenderer{
+			delegate:          renderer,
+			startFoldTemplate: "##teamcity[blockOpened name='%s']",
+			endFoldTemplate:   "##teamcity[blockClosed name='%s']",
+			escapeFunc:        replacer.Replace,
+		},
+		inspectionTypes: map[string]struct{}{},
 	}
 }
+
+// RenderAnnotation renders the annotation as an inspection[1].
+//
+// Inspections require a file, so the annotations without one are rendered as build log messages[2].
+//
+// [1]: https://www.jetbrains.com/help/teamcity/service-messages.html#Reporting+Inspections
+// [2]: https://www.jetbrains.com/help/teamcity/service-messages.html#Reporting+Messages+to+Build+Log
+func (r *TeamCityLogsRenderer) RenderAnnotation(_ string, annotation *api.Annotation) {
+	message := annotation.Message
+	if annotation.RawDetails != "" {
+		message += "\n" + annotation.RawDetails
+	}
+
+	if annotation.FileLocation == nil {
+		status := "NORMAL"
+
+		switch annotation.Level {
+		case api.Annotation_WARNING:
+			status = "WARNING"
+		case api.Annotation_FAILURE:
+			status = "ERROR"
+		}
+
+		r.RenderRawMessage(fmt.Sprintf("##teamcity[message text='%s' status='%s']\n",
+			r.escapeFunc(message), status))
+
+		return
+	}
+
+	typeID := annotation.FullyQualifiedName
+	if typeID == "" {
+		typeID = strings.ToLower(annotation.Type.String())
+	}
+
+	r.inspectionTypesMtx.Lock()
+	_, declared := r.inspectionTypes[typeID]
+	r.inspectionTypes[typeID] = struct{}{}
+	r.inspectionTypesMtx.Unlock()
+
+	if !declared {
+		r.RenderRawMessage(fmt.Sprintf("##teamcity[inspectionType id='%s' name='%s' category='%s' "+
+			"description='%s']\n", r.escapeFunc(typeID), r.escapeFunc(typeID),
+			r.escapeFunc(strings.ToLower(annotation.Type.String())), r.escapeFunc(typeID)))
+	}
+
+	var severity string
+
+	switch annotation.Level {
+	case api.Annotation_NOTICE:
+		severity = "INFO"
+	case api.Annotation_WARNING:
+		severity = "WARNING"
+	case api.Annotation_FAILURE:
+		severity = "ERROR"
+	}
+
+	r.RenderRawMessage(fmt.Sprintf("##teamcity[inspection typeId='%s' message='%s' file='%s' line='%d' "+
+		"SEVERITY='%s']\n", r.escapeFunc(typeID), r.escapeFunc(message),
+		r.escapeFunc(annotation.FileLocation.Path), annotation.FileLocation.StartLine, severity))
+}
@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/cirruslabs/cirrus-cli/internal/commands/helpers"
+	"github.com/cirruslabs/cirrus-cli/internal/evaluator"
+	"github.com/cirruslabs/cirrus-cli/internal/logginglevel"
+	"github.com/cirruslabs/cirrus-cli/internal/lsp"
+	"github.com/cirruslabs/cirrus-cli/pkg/executorservice"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var ErrLSP = errors.New("language server failed")
+
+var lspEnvironment []string
+var lspCloudInstances bool
+
+func runLSP(cmd *cobra.Command, args []string) error {
+	// https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	// The standard output is reserved for the protocol messages
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+		Level: logginglevel.Level,
+	})))
+
+	opts := []lsp.Option{
+		lsp.WithEnvironment(helpers.EnvArgsToMap(lspEnvironment)),
+	}
+
+	if lspCloudInstances {
+		additionalInstances, err := retrieveAdditionalInstances()
+		if err != nil {
+			slog.Warn("failed to retrieve additional instances supported by the Cirrus Cloud, "+
+				"they will not be completed and validated", "err", err)
+		} else {
+			opts = append(opts, lsp.WithAdditionalInstances(additionalInstances))
+		}
+	}
+
+	server, err := lsp.New(opts...)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrLSP, err)
+	}
+
+	if err := server.Serve(cmd.Context(), cmd.InOrStdin(), cmd.OutOrStdout()); err != nil {
+		return fmt.Errorf("%w: %v", ErrLSP, err)
+	}
+
+	return nil
+}
+
+func retrieveAdditionalInstances() (map[string]protoreflect.MessageDescriptor, error) {
+	additionalInstances, err := executorservice.New().SupportedInstances()
+	if err != nil {
+		return nil, err
+	}
+
+	return evaluator.TransformAdditionalInstances(additionalInstances)
+}
+
+func newLSPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lsp",
+		Short: "Run a Language Server Protocol server for .cirrus.yml and .cirrus.star files over stdio",
+		RunE:  runLSP,
+	}
+
+	cmd.PersistentFlags().StringArrayVarP(&lspEnvironment, "environment", "e", []string{},
+		"set (-e A=B) or pass-through (-e A) an environment variable to the parser and the Starlark interpreter")
+	cmd.PersistentFlags().BoolVar(&lspCloudInstances, "cloud-instances", false,
+		"retrieve the additional instances supported by the Cirrus Cloud for completion and validation")
+
+	return cmd
+}
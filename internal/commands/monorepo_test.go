@@ -0,0 +1,73 @@
+//go:build !windows
+
+package commands_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/commands"
+	"github.com/cirruslabs/cirrus-cli/internal/taskgraph"
+	"github.com/cirruslabs/cirrus-cli/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMonorepo ensures that the tasks from the nested configurations are namespaced
+// by their directory, scoped to their subtree and can depend on each other.
+func TestMonorepo(t *testing.T) {
+	testutil.TempChdirPopulatedWith(t, "testdata/monorepo")
+
+	buf := bytes.NewBufferString("")
+
+	command := commands.NewRootCmd()
+	command.SetArgs([]string{"graph", "--monorepo", "--format", "json",
+		"--affected-files", "services/api/src/main.go"})
+	command.SetOut(buf)
+	command.SetErr(buf)
+	require.NoError(t, command.Execute())
+
+	var graph taskgraph.Graph
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &graph))
+
+	nodes := map[string]*taskgraph.Node{}
+	for _, node := range graph.Nodes {
+		nodes[node.Name] = node
+	}
+
+	require.Len(t, nodes, 4)
+	require.Contains(t, nodes, "services/api/build")
+	assert.Equal(t, []int64{nodes["lint"].ID, nodes["services/api/build"].ID}, nodes["services/api/test"].DependsOn)
+	assert.Equal(t, []int64{nodes["services/api/test"].ID}, nodes["services/web/deploy"].DependsOn)
+}
+
+func TestMonorepoChangesIncludeScope(t *testing.T) {
+	testutil.TempChdirPopulatedWith(t, "testdata/monorepo")
+
+	buf := bytes.NewBufferString("")
+
+	// src/main.go belongs to the root project, so it doesn't trigger services/api/build
+	command := commands.NewRootCmd()
+	command.SetArgs([]string{"graph", "--monorepo", "--format", "json", "--affected-files", "src/main.go"})
+	command.SetOut(buf)
+	command.SetErr(buf)
+	require.NoError(t, command.Execute())
+
+	var graph taskgraph.Graph
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &graph))
+
+	for _, node := range graph.Nodes {
+		assert.NotEqual(t, "services/api/build", node.Name)
+	}
+}
+
+func TestMonorepoValidate(t *testing.T) {
+	testutil.TempChdirPopulatedWith(t, "testdata/monorepo")
+
+	command := commands.NewRootCmd()
+	command.SetArgs([]string{"validate", "--monorepo"})
+	command.SetOut(bytes.NewBufferString(""))
+	command.SetErr(bytes.NewBufferString(""))
+	require.NoError(t, command.Execute())
+}
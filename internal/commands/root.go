@@ -36,7 +36,12 @@ func NewRootCmd() *cobra.Command {
 	commands := []*cobra.Command{
 		validate.NewValidateCmd(),
 		newRunCmd(),
+		newGraphCmd(),
+		newExplainCmd(),
+		newDiffCmd(),
+		newFmtCmd(),
 		newServeCmd(),
+		newLSPCmd(),
 		internal.NewRootCmd(),
 		worker.NewRootCmd(),
 		localnetworkhelper.NewCommand(),
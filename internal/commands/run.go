@@ -8,23 +8,31 @@ import (
 	"fmt"
 	"os"
 	"runtime"
+	"slices"
 	"strings"
 	"time"
 
+	"filippo.io/age"
 	"github.com/cirruslabs/chacha/pkg/localnetworkhelper"
 	"github.com/cirruslabs/chacha/pkg/privdrop"
 
 	"github.com/cirruslabs/cirrus-cli/internal/commands/helpers"
 	"github.com/cirruslabs/cirrus-cli/internal/commands/logs"
 	"github.com/cirruslabs/cirrus-cli/internal/executor"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/annotations"
 	eenvironment "github.com/cirruslabs/cirrus-cli/internal/executor/environment"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/containerbackend"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/options"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/secrets"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/taskfilter"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/terminalserver"
+	"github.com/cirruslabs/cirrus-cli/internal/version"
 	"github.com/cirruslabs/cirrus-cli/pkg/larker/fs/local"
 	"github.com/cirruslabs/cirrus-cli/pkg/parser"
 	"github.com/cirruslabs/cirrus-cli/pkg/parser/parsererror"
+	"github.com/cirruslabs/echelon"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
 const projectDir = "."
@@ -43,6 +51,10 @@ var (
 	affectedFilesGitRevision       string
 	affectedFilesGitCachedRevision string
 	verbose                        bool
+	annotationsSARIF               string
+	monorepo                       bool
+	event                          string
+	eventBase                      string
 )
 
 // Common instance-related flags.
@@ -73,6 +85,18 @@ var vetuLazyPull bool
 // macOS-related flags.
 var username string
 
+// Secrets-related flags.
+var (
+	secretsFile         string
+	secretsIdentityFile string
+)
+
+// Terminal-related flags.
+var (
+	terminal       bool
+	debugOnFailure bool
+)
+
 // Flags useful for debugging.
 var debugNoCleanup bool
 
@@ -80,22 +104,65 @@ func readYaml(
 	ctx context.Context,
 	baseEnvironment map[string]string,
 	userSpecifiedEnvironment map[string]string,
+	additionalParserOpts ...parser.Option,
 ) (*parser.Result, error) {
-	// Retrieve the combined YAML configuration
-	combinedYAML, err := helpers.ReadCombinedConfig(
-		ctx,
-		eenvironment.Merge(baseEnvironment, userSpecifiedEnvironment),
-	)
+	// Retrieve the combined YAML configuration of each project
+	var projects []parser.Project
+
+	if monorepo {
+		var err error
+
+		projects, err = helpers.ReadProjects(ctx, projectDir,
+			eenvironment.Merge(baseEnvironment, userSpecifiedEnvironment))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		combinedYAML, err := helpers.ReadCombinedConfig(
+			ctx,
+			eenvironment.Merge(baseEnvironment, userSpecifiedEnvironment),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		projects = []parser.Project{{Config: combinedYAML}}
+	}
+
+	// Parse
+	parserOpts, err := evaluationParserOptions(baseEnvironment, userSpecifiedEnvironment)
 	if err != nil {
 		return nil, err
 	}
+	parserOpts = append(parserOpts, additionalParserOpts...)
+
+	p := parser.New(parserOpts...)
+	result, err := p.ParseProjects(ctx, projects)
+	if err != nil {
+		if re, ok := err.(*parsererror.Rich); ok {
+			fmt.Print(re.ContextLines())
+		}
+
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// evaluationParserOptions returns the parser options that correspond to the evaluation flags,
+// which are shared between the commands that need to see the same tasks as "cirrus run".
+func evaluationParserOptions(
+	baseEnvironment map[string]string,
+	userSpecifiedEnvironment map[string]string,
+) ([]parser.Option, error) {
+	files := slices.Clone(affectedFiles)
 
 	if affectedFilesGitRevision != "" {
 		affectedFilesFromGit, err := helpers.GitDiff(projectDir, affectedFilesGitRevision, false)
 		if err != nil {
 			return nil, err
 		}
-		affectedFiles = append(affectedFiles, affectedFilesFromGit...)
+		files = append(files, affectedFilesFromGit...)
 	}
 
 	if affectedFilesGitCachedRevision != "" {
@@ -103,26 +170,36 @@ func readYaml(
 		if err != nil {
 			return nil, err
 		}
-		affectedFiles = append(affectedFiles, affectedFilesFromGit...)
+		files = append(files, affectedFilesFromGit...)
 	}
 
-	// Parse
-	p := parser.New(
-		parser.WithEnvironment(eenvironment.Merge(eenvironment.Static(), userSpecifiedEnvironment)),
-		parser.WithMissingInstancesAllowed(),
-		parser.WithAffectedFiles(affectedFiles),
-		parser.WithFileSystem(local.New(projectDir)),
-	)
-	result, err := p.Parse(ctx, combinedYAML)
-	if err != nil {
-		if re, ok := err.(*parsererror.Rich); ok {
-			fmt.Print(re.ContextLines())
+	// Files committed since the merge base of the simulated event
+	if event != "" && eventBase != "" {
+		mergeBase, err := eenvironment.MergeBase(projectDir, eventBase)
+		if err != nil {
+			return nil, err
 		}
 
-		return nil, err
+		affectedFilesFromGit, err := helpers.GitDiffCommits(projectDir, mergeBase, "HEAD")
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, affectedFilesFromGit...)
 	}
 
-	return result, nil
+	parserEnvironment := eenvironment.Static()
+
+	// The simulated event is only useful when it's visible to the "only_if" expressions
+	if event != "" {
+		parserEnvironment = baseEnvironment
+	}
+
+	return []parser.Option{
+		parser.WithEnvironment(eenvironment.Merge(parserEnvironment, userSpecifiedEnvironment)),
+		parser.WithMissingInstancesAllowed(),
+		parser.WithAffectedFiles(files),
+		parser.WithFileSystem(local.New(projectDir)),
+	}, nil
 }
 
 func run(cmd *cobra.Command, args []string) error {
@@ -143,7 +220,10 @@ func run(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	baseEnvironment := makeBaseEnvironment()
+	baseEnvironment, err := makeBaseEnvironment()
+	if err != nil {
+		return err
+	}
 
 	userSpecifiedEnvironment, err := makeUserSpecifiedEnvironment()
 	if err != nil {
@@ -158,11 +238,40 @@ func run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// Local secrets (resolved before enabling logging since we might prompt for a passphrase)
+	secretsProvider, err := makeSecretsProvider()
+	if err != nil {
+		return err
+	}
+	executorOpts = append(executorOpts, executor.WithSecretsProvider(secretsProvider))
+
+	// Interactive terminal
+	if terminal || debugOnFailure {
+		if terminal && debugOnFailure {
+			return fmt.Errorf("%w: --terminal and --debug-on-failure are mutually exclusive", ErrRun)
+		}
+
+		if !terminalserver.IsInteractive() {
+			return fmt.Errorf("%w: --terminal and --debug-on-failure require an interactive terminal", ErrRun)
+		}
+
+		// The interactive renderer would redraw the screen on top of the attached terminal
+		if output == logs.OutputAuto || output == logs.OutputInteractive {
+			output = logs.OutputSimple
+		}
+
+		executorOpts = append(executorOpts, executor.WithTerminal(debugOnFailure))
+	}
+
 	// Enable logging
 	logger, cancel := logs.GetLogger(output, verbose, cmd.OutOrStdout(), os.Stdout)
 	defer cancel()
 	executorOpts = append(executorOpts, executor.WithLogger(logger))
 
+	// Annotations
+	annotationsCollector := annotations.NewCollector()
+	executorOpts = append(executorOpts, executor.WithAnnotations(annotationsCollector))
+
 	// Enable a task filter if the task name is specified
 	if len(args) == 1 {
 		taskFilter := taskfilter.MatchExactTask(args[0])
@@ -225,7 +334,44 @@ func run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	return e.Run(cmd.Context())
+	runErr := e.Run(cmd.Context())
+
+	// Stop the interactive renderer so that the annotations summary is printed below it
+	cancel()
+
+	if err := reportAnnotations(cmd, logger, annotationsCollector); err != nil {
+		return errors.Join(runErr, err)
+	}
+
+	return runErr
+}
+
+func reportAnnotations(cmd *cobra.Command, logger *echelon.Logger, collector *annotations.Collector) error {
+	collectedAnnotations := collector.Annotations()
+
+	// Renderers that display the annotations natively did that already
+	if _, ok := logger.Renderer().(annotations.Renderer); !ok {
+		if err := annotations.WriteSummary(cmd.OutOrStdout(), collectedAnnotations); err != nil {
+			return err
+		}
+	}
+
+	if annotationsSARIF == "" {
+		return nil
+	}
+
+	sarifFile, err := os.Create(annotationsSARIF)
+	if err != nil {
+		return fmt.Errorf("%w: failed to write annotations to %s: %v", ErrRun, annotationsSARIF, err)
+	}
+
+	if err := annotations.WriteSARIF(sarifFile, collectedAnnotations, version.FullVersion); err != nil {
+		_ = sarifFile.Close()
+
+		return fmt.Errorf("%w: failed to write annotations to %s: %v", ErrRun, annotationsSARIF, err)
+	}
+
+	return sarifFile.Close()
 }
 
 func newRunCmd() *cobra.Command {
@@ -237,7 +383,10 @@ func newRunCmd() *cobra.Command {
 		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 			completions := []string{}
 
-			baseEnvironment := makeBaseEnvironment()
+			baseEnvironment, err := makeBaseEnvironment()
+			if err != nil {
+				return completions, cobra.ShellCompDirectiveError
+			}
 
 			userSpecifiedEnvironment, err := makeUserSpecifiedEnvironment()
 			if err != nil {
@@ -263,21 +412,11 @@ func newRunCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(&dirty, "dirty", false, "if set the project directory will "+
 		"be mounted in read-write mode, otherwise the project directory files are copied, taking .gitignore "+
 		"into account")
-	cmd.PersistentFlags().StringArrayVarP(&env, "env", "e", []string{},
-		"set (-e NAME=VALUE) or pass-through (-e NAME) an environment variable")
-	cmd.PersistentFlags().StringVar(&envFile, "env-file", "",
-		"set (NAME=VALUE on a separate line) or pass-through (NAME on a separate line) "+
-			"environment variables from the specified file")
-	cmd.PersistentFlags().StringSliceVar(&affectedFiles, "affected-files", []string{},
-		"comma-separated list of files to add to the list of affected files (used in changesInclude and "+
-			"changesIncludeOnly functions)")
-	cmd.PersistentFlags().StringVar(&affectedFilesGitRevision, "affected-files-git", "",
-		"Git revision (e.g. HEAD, v0.1.0 or commit SHA) to compare unstaged changes against and "+
-			"add changed files to the list of affected files (similarly to git diff)")
-	cmd.PersistentFlags().StringVar(&affectedFilesGitCachedRevision, "affected-files-git-cached", "",
-		"Git revision (e.g. HEAD, v0.1.0 or commit SHA) to compare staged changes against and "+
-			"add changed files to the list of affected files (similarly to git diff --cached)")
+	addEvaluationFlags(cmd)
 	cmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "")
+	cmd.PersistentFlags().StringVar(&annotationsSARIF, "annotations-sarif", "",
+		"write the annotations reported by the tasks (e.g. parsed from JUnit reports) "+
+			"to the specified file as a SARIF 2.1.0 log")
 	cmd.PersistentFlags().StringVarP(&output, "output", "o", logs.DefaultFormat(), fmt.Sprintf("output format of logs, "+
 		"supported values: %s", strings.Join(logs.Formats(), ", ")))
 
@@ -323,6 +462,19 @@ func newRunCmd() *cobra.Command {
 			"will be then dropped to the specified user after starting the \"cirrus localnetworkhelper\" helper process)")
 	}
 
+	// Secrets-related flags
+	cmd.PersistentFlags().StringVar(&secretsFile, "secrets-file", "",
+		"age-encrypted YAML file that maps the ENCRYPTED[...] values or the names of their variables to plaintext")
+	cmd.PersistentFlags().StringVar(&secretsIdentityFile, "secrets-identity", "",
+		"age identity file to decrypt the --secrets-file with (by default, the file is decrypted with a passphrase"+
+			" from the "+secretsPassphraseEnv+" environment variable or the one entered interactively)")
+
+	// Terminal-related flags
+	cmd.PersistentFlags().BoolVar(&terminal, "terminal", false,
+		"attach an interactive terminal to the task's instance once all of its instructions have run")
+	cmd.PersistentFlags().BoolVar(&debugOnFailure, "debug-on-failure", false,
+		"attach an interactive terminal to the task's instance only when the task fails")
+
 	// Flags useful for debugging
 	cmd.PersistentFlags().BoolVar(&debugNoCleanup, "debug-no-cleanup", false,
 		"don't remove containers and volumes after execution")
@@ -336,12 +488,61 @@ func newRunCmd() *cobra.Command {
 	return cmd
 }
 
-func makeBaseEnvironment() map[string]string {
+// addEvaluationFlags adds the flags that affect the evaluation of the configuration,
+// which are shared between the commands that need to see the same tasks as "cirrus run".
+func addEvaluationFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringArrayVarP(&env, "env", "e", []string{},
+		"set (-e NAME=VALUE) or pass-through (-e NAME) an environment variable")
+	cmd.PersistentFlags().StringVar(&envFile, "env-file", "",
+		"set (NAME=VALUE on a separate line) or pass-through (NAME on a separate line) "+
+			"environment variables from the specified file")
+	cmd.PersistentFlags().StringSliceVar(&affectedFiles, "affected-files", []string{},
+		"comma-separated list of files to add to the list of affected files (used in changesInclude and "+
+			"changesIncludeOnly functions)")
+	cmd.PersistentFlags().BoolVar(&monorepo, "monorepo", false,
+		"discover the configurations in the subdirectories and evaluate them as a single build, "+
+			"with the task names prefixed by their directory (e.g. services/api/build)")
+	cmd.PersistentFlags().StringVar(&affectedFilesGitRevision, "affected-files-git", "",
+		"Git revision (e.g. HEAD, v0.1.0 or commit SHA) to compare unstaged changes against and "+
+			"add changed files to the list of affected files (similarly to git diff)")
+	cmd.PersistentFlags().StringVar(&affectedFilesGitCachedRevision, "affected-files-git-cached", "",
+		"Git revision (e.g. HEAD, v0.1.0 or commit SHA) to compare staged changes against and "+
+			"add changed files to the list of affected files (similarly to git diff --cached)")
+	cmd.PersistentFlags().StringVar(&event, "event", "",
+		"simulate the environment of a CI event: push, pr[:NUMBER], cron:NAME, tag:NAME or api")
+	cmd.PersistentFlags().StringVar(&eventBase, "base", "",
+		"base branch of the simulated event (e.g. main), the files committed since the merge base "+
+			"are added to the list of affected files")
+}
+
+func makeBaseEnvironment() (map[string]string, error) {
+	if event == "" {
+		if eventBase != "" {
+			return nil, fmt.Errorf("%w: --base requires --event", eenvironment.ErrEvent)
+		}
+
+		return eenvironment.Merge(
+			eenvironment.Static(),
+			eenvironment.BuildID(),
+			eenvironment.ProjectSpecific(projectDir),
+		), nil
+	}
+
+	simulatedEvent, err := eenvironment.ParseEvent(event)
+	if err != nil {
+		return nil, err
+	}
+
+	eventEnvironment, err := simulatedEvent.Environment(projectDir, eventBase)
+	if err != nil {
+		return nil, err
+	}
+
 	return eenvironment.Merge(
 		eenvironment.Static(),
 		eenvironment.BuildID(),
-		eenvironment.ProjectSpecific(projectDir),
-	)
+		eventEnvironment,
+	), nil
 }
 
 func makeUserSpecifiedEnvironment() (map[string]string, error) {
@@ -361,3 +562,57 @@ func makeUserSpecifiedEnvironment() (map[string]string, error) {
 
 	return result, nil
 }
+
+const secretsPassphraseEnv = "CIRRUS_SECRETS_PASSPHRASE"
+
+func makeSecretsProvider() (secrets.Provider, error) {
+	// Variables from the CLI's environment are only used as a fallback,
+	// so that a stray CIRRUS_SECRET_<NAME> doesn't override the secrets from the file
+	envProvider := secrets.NewEnvProvider()
+
+	if secretsFile == "" {
+		return envProvider, nil
+	}
+
+	var identities []age.Identity
+
+	if secretsIdentityFile != "" {
+		fileIdentities, err := secrets.ParseIdentitiesFile(secretsIdentityFile)
+		if err != nil {
+			return nil, err
+		}
+
+		identities = fileIdentities
+	} else {
+		passphrase, ok := os.LookupEnv(secretsPassphraseEnv)
+		if !ok {
+			if !term.IsTerminal(int(os.Stdin.Fd())) {
+				return nil, fmt.Errorf("%w: --secrets-file requires either --secrets-identity, %s "+
+					"or an interactive terminal", ErrRun, secretsPassphraseEnv)
+			}
+
+			fmt.Fprintf(os.Stderr, "Enter passphrase for %s: ", secretsFile)
+			rawPassphrase, err := term.ReadPassword(int(os.Stdin.Fd()))
+			fmt.Fprintln(os.Stderr)
+			if err != nil {
+				return nil, fmt.Errorf("%w: failed to read passphrase: %v", ErrRun, err)
+			}
+
+			passphrase = string(rawPassphrase)
+		}
+
+		passphraseIdentity, err := secrets.PassphraseIdentity(passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		identities = append(identities, passphraseIdentity)
+	}
+
+	fileStore, err := secrets.NewFileStore(secretsFile, identities...)
+	if err != nil {
+		return nil, err
+	}
+
+	return secrets.Chain(fileStore, envProvider), nil
+}
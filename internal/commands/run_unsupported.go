@@ -7,3 +7,15 @@ import "github.com/spf13/cobra"
 func newRunCmd() *cobra.Command {
 	return nil
 }
+
+func newGraphCmd() *cobra.Command {
+	return nil
+}
+
+func newExplainCmd() *cobra.Command {
+	return nil
+}
+
+func newDiffCmd() *cobra.Command {
+	return nil
+}
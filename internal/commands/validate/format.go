@@ -0,0 +1,165 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cirruslabs/cirrus-cli/internal/executor/annotations"
+	"github.com/cirruslabs/cirrus-cli/internal/githubactions"
+	"github.com/cirruslabs/cirrus-cli/internal/version"
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
+)
+
+const (
+	formatText   = "text"
+	formatJSON   = "json"
+	formatSARIF  = "sarif"
+	formatGitHub = "github"
+)
+
+var formats = []string{formatText, formatJSON, formatSARIF, formatGitHub}
+
+type jsonIssue struct {
+	Path    string `json:"path,omitempty"`
+	Line    uint64 `json:"line"`
+	Column  uint64 `json:"column"`
+	Level   string `json:"level"`
+	Rule    string `json:"rule,omitempty"`
+	Message string `json:"message"`
+}
+
+func writeIssues(writer io.Writer, format string, issues []*api.Issue) error {
+	switch format {
+	case formatText:
+		return writeTextIssues(writer, issues)
+	case formatJSON:
+		return writeJSONIssues(writer, issues)
+	case formatSARIF:
+		return annotations.WriteSARIF(writer, issuesToAnnotations(issues), version.FullVersion)
+	case formatGitHub:
+		return writeGitHubIssues(writer, issues)
+	default:
+		return fmt.Errorf("%w: unsupported format %q, supported formats are: %s", ErrValidate,
+			format, strings.Join(formats, ", "))
+	}
+}
+
+func writeTextIssues(writer io.Writer, issues []*api.Issue) error {
+	for _, issue := range issues {
+		line := fmt.Sprintf("%s: %s: %s", issueLocation(issue), levelName(issue.Level), issue.Message)
+		if issue.Rule != "" {
+			line += fmt.Sprintf(" (%s)", issue.Rule)
+		}
+
+		if _, err := fmt.Fprintln(writer, line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeJSONIssues(writer io.Writer, issues []*api.Issue) error {
+	result := []jsonIssue{}
+
+	for _, issue := range issues {
+		result = append(result, jsonIssue{
+			Path:    issue.Path,
+			Line:    issue.Line,
+			Column:  issue.Column,
+			Level:   levelName(issue.Level),
+			Rule:    issue.Rule,
+			Message: issue.Message,
+		})
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(result)
+}
+
+// writeGitHubIssues prints the issues as GitHub Actions workflow commands[1].
+//
+// [1]: https://docs.github.com/en/actions/using-workflows/workflow-commands-for-github-actions
+func writeGitHubIssues(writer io.Writer, issues []*api.Issue) error {
+	for _, issue := range issues {
+		var level string
+
+		switch issue.Level {
+		case api.Issue_ERROR:
+			level = "error"
+		case api.Issue_WARNING:
+			level = "warning"
+		default:
+			level = "notice"
+		}
+
+		properties := []string{
+			"file=" + githubactions.EscapeProperty(issuePath(issue)),
+			fmt.Sprintf("line=%d", issue.Line),
+			fmt.Sprintf("col=%d", issue.Column),
+		}
+		if issue.Rule != "" {
+			properties = append(properties, "title="+githubactions.EscapeProperty(issue.Rule))
+		}
+
+		if _, err := fmt.Fprintf(writer, "::%s %s::%s\n", level, strings.Join(properties, ","),
+			githubactions.EscapeData(issue.Message)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func issuesToAnnotations(issues []*api.Issue) []annotations.Annotation {
+	var result []annotations.Annotation
+
+	for _, issue := range issues {
+		level := api.Annotation_NOTICE
+
+		switch issue.Level {
+		case api.Issue_ERROR:
+			level = api.Annotation_FAILURE
+		case api.Issue_WARNING:
+			level = api.Annotation_WARNING
+		}
+
+		result = append(result, annotations.Annotation{
+			Annotation: &api.Annotation{
+				Type:               api.Annotation_LINT_RESULT,
+				Level:              level,
+				Message:            issue.Message,
+				RawDetails:         issue.RawDetails,
+				FullyQualifiedName: issue.Rule,
+				FileLocation: &api.Annotation_FileLocation{
+					Path:        issuePath(issue),
+					StartLine:   int64(issue.Line),
+					EndLine:     int64(issue.Line),
+					StartColumn: int64(issue.Column),
+				},
+			},
+		})
+	}
+
+	return result
+}
+
+func issuePath(issue *api.Issue) string {
+	if issue.Path != "" {
+		return issue.Path
+	}
+
+	return ".cirrus.yml"
+}
+
+func issueLocation(issue *api.Issue) string {
+	return fmt.Sprintf("%s:%d:%d", issuePath(issue), issue.Line, issue.Column)
+}
+
+func levelName(level api.Issue_Level) string {
+	return strings.ToLower(level.String())
+}
@@ -1,17 +1,21 @@
 package validate
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"github.com/cirruslabs/cirrus-cli/internal/commands/helpers"
 	"github.com/cirruslabs/cirrus-cli/internal/evaluator"
 	eenvironment "github.com/cirruslabs/cirrus-cli/internal/executor/environment"
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
 	"github.com/cirruslabs/cirrus-cli/pkg/executorservice"
 	"github.com/cirruslabs/cirrus-cli/pkg/larker/fs/local"
 	"github.com/cirruslabs/cirrus-cli/pkg/parser"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/issue"
 	"github.com/cirruslabs/cirrus-cli/pkg/parser/parsererror"
 	"github.com/spf13/cobra"
 	"io"
+	"slices"
 	"strings"
 )
 
@@ -21,6 +25,14 @@ var ErrValidate = errors.New("validate failed")
 var validateFile string
 var environment []string
 var shouldPrint bool
+var monorepo bool
+
+// Lint flags.
+var lint bool
+var format string
+var errorExitCode int
+var warningExitCode int
+var infoExitCode int
 
 func additionalInstancesOption(stderr io.Writer) parser.Option {
 	// Try to retrieve additional instances from the Cirrus Cloud
@@ -61,6 +73,10 @@ func validate(cmd *cobra.Command, args []string) error {
 	var err error
 
 	switch {
+	case monorepo:
+		if validateFile != "" {
+			return fmt.Errorf("%w: --monorepo and --file cannot be used together", ErrValidate)
+		}
 	case validateFile == "":
 		configuration, err = helpers.ReadCombinedConfig(cmd.Context(), resultingEnvironment)
 		if err != nil {
@@ -80,15 +96,36 @@ func validate(cmd *cobra.Command, args []string) error {
 		return ErrValidate
 	}
 
+	projects := []parser.Project{{Config: configuration}}
+
+	if monorepo {
+		projects, err = helpers.ReadProjects(cmd.Context(), ".", resultingEnvironment)
+		if err != nil {
+			return err
+		}
+	}
+
 	if shouldPrint {
-		fmt.Fprint(cmd.OutOrStdout(), configuration)
+		for _, project := range projects {
+			if monorepo {
+				fmt.Fprintf(cmd.OutOrStdout(), "# %s\n", project.Dir)
+			}
+
+			fmt.Fprint(cmd.OutOrStdout(), project.Config)
+		}
 	}
 
 	// Parse
-	p := parser.New(parser.WithEnvironment(userSpecifiedEnvironment),
+	parserOpts := []parser.Option{
+		parser.WithEnvironment(userSpecifiedEnvironment),
 		additionalInstancesOption(cmd.ErrOrStderr()),
-		parser.WithFileSystem(local.New(".")))
-	_, err = p.Parse(cmd.Context(), configuration)
+		parser.WithFileSystem(local.New(".")),
+	}
+	if lint {
+		parserOpts = append(parserOpts, parser.WithLint())
+	}
+
+	result, err := parser.New(parserOpts...).ParseProjects(cmd.Context(), projects)
 	if err != nil {
 		if re, ok := err.(*parsererror.Rich); ok {
 			fmt.Print(re.ContextLines())
@@ -97,9 +134,66 @@ func validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if !lint {
+		return nil
+	}
+
+	issues := sortedIssues(result.Issues)
+
+	if err := writeIssues(cmd.OutOrStdout(), format, issues); err != nil {
+		return err
+	}
+
+	if exitCode := issuesExitCode(issues); exitCode != 0 {
+		return helpers.NewExitCodeError(exitCode, fmt.Errorf("%w: found %d issue(s)", ErrValidate, len(issues)))
+	}
+
 	return nil
 }
 
+// sortedIssues orders the issues by their file and position in the configuration
+// and points the issues in the main configuration to the validated file, if any.
+func sortedIssues(issues []*api.Issue) []*api.Issue {
+	result := slices.Clone(issues)
+
+	if strings.HasSuffix(validateFile, ".yml") || strings.HasSuffix(validateFile, ".yaml") {
+		for _, foundIssue := range result {
+			if foundIssue.Path == issue.PathYAML {
+				foundIssue.Path = validateFile
+			}
+		}
+	}
+
+	slices.SortStableFunc(result, func(a, b *api.Issue) int {
+		return cmp.Or(cmp.Compare(a.Path, b.Path), cmp.Compare(a.Line, b.Line), cmp.Compare(a.Column, b.Column))
+	})
+
+	return result
+}
+
+// issuesExitCode returns the highest exit code configured
+// for the severities of the found issues.
+func issuesExitCode(issues []*api.Issue) int {
+	var result int
+
+	for _, issue := range issues {
+		var exitCode int
+
+		switch issue.Level {
+		case api.Issue_ERROR:
+			exitCode = errorExitCode
+		case api.Issue_WARNING:
+			exitCode = warningExitCode
+		default:
+			exitCode = infoExitCode
+		}
+
+		result = max(result, exitCode)
+	}
+
+	return result
+}
+
 func NewValidateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "validate",
@@ -114,6 +208,20 @@ func NewValidateCmd() *cobra.Command {
 		"use file as the configuration file (the path should end with either .yml or ..star)")
 	cmd.PersistentFlags().BoolVarP(&shouldPrint, "print", "p", false,
 		"print the configuration as YAML (useful for debugging Starlark files)")
+	cmd.PersistentFlags().BoolVar(&monorepo, "monorepo", false,
+		"validate the configurations discovered in the subdirectories as a single build")
+
+	// Lint flags
+	cmd.PersistentFlags().BoolVar(&lint, "lint", false,
+		"additionally check the configuration for common mistakes and print all the found issues")
+	cmd.PersistentFlags().StringVar(&format, "format", formatText,
+		fmt.Sprintf("output format of the issues found with --lint (%s)", strings.Join(formats, ", ")))
+	cmd.PersistentFlags().IntVar(&errorExitCode, "error-exit-code", 1,
+		"exit code to use when --lint finds issues with an error severity")
+	cmd.PersistentFlags().IntVar(&warningExitCode, "warning-exit-code", 0,
+		"exit code to use when --lint finds issues with a warning severity")
+	cmd.PersistentFlags().IntVar(&infoExitCode, "info-exit-code", 0,
+		"exit code to use when --lint finds issues with an info severity")
 
 	return cmd
 }
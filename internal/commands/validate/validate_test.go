@@ -2,9 +2,12 @@ package validate_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"github.com/cirruslabs/cirrus-cli/internal/commands"
+	"github.com/cirruslabs/cirrus-cli/internal/commands/helpers"
 	"github.com/cirruslabs/cirrus-cli/internal/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"io"
 	"os"
 	"testing"
@@ -28,3 +31,53 @@ func TestValidateCloudInstance(t *testing.T) {
 	assert.NotContains(t, buf.String(), "failed",
 		"additional instance should be fetched and transformed successfully")
 }
+
+func TestValidateLint(t *testing.T) {
+	testutil.TempChdirPopulatedWith(t, "testdata/lint")
+
+	buf := bytes.NewBufferString("")
+
+	command := commands.NewRootCmd()
+	command.SetArgs([]string{"validate", "--lint"})
+	command.SetOut(buf)
+	command.SetErr(io.Discard)
+	require.NoError(t, command.Execute())
+
+	assert.Contains(t, buf.String(), ".cirrus.yml:7:3: warning: ")
+	assert.Contains(t, buf.String(), "(duplicate-cache)")
+}
+
+func TestValidateWithoutLintPrintsNoIssues(t *testing.T) {
+	testutil.TempChdirPopulatedWith(t, "testdata/lint")
+
+	buf := bytes.NewBufferString("")
+
+	command := commands.NewRootCmd()
+	command.SetArgs([]string{"validate"})
+	command.SetOut(buf)
+	command.SetErr(buf)
+	require.NoError(t, command.Execute())
+
+	assert.NotContains(t, buf.String(), "duplicate-cache")
+}
+
+func TestValidateLintExitCode(t *testing.T) {
+	testutil.TempChdirPopulatedWith(t, "testdata/lint")
+
+	buf := bytes.NewBufferString("")
+
+	command := commands.NewRootCmd()
+	command.SetArgs([]string{"validate", "--lint", "--format", "json", "--warning-exit-code", "3"})
+	command.SetOut(buf)
+	command.SetErr(io.Discard)
+
+	var exitCodeError helpers.ExitCodeError
+	require.ErrorAs(t, command.Execute(), &exitCodeError)
+	assert.Equal(t, 3, exitCodeError.ExitCode())
+
+	var issues []map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &issues))
+	require.Len(t, issues, 1)
+	assert.Equal(t, "duplicate-cache", issues[0]["rule"])
+	assert.Equal(t, "warning", issues[0]["level"])
+}
@@ -11,8 +11,10 @@ import (
 
 	"github.com/cirruslabs/cirrus-cli/internal/executor/endpoint"
 	"github.com/cirruslabs/cirrus-cli/internal/worker"
+	"github.com/cirruslabs/cirrus-cli/internal/worker/imagemanager"
 	"github.com/cirruslabs/cirrus-cli/internal/worker/resourcemodifier"
 	"github.com/cirruslabs/cirrus-cli/internal/worker/security"
+	"github.com/cirruslabs/cirrus-cli/internal/worker/sshinventory"
 	"github.com/cirruslabs/cirrus-cli/internal/worker/tuning"
 	"github.com/cirruslabs/cirrus-cli/internal/worker/upstream"
 	"github.com/dustin/go-humanize"
@@ -46,6 +48,12 @@ type Config struct {
 	ResourceModifiers []*resourcemodifier.Modifier `yaml:"resource-modifiers"`
 
 	TartPrePull *worker.TartPrePull `yaml:"tart-pre-pull"`
+
+	Images *imagemanager.Config `yaml:"images"`
+
+	DiskQuota *worker.DiskQuota `yaml:"disk-quota"`
+
+	SSHHosts []*sshinventory.Host `yaml:"ssh-hosts"`
 }
 
 type ConfigLog struct {
@@ -62,6 +70,11 @@ type ConfigRPC struct {
 type ConfigUpstream struct {
 	Token    string `yaml:"token"`
 	Endpoint string `yaml:"endpoint"`
+
+	// Weight and resource quota used to fairly share
+	// the worker's resources between multiple upstreams
+	Weight    float64            `yaml:"weight"`
+	Resources map[string]float64 `yaml:"resources"`
 }
 
 var (
@@ -132,26 +145,48 @@ func parseConfig(path string) (*Config, error) {
 		}
 	}
 
+	if config.Images != nil {
+		if err := config.Images.Validate(); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrConfiguration, err)
+		}
+	}
+
+	if config.DiskQuota != nil {
+		configSecurity := config.Security
+		if configSecurity == nil {
+			configSecurity = security.NoSecurity()
+		}
+
+		if err := config.DiskQuota.Validate(configSecurity); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrConfiguration, err)
+		}
+	}
+
 	return &config, nil
 }
 
 func buildWorker(output io.Writer, opts ...worker.Option) (*worker.Worker, error) {
+	worker, _, _, err := buildWorkerWithConfig(output, opts...)
+
+	return worker, err
+}
+
+func buildWorkerWithConfig(
+	output io.Writer,
+	opts ...worker.Option,
+) (*worker.Worker, *Config, logrus.FieldLogger, error) {
 	config, err := parseConfig(configPath)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
-	// Configure worker
-	opts = append(opts, worker.WithLabels(config.Labels),
-		worker.WithResources(config.Resources))
-
 	// Configure logging
 	logger := logrus.New()
 
 	if config.Log.Level != "" {
 		level, err := logrus.ParseLevel(config.Log.Level)
 		if err != nil {
-			return nil, err
+			return nil, nil, nil, err
 		}
 
 		logger.SetLevel(level)
@@ -162,7 +197,7 @@ func buildWorker(output io.Writer, opts ...worker.Option) (*worker.Worker, error
 		if config.Log.RotateSize != "" {
 			logRotateSizeBytes, err = humanize.ParseBytes(config.Log.RotateSize)
 			if err != nil {
-				return nil, fmt.Errorf("failed to parse log size for rotation: %w", err)
+				return nil, nil, nil, fmt.Errorf("failed to parse log size for rotation: %w", err)
 			}
 		}
 
@@ -177,24 +212,12 @@ func buildWorker(output io.Writer, opts ...worker.Option) (*worker.Worker, error
 	opts = append(opts, worker.WithLogger(logger))
 
 	// Configure upstreams
-	if len(config.Upstreams) == 0 {
-		config.Upstreams = append(config.Upstreams, ConfigUpstream{
-			Token:    config.Token,
-			Endpoint: config.RPC.Endpoint,
-		})
-	} else {
-		if config.Token != "" {
-			return nil, fmt.Errorf("%w: \"token:\" and \"endpoints:\" are mutually exclusive",
-				ErrConfiguration)
-		}
-
-		if config.RPC.Endpoint != upstream.DefaultRPCEndpoint {
-			return nil, fmt.Errorf("%w: \"rpc:\" and \"endpoints:\" are mutually exclusive",
-				ErrConfiguration)
-		}
+	configUpstreams, err := config.upstreams()
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
-	for _, configUpstream := range config.Upstreams {
+	for _, configUpstream := range configUpstreams {
 		upstreamOpts := []upstream.Option{upstream.WithLogger(logger)}
 
 		if configUpstream.Endpoint != "" {
@@ -204,14 +227,76 @@ func buildWorker(output io.Writer, opts ...worker.Option) (*worker.Worker, error
 			))
 		}
 
+		if configUpstream.Weight != 0 {
+			upstreamOpts = append(upstreamOpts, upstream.WithWeight(configUpstream.Weight))
+		}
+
+		if len(configUpstream.Resources) != 0 {
+			upstreamOpts = append(upstreamOpts, upstream.WithResourceQuota(configUpstream.Resources))
+		}
+
 		upstream, err := upstream.New(config.Name, configUpstream.Token, upstreamOpts...)
 		if err != nil {
-			return nil, err
+			return nil, nil, nil, err
 		}
 
 		opts = append(opts, worker.WithUpstream(upstream))
 	}
 
+	// Configure SSH inventory
+	if len(config.SSHHosts) != 0 {
+		sshInventory, err := sshinventory.New(config.SSHHosts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		opts = append(opts, worker.WithSSHInventory(sshInventory))
+	}
+
+	opts = append(opts, reloadableOptions(config)...)
+
+	// Instantiate worker
+	worker, err := worker.New(opts...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return worker, config, logger, nil
+}
+
+// upstreams returns the configured upstreams or a single upstream
+// derived from the "token:" and "rpc:" fields when none are configured.
+func (config *Config) upstreams() ([]ConfigUpstream, error) {
+	if len(config.Upstreams) == 0 {
+		return []ConfigUpstream{
+			{
+				Token:    config.Token,
+				Endpoint: config.RPC.Endpoint,
+			},
+		}, nil
+	}
+
+	if config.Token != "" {
+		return nil, fmt.Errorf("%w: \"token:\" and \"endpoints:\" are mutually exclusive",
+			ErrConfiguration)
+	}
+
+	if config.RPC.Endpoint != upstream.DefaultRPCEndpoint {
+		return nil, fmt.Errorf("%w: \"rpc:\" and \"endpoints:\" are mutually exclusive",
+			ErrConfiguration)
+	}
+
+	return config.Upstreams, nil
+}
+
+// reloadableOptions returns worker options for the settings
+// that can be changed without restarting the worker.
+func reloadableOptions(config *Config) []worker.Option {
+	opts := []worker.Option{
+		worker.WithLabels(config.Labels),
+		worker.WithResources(config.Resources),
+	}
+
 	// Configure security
 	if security := config.Security; security != nil {
 		opts = append(opts, worker.WithSecurity(security))
@@ -237,6 +322,13 @@ func buildWorker(output io.Writer, opts ...worker.Option) (*worker.Worker, error
 		opts = append(opts, worker.WithTartPrePull(config.TartPrePull))
 	}
 
-	// Instantiate worker
-	return worker.New(opts...)
+	if config.Images != nil {
+		opts = append(opts, worker.WithImageManagement(config.Images))
+	}
+
+	if config.DiskQuota != nil {
+		opts = append(opts, worker.WithDiskQuota(config.DiskQuota))
+	}
+
+	return opts
 }
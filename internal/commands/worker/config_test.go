@@ -2,6 +2,8 @@
 package worker
 
 import (
+	"github.com/cirruslabs/cirrus-cli/internal/worker/imagemanager"
+	"github.com/cirruslabs/cirrus-cli/internal/worker/upstream"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"path/filepath"
@@ -112,3 +114,75 @@ func TestStandby(t *testing.T) {
 	require.Equal(t, "xcrun simctl list || true", config.Standby.Warmup.Script)
 	require.Equal(t, uint64(600), config.Standby.Warmup.TimeoutSeconds)
 }
+
+func TestUpstreamsWeightsAndQuotas(t *testing.T) {
+	config, err := parseConfig(filepath.Join("testdata", "upstreams-fair.yml"))
+	require.NoError(t, err)
+
+	// The "--rpc-endpoint" flag's default value is not set when calling parseConfig() directly
+	config.RPC.Endpoint = upstream.DefaultRPCEndpoint
+
+	upstreams, err := config.upstreams()
+	require.NoError(t, err)
+	require.Len(t, upstreams, 2)
+
+	require.Equal(t, 3.0, upstreams[0].Weight)
+	require.Equal(t, map[string]float64{"tart-vms": 1}, upstreams[0].Resources)
+
+	require.Zero(t, upstreams[1].Weight)
+	require.Empty(t, upstreams[1].Resources)
+}
+
+func TestImages(t *testing.T) {
+	config, err := parseConfig(filepath.Join("testdata", "images.yml"))
+	require.NoError(t, err)
+
+	require.Equal(t, &imagemanager.Config{
+		PrePull: []imagemanager.Image{
+			{Isolation: imagemanager.KindTart, Name: "ghcr.io/cirruslabs/macos-sequoia-xcode:latest"},
+			{Isolation: imagemanager.KindContainer, Name: "ghcr.io/cirruslabs/flutter:latest"},
+		},
+		CheckInterval:        6 * time.Hour,
+		Jitter:               30 * time.Minute,
+		EvictUnusedAfterDays: 7,
+		MaxDiskUsage:         85,
+	}, config.Images)
+
+	_, err = parseConfig(filepath.Join("testdata", "images-invalid-isolation.yml"))
+	require.ErrorIs(t, err, imagemanager.ErrInvalidConfig)
+}
+
+func TestDiskQuota(t *testing.T) {
+	config, err := parseConfig(filepath.Join("testdata", "disk-quota.yml"))
+	require.NoError(t, err)
+
+	perTaskBytes, err := config.DiskQuota.PerTaskBytes()
+	require.NoError(t, err)
+	require.EqualValues(t, 50_000_000_000, perTaskBytes)
+	require.Equal(t, time.Minute, config.DiskQuota.CheckInterval)
+}
+
+func TestDiskQuotaDefaultSecurity(t *testing.T) {
+	config, err := parseConfig(filepath.Join("testdata", "disk-quota-default-security.yml"))
+	require.NoError(t, err)
+
+	perTaskBytes, err := config.DiskQuota.PerTaskBytes()
+	require.NoError(t, err)
+	require.EqualValues(t, 50_000_000_000, perTaskBytes)
+}
+
+func TestDiskQuotaUnsupportedIsolation(t *testing.T) {
+	_, err := parseConfig(filepath.Join("testdata", "disk-quota-unsupported-isolation.yml"))
+	require.ErrorIs(t, err, ErrConfiguration)
+	require.ErrorContains(t, err, "disk quota cannot be enforced for tart isolation")
+}
+
+func TestUpstreamsAndRPCAreMutuallyExclusive(t *testing.T) {
+	config, err := parseConfig(filepath.Join("testdata", "upstreams-fair.yml"))
+	require.NoError(t, err)
+
+	config.RPC.Endpoint = "https://grpc.example.com:443"
+
+	_, err = config.upstreams()
+	require.ErrorIs(t, err, ErrConfiguration)
+}
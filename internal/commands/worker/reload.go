@@ -0,0 +1,236 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/cirruslabs/cirrus-cli/internal/worker"
+	"github.com/cirruslabs/cirrus-cli/internal/worker/sshinventory"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var ErrReload = errors.New("failed to reload the worker configuration")
+
+var pidFile string
+
+func NewReloadCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reload",
+		Short: "Validate the configuration file and ask the running worker to reload it",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if pidFile == "" {
+				return fmt.Errorf("%w: --pid-file is required", ErrReload)
+			}
+
+			// Validate the configuration before bothering the running worker
+			config, err := parseConfig(configPath)
+			if err != nil {
+				return fmt.Errorf("%w: %v", ErrReload, err)
+			}
+
+			if _, err := config.upstreams(); err != nil {
+				return fmt.Errorf("%w: %v", ErrReload, err)
+			}
+
+			pidBytes, err := os.ReadFile(pidFile)
+			if err != nil {
+				return fmt.Errorf("%w: %v", ErrReload, err)
+			}
+
+			pid, err := strconv.Atoi(strings.TrimSpace(string(pidBytes)))
+			if err != nil {
+				return fmt.Errorf("%w: invalid PID file %s: %v", ErrReload, pidFile, err)
+			}
+
+			process, err := os.FindProcess(pid)
+			if err != nil {
+				return fmt.Errorf("%w: %v", ErrReload, err)
+			}
+
+			if err := process.Signal(syscall.SIGHUP); err != nil {
+				return fmt.Errorf("%w: failed to signal the worker process %d: %v", ErrReload, pid, err)
+			}
+
+			return nil
+		},
+	}
+
+	attachFlags(cmd)
+	attachPIDFileFlag(cmd)
+
+	return cmd
+}
+
+func attachPIDFileFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&pidFile, "pid-file", "",
+		"path to the file containing the PID of the running worker (e.g. /var/run/cirrus-worker.pid)")
+}
+
+// reloadConfig re-reads the configuration file and applies the settings that can be changed
+// at runtime to the running worker. The new configuration is rejected if it fails to parse
+// or attempts to change settings that require a restart.
+func reloadConfig(
+	ctx context.Context,
+	worker *worker.Worker,
+	oldConfig *Config,
+	logger logrus.FieldLogger,
+) (*Config, error) {
+	newConfig, err := parseConfig(configPath)
+	if err != nil {
+		logger.Errorf("rejecting the new configuration: %v", err)
+
+		return nil, fmt.Errorf("%w: %v", ErrReload, err)
+	}
+
+	diff := configDiff(oldConfig, newConfig)
+
+	if err := validateReload(oldConfig, newConfig); err != nil {
+		logger.Errorf("rejecting the new configuration: %v, diff:\n%s", err, diff)
+
+		return nil, fmt.Errorf("%w: %v", ErrReload, err)
+	}
+
+	if diff == "" {
+		logger.Infof("configuration file has not changed, reloading anyway")
+	} else {
+		logger.Infof("reloading the configuration, diff:\n%s", diff)
+	}
+
+	if err := worker.Reconfigure(ctx, reloadableOptions(newConfig)...); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrReload, err)
+	}
+
+	return newConfig, nil
+}
+
+// validateReload ensures that the new configuration only changes
+// the settings that can be applied without restarting the worker.
+func validateReload(oldConfig *Config, newConfig *Config) error {
+	if oldConfig.Name != newConfig.Name {
+		return fmt.Errorf("%w: \"name:\" cannot be changed without a restart", ErrConfiguration)
+	}
+
+	if oldConfig.Log != newConfig.Log {
+		return fmt.Errorf("%w: \"log:\" cannot be changed without a restart", ErrConfiguration)
+	}
+
+	// Compare the marshalled representation since the hosts
+	// of the running configuration have their keys already loaded
+	oldSSHHosts, err := yaml.Marshal(oldConfig.SSHHosts)
+	if err != nil {
+		return err
+	}
+
+	newSSHHosts, err := yaml.Marshal(newConfig.SSHHosts)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(oldSSHHosts, newSSHHosts) {
+		return fmt.Errorf("%w: \"ssh-hosts:\" cannot be changed without a restart", ErrConfiguration)
+	}
+
+	oldUpstreams, err := oldConfig.upstreams()
+	if err != nil {
+		return err
+	}
+
+	newUpstreams, err := newConfig.upstreams()
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(oldUpstreams, newUpstreams) {
+		return fmt.Errorf("%w: \"token:\", \"rpc:\" and \"upstreams:\" cannot be changed without a restart",
+			ErrConfiguration)
+	}
+
+	return nil
+}
+
+const redacted = "<redacted>"
+
+// configDiff produces a human-readable diff of the configurations
+// with the secrets redacted, since it ends up in the worker's log.
+func configDiff(oldConfig *Config, newConfig *Config) string {
+	oldYAML, err := marshalConfig(redactConfig(oldConfig))
+	if err != nil {
+		return fmt.Sprintf("failed to produce a diff: %v", err)
+	}
+
+	newYAML, err := marshalConfig(redactConfig(newConfig))
+	if err != nil {
+		return fmt.Sprintf("failed to produce a diff: %v", err)
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(oldYAML)),
+		B:        difflib.SplitLines(string(newYAML)),
+		FromFile: "old",
+		ToFile:   "new",
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Sprintf("failed to produce a diff: %v", err)
+	}
+
+	return diff
+}
+
+func marshalConfig(config *Config) ([]byte, error) {
+	var buf bytes.Buffer
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(config); err != nil {
+		return nil, err
+	}
+
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// redactConfig returns a copy of the configuration with the tokens and passphrases
+// replaced by a placeholder, yet still allowing to see whether they were set.
+func redactConfig(config *Config) *Config {
+	result := *config
+
+	result.Token = redactSecret(result.Token)
+
+	result.Upstreams = make([]ConfigUpstream, 0, len(config.Upstreams))
+	for _, configUpstream := range config.Upstreams {
+		configUpstream.Token = redactSecret(configUpstream.Token)
+		result.Upstreams = append(result.Upstreams, configUpstream)
+	}
+
+	result.SSHHosts = make([]*sshinventory.Host, 0, len(config.SSHHosts))
+	for _, host := range config.SSHHosts {
+		hostCopy := *host
+		hostCopy.PrivateKeyPassphrase = redactSecret(hostCopy.PrivateKeyPassphrase)
+		result.SSHHosts = append(result.SSHHosts, &hostCopy)
+	}
+
+	return &result
+}
+
+func redactSecret(secret string) string {
+	if secret == "" {
+		return ""
+	}
+
+	return redacted
+}
@@ -0,0 +1,50 @@
+//nolint:testpackage // we need to call the parseConfig() and validateReload(), which are private
+package worker
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/worker/sshinventory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloadAllowsReloadableChanges(t *testing.T) {
+	oldConfig, err := parseConfig(filepath.Join("testdata", "reload-old.yml"))
+	require.NoError(t, err)
+
+	newConfig, err := parseConfig(filepath.Join("testdata", "reload-new-labels.yml"))
+	require.NoError(t, err)
+
+	require.NoError(t, validateReload(oldConfig, newConfig))
+
+	diff := configDiff(oldConfig, newConfig)
+	require.Contains(t, diff, "+  gpu: \"true\"")
+	require.Contains(t, diff, "-  cpu: 4")
+	require.Contains(t, diff, "+  cpu: 8")
+}
+
+func TestReloadRejectsNonReloadableChanges(t *testing.T) {
+	oldConfig, err := parseConfig(filepath.Join("testdata", "reload-old.yml"))
+	require.NoError(t, err)
+
+	newConfig, err := parseConfig(filepath.Join("testdata", "reload-new-token.yml"))
+	require.NoError(t, err)
+
+	require.ErrorIs(t, validateReload(oldConfig, newConfig), ErrConfiguration)
+}
+
+func TestReloadDiffRedactsSecrets(t *testing.T) {
+	oldConfig, err := parseConfig(filepath.Join("testdata", "reload-old.yml"))
+	require.NoError(t, err)
+
+	newConfig, err := parseConfig(filepath.Join("testdata", "reload-new-token.yml"))
+	require.NoError(t, err)
+
+	newConfig.Upstreams = []ConfigUpstream{{Token: "upstream-secret", Endpoint: "https://grpc.cirrus-ci.com:443"}}
+	newConfig.SSHHosts = []*sshinventory.Host{{Name: "mac-1", PrivateKeyPassphrase: "passphrase-secret"}}
+
+	diff := configDiff(oldConfig, newConfig)
+	require.Contains(t, diff, "+    private-key-passphrase: <redacted>")
+	require.NotContains(t, diff, "secret")
+}
@@ -8,7 +8,11 @@ import (
 	"github.com/cirruslabs/cirrus-cli/internal/worker"
 	oldprivdrop "github.com/cirruslabs/cirrus-cli/pkg/privdrop"
 	"github.com/spf13/cobra"
+	"os"
+	"os/signal"
 	"runtime"
+	"strconv"
+	"syscall"
 )
 
 var ErrRun = errors.New("run failed")
@@ -44,10 +48,39 @@ func NewRunCmd() *cobra.Command {
 				}
 			}
 
-			worker, err := buildWorker(cmd.ErrOrStderr(), opts...)
+			worker, config, logger, err := buildWorkerWithConfig(cmd.ErrOrStderr(), opts...)
 			if err != nil {
 				return err
 			}
+
+			if pidFile != "" {
+				if err := os.WriteFile(pidFile, []byte(strconv.Itoa(os.Getpid())), 0600); err != nil {
+					return fmt.Errorf("%w: failed to write PID file: %v", ErrRun, err)
+				}
+				defer os.Remove(pidFile)
+			}
+
+			// Reload the configuration on SIGHUP
+			reloadCh := make(chan os.Signal, 1)
+			signal.Notify(reloadCh, syscall.SIGHUP)
+			defer signal.Stop(reloadCh)
+
+			go func() {
+				for {
+					select {
+					case <-reloadCh:
+						newConfig, err := reloadConfig(cmd.Context(), worker, config, logger)
+						if err != nil {
+							continue
+						}
+
+						config = newConfig
+					case <-cmd.Context().Done():
+						return
+					}
+				}
+			}()
+
 			if err := worker.Run(cmd.Context()); err != nil {
 				return fmt.Errorf("%w: %v", ErrRun, err)
 			}
@@ -79,6 +112,7 @@ func NewRunCmd() *cobra.Command {
 	}
 
 	attachFlags(cmd)
+	attachPIDFileFlag(cmd)
 
 	return cmd
 }
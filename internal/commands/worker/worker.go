@@ -15,6 +15,7 @@ func NewRootCmd() *cobra.Command {
 		NewRunCmd(),
 		NewPauseCmd(),
 		NewResumeCmd(),
+		NewReloadCmd(),
 	}
 
 	return helpers.ConsumeSubCommands(cmd, commands)
@@ -0,0 +1,118 @@
+package annotations
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
+)
+
+// Annotation is an annotation reported by the agent (e.g. parsed from a JUnit report)
+// along with the task it was reported for.
+type Annotation struct {
+	Task string
+	*api.Annotation
+}
+
+// Renderer is implemented by the log renderers that are able
+// to display the annotations natively, e.g. as GitHub Actions
+// workflow commands or TeamCity inspections.
+type Renderer interface {
+	RenderAnnotation(task string, annotation *api.Annotation)
+}
+
+// Collector accumulates the annotations reported by all tasks of the build.
+type Collector struct {
+	annotations []Annotation
+	mtx         sync.Mutex
+}
+
+func NewCollector() *Collector {
+	return &Collector{}
+}
+
+func (collector *Collector) Add(task string, annotations ...*api.Annotation) {
+	collector.mtx.Lock()
+	defer collector.mtx.Unlock()
+
+	for _, annotation := range annotations {
+		collector.annotations = append(collector.annotations, Annotation{
+			Task:       task,
+			Annotation: annotation,
+		})
+	}
+}
+
+func (collector *Collector) Annotations() []Annotation {
+	collector.mtx.Lock()
+	defer collector.mtx.Unlock()
+
+	return append([]Annotation{}, collector.annotations...)
+}
+
+// Level returns a human-readable level of the annotation.
+func Level(annotation *api.Annotation) string {
+	switch annotation.Level {
+	case api.Annotation_WARNING:
+		return "warning"
+	case api.Annotation_FAILURE:
+		return "error"
+	default:
+		return "notice"
+	}
+}
+
+// Location returns the "path:line" location of the annotation or an empty string if it has none.
+func Location(annotation *api.Annotation) string {
+	fileLocation := annotation.FileLocation
+	if fileLocation == nil || fileLocation.Path == "" {
+		return ""
+	}
+
+	if fileLocation.StartLine <= 0 {
+		return fileLocation.Path
+	}
+
+	if fileLocation.EndLine > fileLocation.StartLine {
+		return fmt.Sprintf("%s:%d-%d", fileLocation.Path, fileLocation.StartLine, fileLocation.EndLine)
+	}
+
+	return fmt.Sprintf("%s:%d", fileLocation.Path, fileLocation.StartLine)
+}
+
+// WriteSummary writes the annotations as a table for the renderers that can't display them natively.
+func WriteSummary(writer io.Writer, annotations []Annotation) error {
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	if _, err := fmt.Fprintf(writer, "\nAnnotations (%d):\n", len(annotations)); err != nil {
+		return err
+	}
+
+	tabWriter := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+
+	if _, err := fmt.Fprintln(tabWriter, "LEVEL\tTASK\tLOCATION\tMESSAGE"); err != nil {
+		return err
+	}
+
+	for _, annotation := range annotations {
+		location := Location(annotation.Annotation)
+		if location == "" {
+			location = "-"
+		}
+
+		// Keep the table intact for the multi-line messages
+		message := strings.Join(strings.Fields(annotation.Message), " ")
+
+		if _, err := fmt.Fprintf(tabWriter, "%s\t%s\t%s\t%s\n", Level(annotation.Annotation),
+			annotation.Task, location, message); err != nil {
+			return err
+		}
+	}
+
+	return tabWriter.Flush()
+}
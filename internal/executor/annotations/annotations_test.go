@@ -0,0 +1,67 @@
+package annotations_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/executor/annotations"
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
+	"github.com/stretchr/testify/require"
+)
+
+func exampleAnnotations() []*api.Annotation {
+	return []*api.Annotation{
+		{
+			Type:               api.Annotation_LINT_RESULT,
+			Level:              api.Annotation_WARNING,
+			Message:            "use of os.SEEK_START is deprecated",
+			FullyQualifiedName: "staticcheck.SA1019",
+			FileLocation: &api.Annotation_FileLocation{
+				Path:      "main.go",
+				StartLine: 35,
+				EndLine:   35,
+			},
+		},
+		{
+			Type:       api.Annotation_TEST_RESULT,
+			Level:      api.Annotation_FAILURE,
+			Message:    "TestMain() failed!",
+			RawDetails: "main_test.go:18: expected a non-nil return",
+			FileLocation: &api.Annotation_FileLocation{
+				Path:      "main_test.go",
+				StartLine: 18,
+				EndLine:   20,
+			},
+		},
+		{
+			Message: "coverage\nhas decreased",
+		},
+	}
+}
+
+func TestCollectorAndSummary(t *testing.T) {
+	collector := annotations.NewCollector()
+	collector.Add("lint", exampleAnnotations()[0])
+	collector.Add("test", exampleAnnotations()[1:]...)
+
+	collected := collector.Annotations()
+	require.Len(t, collected, 3)
+	require.Equal(t, "lint", collected[0].Task)
+	require.Equal(t, "test", collected[2].Task)
+
+	var buf bytes.Buffer
+	require.NoError(t, annotations.WriteSummary(&buf, collected))
+	require.Equal(t, `
+Annotations (3):
+LEVEL    TASK  LOCATION            MESSAGE
+warning  lint  main.go:35          use of os.SEEK_START is deprecated
+error    test  main_test.go:18-20  TestMain() failed!
+notice   test  -                   coverage has decreased
+`, buf.String())
+}
+
+func TestSummaryEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, annotations.WriteSummary(&buf, nil))
+	require.Empty(t, buf.String())
+}
@@ -0,0 +1,219 @@
+package annotations
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Version        string      `json:"version,omitempty"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifResult struct {
+	RuleID     string            `json:"ruleId"`
+	RuleIndex  int               `json:"ruleIndex"`
+	Level      string            `json:"level"`
+	Message    sarifMessage      `json:"message"`
+	Locations  []sarifLocation   `json:"locations,omitempty"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId,omitempty"`
+}
+
+type sarifRegion struct {
+	StartLine   int64 `json:"startLine"`
+	EndLine     int64 `json:"endLine,omitempty"`
+	StartColumn int64 `json:"startColumn,omitempty"`
+	EndColumn   int64 `json:"endColumn,omitempty"`
+}
+
+// WriteSARIF writes the annotations as a SARIF 2.1.0 log[1] suitable
+// for uploading to the code scanning services, such as GitHub's.
+//
+// [1]: https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+func WriteSARIF(writer io.Writer, annotations []Annotation, toolVersion string) error {
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           "Cirrus CLI",
+				InformationURI: "https://github.com/cirruslabs/cirrus-cli",
+				Version:        toolVersion,
+				Rules:          []sarifRule{},
+			},
+		},
+		Results: []sarifResult{},
+	}
+
+	ruleIndexes := map[string]int{}
+
+	for _, annotation := range annotations {
+		ruleID := sarifRuleID(annotation.Annotation)
+
+		ruleIndex, ok := ruleIndexes[ruleID]
+		if !ok {
+			ruleIndex = len(run.Tool.Driver.Rules)
+			ruleIndexes[ruleID] = ruleIndex
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{
+				ID:               ruleID,
+				ShortDescription: sarifMessage{Text: ruleID},
+			})
+		}
+
+		text := annotation.Message
+		if annotation.RawDetails != "" {
+			text += "\n" + annotation.RawDetails
+		}
+
+		result := sarifResult{
+			RuleID:    ruleID,
+			RuleIndex: ruleIndex,
+			Level:     sarifLevel(annotation.Level),
+			Message:   sarifMessage{Text: text},
+		}
+
+		if annotation.Task != "" {
+			result.Properties = map[string]string{
+				"task": annotation.Task,
+			}
+		}
+
+		if location := sarifLocationFor(annotation.FileLocation); location != nil {
+			result.Locations = []sarifLocation{*location}
+		}
+
+		run.Results = append(run.Results, result)
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(&sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRun{run},
+	})
+}
+
+// sarifRuleID prefers the fully qualified name (e.g. a test name or a linter's check)
+// and falls back to the annotation type to group the results.
+func sarifRuleID(annotation *api.Annotation) string {
+	if annotation.FullyQualifiedName != "" {
+		return annotation.FullyQualifiedName
+	}
+
+	return strings.ToLower(annotation.Type.String())
+}
+
+func sarifLevel(level api.Annotation_Level) string {
+	switch level {
+	case api.Annotation_WARNING:
+		return "warning"
+	case api.Annotation_FAILURE:
+		return "error"
+	default:
+		return "note"
+	}
+}
+
+// fileURI converts the absolute path to a file:// URI, taking
+// care of the Windows paths, which don't start with a slash.
+func fileURI(path string) string {
+	slashedPath := filepath.ToSlash(path)
+	if !strings.HasPrefix(slashedPath, "/") {
+		slashedPath = "/" + slashedPath
+	}
+
+	return (&url.URL{Scheme: "file", Path: slashedPath}).String()
+}
+
+func sarifLocationFor(fileLocation *api.Annotation_FileLocation) *sarifLocation {
+	if fileLocation == nil || fileLocation.Path == "" {
+		return nil
+	}
+
+	location := &sarifLocation{
+		PhysicalLocation: sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{
+				URI: filepath.ToSlash(fileLocation.Path),
+			},
+		},
+	}
+
+	if filepath.IsAbs(fileLocation.Path) {
+		// Absolute paths must be absolute URIs
+		location.PhysicalLocation.ArtifactLocation.URI = fileURI(fileLocation.Path)
+	} else {
+		// Relative paths are resolved against the repository root by the code scanning services
+		location.PhysicalLocation.ArtifactLocation.URIBaseID = "%SRCROOT%"
+	}
+
+	// SARIF lines and columns are 1-based, so zero means "unknown"
+	if fileLocation.StartLine > 0 {
+		region := &sarifRegion{
+			StartLine: fileLocation.StartLine,
+		}
+
+		if fileLocation.EndLine >= fileLocation.StartLine {
+			region.EndLine = fileLocation.EndLine
+		}
+		if fileLocation.StartColumn > 0 {
+			region.StartColumn = fileLocation.StartColumn
+		}
+		if fileLocation.EndColumn > 0 {
+			region.EndColumn = fileLocation.EndColumn
+		}
+
+		location.PhysicalLocation.Region = region
+	}
+
+	return location
+}
@@ -0,0 +1,158 @@
+package annotations_test
+
+import (
+	"bytes"
+	"runtime"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/executor/annotations"
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteSARIF(t *testing.T) {
+	collector := annotations.NewCollector()
+	collector.Add("lint", exampleAnnotations()...)
+
+	var buf bytes.Buffer
+	require.NoError(t, annotations.WriteSARIF(&buf, collector.Annotations(), "1.2.3"))
+
+	require.JSONEq(t, `{
+  "$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+  "version": "2.1.0",
+  "runs": [
+    {
+      "tool": {
+        "driver": {
+          "name": "Cirrus CLI",
+          "informationUri": "https://github.com/cirruslabs/cirrus-cli",
+          "version": "1.2.3",
+          "rules": [
+            {"id": "staticcheck.SA1019", "shortDescription": {"text": "staticcheck.SA1019"}},
+            {"id": "test_result", "shortDescription": {"text": "test_result"}},
+            {"id": "generic", "shortDescription": {"text": "generic"}}
+          ]
+        }
+      },
+      "results": [
+        {
+          "ruleId": "staticcheck.SA1019",
+          "ruleIndex": 0,
+          "level": "warning",
+          "message": {"text": "use of os.SEEK_START is deprecated"},
+          "locations": [
+            {
+              "physicalLocation": {
+                "artifactLocation": {"uri": "main.go", "uriBaseId": "%SRCROOT%"},
+                "region": {"startLine": 35, "endLine": 35}
+              }
+            }
+          ],
+          "properties": {"task": "lint"}
+        },
+        {
+          "ruleId": "test_result",
+          "ruleIndex": 1,
+          "level": "error",
+          "message": {"text": "TestMain() failed!\nmain_test.go:18: expected a non-nil return"},
+          "locations": [
+            {
+              "physicalLocation": {
+                "artifactLocation": {"uri": "main_test.go", "uriBaseId": "%SRCROOT%"},
+                "region": {"startLine": 18, "endLine": 20}
+              }
+            }
+          ],
+          "properties": {"task": "lint"}
+        },
+        {
+          "ruleId": "generic",
+          "ruleIndex": 2,
+          "level": "note",
+          "message": {"text": "coverage\nhas decreased"},
+          "properties": {"task": "lint"}
+        }
+      ]
+    }
+  ]
+}`, buf.String())
+}
+
+func TestWriteSARIFEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, annotations.WriteSARIF(&buf, nil, ""))
+
+	require.JSONEq(t, `{
+  "$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+  "version": "2.1.0",
+  "runs": [{"tool": {"driver": {"name": "Cirrus CLI", "informationUri": "https://github.com/cirruslabs/cirrus-cli", "rules": []}}, "results": []}]
+}`, buf.String())
+}
+
+func TestWriteSARIFWithoutTask(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, annotations.WriteSARIF(&buf, []annotations.Annotation{
+		{Annotation: &api.Annotation{Level: api.Annotation_WARNING, Message: "duplicate cache"}},
+	}, ""))
+
+	require.JSONEq(t, `{
+  "$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+  "version": "2.1.0",
+  "runs": [
+    {
+      "tool": {
+        "driver": {
+          "name": "Cirrus CLI",
+          "informationUri": "https://github.com/cirruslabs/cirrus-cli",
+          "rules": [{"id": "generic", "shortDescription": {"text": "generic"}}]
+        }
+      },
+      "results": [
+        {"ruleId": "generic", "ruleIndex": 0, "level": "warning", "message": {"text": "duplicate cache"}}
+      ]
+    }
+  ]
+}`, buf.String())
+}
+
+func TestWriteSARIFAbsolutePath(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("POSIX-specific absolute path")
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, annotations.WriteSARIF(&buf, []annotations.Annotation{
+		{Annotation: &api.Annotation{
+			Level:        api.Annotation_WARNING,
+			Message:      "duplicate cache",
+			FileLocation: &api.Annotation_FileLocation{Path: "/tmp/my project/.cirrus.yml"},
+		}},
+	}, ""))
+
+	require.JSONEq(t, `{
+  "$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+  "version": "2.1.0",
+  "runs": [
+    {
+      "tool": {
+        "driver": {
+          "name": "Cirrus CLI",
+          "informationUri": "https://github.com/cirruslabs/cirrus-cli",
+          "rules": [{"id": "generic", "shortDescription": {"text": "generic"}}]
+        }
+      },
+      "results": [
+        {
+          "ruleId": "generic",
+          "ruleIndex": 0,
+          "level": "warning",
+          "message": {"text": "duplicate cache"},
+          "locations": [
+            {"physicalLocation": {"artifactLocation": {"uri": "file:///tmp/my%20project/.cirrus.yml"}}}
+          ]
+        }
+      ]
+    }
+  ]
+}`, buf.String())
+}
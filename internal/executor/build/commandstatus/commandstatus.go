@@ -8,6 +8,7 @@ const (
 	Undefined Status = iota
 	Success
 	Failure
+	OutOfMemory
 )
 
 func (status Status) String() string {
@@ -18,6 +19,8 @@ func (status Status) String() string {
 		return "succeeded"
 	case Failure:
 		return "failed"
+	case OutOfMemory:
+		return "ran out of memory"
 	default:
 		return fmt.Sprintf("entered unhandled status %d", int(status))
 	}
@@ -34,6 +34,9 @@ type Task struct {
 	Environment map[string]string
 	Commands    []*Command
 
+	// Plaintext of the locally resolved secrets that the agent should mask
+	SecretsToMask []string
+
 	LastHeartbeatReceivedAt atomic.Pointer[time.Time]
 
 	// A mutex to guarantee safe accesses from both the main loop and gRPC server handlers
@@ -136,7 +139,7 @@ func (task *Task) UniqueDescription() string {
 
 func (task *Task) FailedAtLeastOnce() bool {
 	for _, command := range task.Commands {
-		if command.Status() == commandstatus.Failure {
+		if command.Status() == commandstatus.Failure || command.Status() == commandstatus.OutOfMemory {
 			return true
 		}
 	}
@@ -167,6 +170,12 @@ func (task *Task) Status() taskstatus.Status {
 	}
 
 	if failedAtLeastOnce {
+		for _, command := range task.Commands {
+			if command.Status() == commandstatus.OutOfMemory {
+				return taskstatus.OutOfMemory
+			}
+		}
+
 		return taskstatus.Failed
 	}
 
@@ -2,6 +2,8 @@ package build_test
 
 import (
 	"github.com/cirruslabs/cirrus-cli/internal/executor/build"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/build/commandstatus"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/build/taskstatus"
 	"github.com/cirruslabs/cirrus-cli/internal/testutil"
 	"github.com/cirruslabs/cirrus-cli/pkg/api"
 	"github.com/stretchr/testify/assert"
@@ -51,3 +53,27 @@ func TestCloneInterception(t *testing.T) {
 		})
 	}
 }
+
+// TestStatusOutOfMemory ensures that the task killed by the OOM killer is reported as such.
+func TestStatusOutOfMemory(t *testing.T) {
+	task, err := build.NewFromProto(&api.Task{
+		Commands: []*api.Command{
+			{Name: "build"},
+			{Name: "test"},
+			{Name: "cleanup", ExecutionBehaviour: api.Command_ALWAYS},
+		},
+		Instance: testutil.GetBasicContainerInstance(t, "debian:latest"),
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	task.GetCommand("build").SetStatus(commandstatus.Success)
+	task.GetCommand("test").SetStatus(commandstatus.OutOfMemory)
+	assert.Equal(t, taskstatus.New, task.Status())
+	assert.True(t, task.FailedAtLeastOnce())
+
+	task.GetCommand("cleanup").SetStatus(commandstatus.Success)
+	assert.Equal(t, taskstatus.OutOfMemory, task.Status())
+	assert.Equal(t, "ran out of memory", task.Status().String())
+}
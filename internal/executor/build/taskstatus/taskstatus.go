@@ -10,6 +10,7 @@ const (
 	Failed
 	TimedOut
 	Skipped
+	OutOfMemory
 )
 
 func (status Status) String() string {
@@ -24,6 +25,8 @@ func (status Status) String() string {
 		return "timed out"
 	case Skipped:
 		return "skipped"
+	case OutOfMemory:
+		return "ran out of memory"
 	default:
 		return fmt.Sprintf("entered unhandled status %d", int(status))
 	}
@@ -8,6 +8,10 @@ import (
 	"strings"
 )
 
+// MonorepoProjectDir is the variable holding the directory of the monorepo
+// project that the task belongs to, relative to the repository root.
+const MonorepoProjectDir = "CIRRUS_MONOREPO_PROJECT_DIR"
+
 func Merge(opts ...map[string]string) map[string]string {
 	result := make(map[string]string)
 
@@ -0,0 +1,172 @@
+package environment
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+var ErrEvent = errors.New("failed to simulate the event")
+
+// Kinds of the events that can be simulated.
+const (
+	EventPush = "push"
+	EventPR   = "pr"
+	EventCron = "cron"
+	EventTag  = "tag"
+	EventAPI  = "api"
+)
+
+// LastGreenChange is the variable holding the commit that
+// the affected files of the simulated event are computed from.
+const LastGreenChange = "CIRRUS_LAST_GREEN_CHANGE"
+
+const defaultPRNumber = "1"
+
+// Event describes a CI event (e.g. "pr", "pr:42", "cron:nightly", "tag:v1.0.0" or "api").
+type Event struct {
+	Kind string
+
+	// PR number, cron name or tag name, depending on the kind
+	Name string
+}
+
+func ParseEvent(raw string) (*Event, error) {
+	kind, name, _ := strings.Cut(raw, ":")
+
+	event := &Event{Kind: kind, Name: name}
+
+	switch kind {
+	case EventPR:
+		if event.Name == "" {
+			event.Name = defaultPRNumber
+		}
+	case EventCron, EventTag:
+		if event.Name == "" {
+			return nil, fmt.Errorf("%w: %q event requires a name (e.g. %s:NAME)", ErrEvent, kind, kind)
+		}
+	case EventPush, EventAPI:
+		if event.Name != "" {
+			return nil, fmt.Errorf("%w: %q event doesn't take a name", ErrEvent, kind)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown event %q, supported events are %s, %s[:NUMBER], %s:NAME, "+
+			"%s:NAME and %s", ErrEvent, raw, EventPush, EventPR, EventCron, EventTag, EventAPI)
+	}
+
+	return event, nil
+}
+
+// Environment returns the variables that Cirrus CI would set for the event,
+// on top of the ones derived from the project's Git checkout.
+//
+// For the "pr" event, CIRRUS_BASE_SHA and CIRRUS_LAST_GREEN_CHANGE are set to the merge base
+// of the base revision and HEAD. For the other events, CIRRUS_LAST_GREEN_CHANGE is only set
+// when the base revision is specified.
+func (event *Event) Environment(projectDir string, base string) (map[string]string, error) {
+	result := ProjectSpecific(projectDir)
+
+	// Only tag pushes have a tag, even if the checkout is tagged
+	delete(result, "CIRRUS_TAG")
+
+	switch event.Kind {
+	case EventPush:
+		result["CIRRUS_BUILD_SOURCE"] = "github"
+	case EventPR:
+		if base == "" {
+			return nil, fmt.Errorf("%w: %q event requires a base branch", ErrEvent, EventPR)
+		}
+
+		mergeBase, err := MergeBase(projectDir, base)
+		if err != nil {
+			return nil, err
+		}
+
+		result["CIRRUS_BUILD_SOURCE"] = "github"
+		result["CIRRUS_PR"] = event.Name
+		result["CIRRUS_PR_DRAFT"] = "false"
+		result["CIRRUS_BASE_BRANCH"] = base
+		result["CIRRUS_BASE_SHA"] = mergeBase
+		result[LastGreenChange] = mergeBase
+
+		// Cirrus CI refers to the PR builds by their number instead of the head branch
+		if branch, ok := result["CIRRUS_BRANCH"]; ok {
+			result["CIRRUS_HEAD_BRANCH"] = branch
+		}
+		result["CIRRUS_BRANCH"] = "pull/" + event.Name
+	case EventCron:
+		result["CIRRUS_BUILD_SOURCE"] = "cron"
+		result["CIRRUS_CRON"] = event.Name
+	case EventTag:
+		result["CIRRUS_BUILD_SOURCE"] = "github"
+		result["CIRRUS_TAG"] = event.Name
+		delete(result, "CIRRUS_BRANCH")
+	case EventAPI:
+		result["CIRRUS_BUILD_SOURCE"] = "api"
+	}
+
+	if event.Kind != EventPR && base != "" {
+		mergeBase, err := MergeBase(projectDir, base)
+		if err != nil {
+			return nil, err
+		}
+
+		result[LastGreenChange] = mergeBase
+	}
+
+	return result, nil
+}
+
+// MergeBase returns the SHA of the merge base of the base revision and HEAD.
+func MergeBase(projectDir string, base string) (string, error) {
+	repo, err := git.PlainOpen(projectDir)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrEvent, err)
+	}
+
+	baseCommit, err := resolveCommit(repo, base)
+	if err != nil {
+		return "", fmt.Errorf("%w: failed to resolve base %q: %v", ErrEvent, base, err)
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrEvent, err)
+	}
+
+	headCommit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrEvent, err)
+	}
+
+	mergeBases, err := headCommit.MergeBase(baseCommit)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrEvent, err)
+	}
+
+	if len(mergeBases) == 0 {
+		return "", fmt.Errorf("%w: HEAD and %q have no common ancestor", ErrEvent, base)
+	}
+
+	return mergeBases[0].Hash.String(), nil
+}
+
+// resolveCommit resolves the revision, falling back to the
+// "origin" remote branch when there's no such local branch.
+func resolveCommit(repo *git.Repository, revision string) (*object.Commit, error) {
+	hash, err := repo.ResolveRevision(plumbing.Revision(revision))
+	if err != nil {
+		var fallbackErr error
+
+		hash, fallbackErr = repo.ResolveRevision(plumbing.Revision("origin/" + revision))
+		if fallbackErr != nil {
+			return nil, err
+		}
+	}
+
+	return repo.CommitObject(*hash)
+}
@@ -0,0 +1,149 @@
+package environment_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cirruslabs/cirrus-cli/internal/executor/environment"
+	"github.com/cirruslabs/cirrus-cli/internal/testutil"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEventEnvironment ensures that the simulated PR is based on the merge base
+// of the feature branch and the base branch, and not on the base branch's tip.
+func TestEventEnvironment(t *testing.T) {
+	dir := testutil.TempDir(t)
+
+	repo, err := git.PlainInit(dir, false)
+	require.NoError(t, err)
+
+	workTree, err := repo.Worktree()
+	require.NoError(t, err)
+
+	mergeBase := commitFile(t, workTree, dir, "README.md")
+	require.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference("refs/heads/main", mergeBase)))
+
+	require.NoError(t, workTree.Checkout(&git.CheckoutOptions{
+		Branch: "refs/heads/feature",
+		Create: true,
+	}))
+	head := commitFile(t, workTree, dir, "feature.txt")
+
+	// Advance the base branch past the merge base
+	require.NoError(t, workTree.Checkout(&git.CheckoutOptions{Branch: "refs/heads/main"}))
+	_ = commitFile(t, workTree, dir, "main.txt")
+	require.NoError(t, workTree.Checkout(&git.CheckoutOptions{Branch: "refs/heads/feature"}))
+	_, err = repo.CreateTag("v1.0.0", head, nil)
+	require.NoError(t, err)
+
+	event, err := environment.ParseEvent("pr:42")
+	require.NoError(t, err)
+
+	env, err := event.Environment(dir, "main")
+	require.NoError(t, err)
+
+	assert.Equal(t, "42", env["CIRRUS_PR"])
+	assert.Equal(t, "pull/42", env["CIRRUS_BRANCH"])
+	assert.Equal(t, "feature", env["CIRRUS_HEAD_BRANCH"])
+	assert.Equal(t, "main", env["CIRRUS_BASE_BRANCH"])
+	assert.Equal(t, mergeBase.String(), env["CIRRUS_BASE_SHA"])
+	assert.Equal(t, mergeBase.String(), env[environment.LastGreenChange])
+	assert.Equal(t, head.String(), env["CIRRUS_CHANGE_IN_REPO"])
+	assert.NotContains(t, env, "CIRRUS_TAG")
+
+	event, err = environment.ParseEvent("push")
+	require.NoError(t, err)
+
+	env, err = event.Environment(dir, "main")
+	require.NoError(t, err)
+
+	assert.Equal(t, mergeBase.String(), env[environment.LastGreenChange])
+	assert.NotContains(t, env, "CIRRUS_BASE_SHA")
+
+	env, err = event.Environment(dir, "")
+	require.NoError(t, err)
+	assert.NotContains(t, env, environment.LastGreenChange)
+
+	event, err = environment.ParseEvent("tag:v2.0.0")
+	require.NoError(t, err)
+
+	env, err = event.Environment(dir, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "v2.0.0", env["CIRRUS_TAG"])
+	assert.NotContains(t, env, "CIRRUS_BRANCH")
+	assert.NotContains(t, env, "CIRRUS_BASE_SHA")
+	assert.NotContains(t, env, environment.LastGreenChange)
+
+	event, err = environment.ParseEvent("cron:nightly")
+	require.NoError(t, err)
+
+	env, err = event.Environment(dir, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "nightly", env["CIRRUS_CRON"])
+	assert.Equal(t, "cron", env["CIRRUS_BUILD_SOURCE"])
+	assert.Equal(t, "feature", env["CIRRUS_BRANCH"])
+
+	// Only the "pr" event exposes the base, but every event
+	// with a base gets the merge base as the last green change
+	for _, rawEvent := range []string{"cron:nightly", "tag:v2.0.0", "api"} {
+		event, err := environment.ParseEvent(rawEvent)
+		require.NoError(t, err)
+
+		env, err := event.Environment(dir, "main")
+		require.NoError(t, err)
+		assert.NotContains(t, env, "CIRRUS_BASE_SHA", rawEvent)
+		assert.Equal(t, mergeBase.String(), env[environment.LastGreenChange], rawEvent)
+	}
+
+	mergeBaseSHA, err := environment.MergeBase(dir, "main")
+	require.NoError(t, err)
+	assert.Equal(t, mergeBase.String(), mergeBaseSHA)
+
+	_, err = environment.MergeBase(dir, "nonexistent")
+	require.ErrorIs(t, err, environment.ErrEvent)
+}
+
+func TestParseEvent(t *testing.T) {
+	event, err := environment.ParseEvent("pr")
+	require.NoError(t, err)
+	assert.Equal(t, &environment.Event{Kind: environment.EventPR, Name: "1"}, event)
+
+	for _, invalid := range []string{"", "release", "cron", "tag:", "api:something"} {
+		_, err := environment.ParseEvent(invalid)
+		assert.ErrorIs(t, err, environment.ErrEvent, invalid)
+	}
+}
+
+func TestPREventRequiresBase(t *testing.T) {
+	event, err := environment.ParseEvent("pr")
+	require.NoError(t, err)
+
+	_, err = event.Environment(testutil.TempDir(t), "")
+	require.ErrorIs(t, err, environment.ErrEvent)
+}
+
+func commitFile(t *testing.T, workTree *git.Worktree, dir string, name string) plumbing.Hash {
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(name+"\n"), 0600))
+
+	_, err := workTree.Add(name)
+	require.NoError(t, err)
+
+	hash, err := workTree.Commit("Add "+name, &git.CommitOptions{
+		Author: &object.Signature{
+			Name:  "Charlie Root",
+			Email: "root@localhost",
+			When:  time.Now(),
+		},
+	})
+	require.NoError(t, err)
+
+	return hash
+}
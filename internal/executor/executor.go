@@ -5,24 +5,34 @@ import (
 	"errors"
 	"fmt"
 	"github.com/cirruslabs/chacha/pkg/localnetworkhelper"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/annotations"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/build"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/build/taskstatus"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/endpoint"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/environment"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/instance"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/abstract"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/container"
+	isolationcontainer "github.com/cirruslabs/cirrus-cli/internal/executor/instance/persistentworker/isolation/container"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/persistentworker/isolation/none"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/persistentworker/isolation/vetu"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/runconfig"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/options"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/pathsafe"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/rpc"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/secrets"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/taskfilter"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/terminalserver"
 	"github.com/cirruslabs/cirrus-cli/pkg/api"
 	"github.com/cirruslabs/echelon"
 	"github.com/cirruslabs/echelon/renderers"
 	"io"
+	"os"
+	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -50,8 +60,16 @@ type Executor struct {
 	vetuOptions              options.VetuOptions
 	artifactsDir             string
 	localNetworkHelper       *localnetworkhelper.LocalNetworkHelper
+	terminalServer           *terminalserver.Server
+	terminalBehavior         api.Command_CommandExecutionBehavior
+	secretsProvider          secrets.Provider
+	annotations              *annotations.Collector
 }
 
+// terminalExpirationWindow is how long the agent waits for the terminal
+// to be inactive, a short one suffices since we attach automatically.
+const terminalExpirationWindow = 15 * time.Second
+
 func New(projectDir string, tasks []*api.Task, opts ...Option) (*Executor, error) {
 	e := &Executor{
 		taskFilter: taskfilter.MatchAnyTask(),
@@ -96,6 +114,20 @@ func New(projectDir string, tasks []*api.Task, opts ...Option) (*Executor, error
 			// Highest priority: common to all tasks
 			e.userSpecifiedEnvironment,
 		)
+
+		if e.terminalServer != nil {
+			if _, ok := task.Environment["CIRRUS_TERMINAL_EXPIRATION_WINDOW"]; !ok {
+				task.Environment["CIRRUS_TERMINAL_EXPIRATION_WINDOW"] =
+					strconv.Itoa(int(terminalExpirationWindow.Seconds()))
+			}
+			task.Commands = append(task.Commands, &api.Command{
+				Name: "wait_for_terminal",
+				Instruction: &api.Command_WaitForTerminalInstruction{
+					WaitForTerminalInstruction: &api.WaitForTerminalInstruction{},
+				},
+				ExecutionBehaviour: e.terminalBehavior,
+			})
+		}
 	}
 
 	// Create a build that describes what we're about to do
@@ -126,9 +158,32 @@ func New(projectDir string, tasks []*api.Task, opts ...Option) (*Executor, error
 		}
 
 		// If not set by the user, set task's working directory based on it's instance
+		workingDir := task.Instance.WorkingDirectory(projectDir, e.dirtyMode)
+
+		// Tasks of the monorepo projects run from their project's directory
+		if monorepoProjectDir := task.Environment[environment.MonorepoProjectDir]; monorepoProjectDir != "" &&
+			workingDir != "" {
+			workingDir = joinWorkingDir(workingDir, monorepoProjectDir)
+		}
+
 		task.Environment = environment.Merge(map[string]string{
-			"CIRRUS_WORKING_DIR": task.Instance.WorkingDirectory(projectDir, e.dirtyMode),
+			"CIRRUS_WORKING_DIR": workingDir,
 		}, task.Environment)
+
+		// Decrypt the ENCRYPTED[...] values using the local secrets
+		if e.secretsProvider != nil {
+			sensitiveValues, unresolved, err := secrets.Resolve(e.secretsProvider, task.Environment)
+			if err != nil {
+				return nil, err
+			}
+			task.SecretsToMask = sensitiveValues
+
+			sort.Strings(unresolved)
+			for _, name := range unresolved {
+				e.logger.Warnf("no local secret found for the encrypted variable %s of %s",
+					name, task.UniqueDescription())
+			}
+		}
 	}
 
 	return e, nil
@@ -137,6 +192,25 @@ func New(projectDir string, tasks []*api.Task, opts ...Option) (*Executor, error
 func (e *Executor) Run(ctx context.Context) error {
 	var firstErr error
 
+	// Artifacts need to be stored somewhere to be passed to the dependent tasks,
+	// so use a temporary directory if the user didn't ask to keep them
+	if e.artifactsDir == "" && e.hasDependencies() {
+		artifactsDir, err := os.MkdirTemp("", "cirrus-artifacts-")
+		if err != nil {
+			return err
+		}
+
+		e.artifactsDir = artifactsDir
+
+		defer func() {
+			if err := os.RemoveAll(artifactsDir); err != nil {
+				e.logger.Warnf("failed to remove temporary artifacts directory %s: %v", artifactsDir, err)
+			}
+
+			e.artifactsDir = ""
+		}()
+	}
+
 	for {
 		// Pick next undone task to run
 		task := e.build.GetNextTask()
@@ -162,11 +236,19 @@ func (e *Executor) Run(ctx context.Context) error {
 func (e *Executor) runSingleTask(ctx context.Context, task *build.Task) (err error) {
 	rpcOpts := []rpc.Option{rpc.WithLogger(e.logger)}
 
-	if e.artifactsDir != "" && pathsafe.IsPathSafe(task.Name) {
-		taskSpecificArtifactsDir := filepath.Join(e.artifactsDir, task.Name)
+	if escapedName, ok := pathsafe.Escape(task.Name); e.artifactsDir != "" && ok {
+		taskSpecificArtifactsDir := filepath.Join(e.artifactsDir, escapedName)
 		rpcOpts = append(rpcOpts, rpc.WithArtifactsDir(taskSpecificArtifactsDir))
 	}
 
+	if e.terminalServer != nil {
+		rpcOpts = append(rpcOpts, rpc.WithTerminalServer(e.terminalServer))
+	}
+
+	if e.annotations != nil {
+		rpcOpts = append(rpcOpts, rpc.WithAnnotations(e.annotations))
+	}
+
 	// Provide more information for RPC address heuristics
 	// when running Virtual Machines on Linux
 	_, virtualMachine := task.Instance.(*vetu.Vetu)
@@ -197,6 +279,19 @@ func (e *Executor) runSingleTask(ctx context.Context, task *build.Task) (err err
 
 	instanceRunOpts.SetLogger(taskLogger)
 
+	// Pass the artifacts of the tasks this task depends on
+	upstreamArtifacts, err := e.upstreamArtifacts(task)
+	if err != nil {
+		return err
+	}
+	if len(upstreamArtifacts) > 0 {
+		if supportsUpstreamArtifacts(task.Instance) {
+			instanceRunOpts.UpstreamArtifacts = upstreamArtifacts
+		} else {
+			taskLogger.Warnf("not passing the artifacts of the upstream tasks: unsupported instance type")
+		}
+	}
+
 	// Respect custom agent version
 	if agentVersionFromEnv, ok := task.Environment["CIRRUS_CLI_VERSION"]; ok {
 		instanceRunOpts.SetCLIVersion(agentVersionFromEnv)
@@ -287,6 +382,71 @@ func (e *Executor) runSingleTask(ctx context.Context, task *build.Task) (err err
 	return err
 }
 
+func (e *Executor) hasDependencies() bool {
+	for _, task := range e.build.Tasks() {
+		if len(task.RequiredIDs) != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// upstreamArtifacts returns the artifact directories of all the tasks the specified
+// task depends on, either directly or transitively, keyed by their escaped names.
+func (e *Executor) upstreamArtifacts(task *build.Task) (map[string]string, error) {
+	artifactsDir, err := filepath.Abs(e.artifactsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+	visited := map[int64]struct{}{}
+	queue := slices.Clone(task.RequiredIDs)
+
+	for len(queue) != 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		if _, ok := visited[id]; ok {
+			continue
+		}
+		visited[id] = struct{}{}
+
+		// Dependency might've been filtered out
+		upstreamTask := e.build.GetTask(id)
+		if upstreamTask == nil {
+			continue
+		}
+
+		queue = append(queue, upstreamTask.RequiredIDs...)
+
+		escapedName, ok := pathsafe.Escape(upstreamTask.Name)
+		if !ok {
+			continue
+		}
+
+		// Upstream task might've produced no artifacts
+		upstreamArtifactsDir := filepath.Join(artifactsDir, escapedName)
+		if _, err := os.Stat(upstreamArtifactsDir); err != nil {
+			continue
+		}
+
+		result[escapedName] = upstreamArtifactsDir
+	}
+
+	return result, nil
+}
+
+func supportsUpstreamArtifacts(inst abstract.Instance) bool {
+	switch inst.(type) {
+	case *container.Instance, *instance.PipeInstance, *isolationcontainer.Container, *none.PersistentWorkerInstance:
+		return true
+	default:
+		return false
+	}
+}
+
 func (e *Executor) transformDockerfileImageIfNeeded(reference string, strict bool) (string, error) {
 	// Modify image name if the user provided a custom template
 	if e.containerOptions.DockerfileImageTemplate == "" {
@@ -309,3 +469,14 @@ func (e *Executor) transformDockerfileImageIfNeeded(reference string, strict boo
 	// Render the template
 	return strings.ReplaceAll(e.containerOptions.DockerfileImageTemplate, "%s", hash), nil
 }
+
+// joinWorkingDir appends the slash-separated directory to the working directory, which is
+// a host path for the persistent workers, but a Unix path for the Linux containers even when
+// running on Windows.
+func joinWorkingDir(workingDir string, dir string) string {
+	if strings.HasPrefix(workingDir, "/") {
+		return path.Join(workingDir, dir)
+	}
+
+	return filepath.Join(workingDir, filepath.FromSlash(dir))
+}
@@ -5,7 +5,11 @@ import (
 	"github.com/cirruslabs/cirrus-cli/internal/executor/options"
 	"github.com/cirruslabs/cirrus-cli/pkg/api"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/anypb"
+	"os"
+	"path/filepath"
+	"runtime"
 	"testing"
 )
 
@@ -37,3 +41,83 @@ func TestDockerfileImageTemplate(t *testing.T) {
 	assert.Equal(t, "gcr.io/cirrus-ci-community/d41d8cd98f00b204e9800998ecf8427e:latest",
 		e.build.GetTask(0).Instance.(*instance.PrebuiltInstance).Image)
 }
+
+func TestUpstreamArtifacts(t *testing.T) {
+	anyInstance, err := anypb.New(&api.ContainerInstance{
+		Image: "debian:latest",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tasks := []*api.Task{
+		{LocalGroupId: 0, Name: "build", Instance: anyInstance},
+		{LocalGroupId: 1, Name: "test", Instance: anyInstance, RequiredGroups: []int64{0}},
+		{LocalGroupId: 2, Name: "lint", Instance: anyInstance},
+		{LocalGroupId: 3, Name: "release", Instance: anyInstance, RequiredGroups: []int64{1, 2}},
+	}
+
+	artifactsDir := t.TempDir()
+
+	e, err := New(".", tasks, WithArtifactsDir(artifactsDir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// "lint" task has produced no artifacts
+	require.NoError(t, os.Mkdir(filepath.Join(artifactsDir, "build"), 0700))
+	require.NoError(t, os.Mkdir(filepath.Join(artifactsDir, "test"), 0700))
+
+	upstreamArtifacts, err := e.upstreamArtifacts(e.build.GetTask(3))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"build": filepath.Join(artifactsDir, "build"),
+		"test":  filepath.Join(artifactsDir, "test"),
+	}, upstreamArtifacts)
+
+	upstreamArtifacts, err = e.upstreamArtifacts(e.build.GetTask(0))
+	require.NoError(t, err)
+	assert.Empty(t, upstreamArtifacts)
+}
+
+func TestUpstreamArtifactsMonorepo(t *testing.T) {
+	anyInstance, err := anypb.New(&api.ContainerInstance{
+		Image: "debian:latest",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Tasks of the monorepo projects are prefixed with their project's directory
+	tasks := []*api.Task{
+		{LocalGroupId: 0, Name: "lint", Instance: anyInstance},
+		{LocalGroupId: 1, Name: "services/api/build", Instance: anyInstance},
+		{LocalGroupId: 2, Name: "services/web/deploy", Instance: anyInstance, RequiredGroups: []int64{0, 1}},
+	}
+
+	artifactsDir := t.TempDir()
+
+	e, err := New(".", tasks, WithArtifactsDir(artifactsDir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.NoError(t, os.Mkdir(filepath.Join(artifactsDir, "lint"), 0700))
+	require.NoError(t, os.Mkdir(filepath.Join(artifactsDir, "services%2Fapi%2Fbuild"), 0700))
+
+	upstreamArtifacts, err := e.upstreamArtifacts(e.build.GetTask(2))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"lint":                   filepath.Join(artifactsDir, "lint"),
+		"services%2Fapi%2Fbuild": filepath.Join(artifactsDir, "services%2Fapi%2Fbuild"),
+	}, upstreamArtifacts)
+}
+
+func TestJoinWorkingDir(t *testing.T) {
+	assert.Equal(t, "/tmp/cirrus-ci-build/services/api", joinWorkingDir("/tmp/cirrus-ci-build", "services/api"))
+
+	if runtime.GOOS == "windows" {
+		assert.Equal(t, `C:\Windows\Temp\cirrus-build\services\api`,
+			joinWorkingDir(`C:\Windows\Temp\cirrus-build`, "services/api"))
+	}
+}
@@ -33,3 +33,11 @@ type WarmableInstance interface {
 		logger *echelon.Logger,
 	) error
 }
+
+// HostDirectoryInstance is implemented by the instances that can run
+// the tasks in a working directory located on the host's file system.
+type HostDirectoryInstance interface {
+	// UseHostWorkingDirectory makes the instance run the task in a working
+	// directory located on the host and returns the path to that directory.
+	UseHostWorkingDirectory() string
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"math"
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -153,6 +155,20 @@ func RunContainerizedAgent(ctx context.Context, config *runconfig.RunConfig, par
 		})
 	}
 
+	// Expose the artifacts of the tasks this task depends on
+	if len(config.UpstreamArtifacts) > 0 {
+		for _, taskName := range slices.Sorted(maps.Keys(config.UpstreamArtifacts)) {
+			input.Mounts = append(input.Mounts, containerbackend.ContainerMount{
+				Type:     containerbackend.MountTypeBind,
+				Source:   config.UpstreamArtifacts[taskName],
+				Target:   params.Platform.UpstreamArtifactsDir(taskName),
+				ReadOnly: true,
+			})
+		}
+
+		input.Env[runconfig.UpstreamArtifactsDirEnvironmentVariable] = params.Platform.UpstreamArtifactsDir()
+	}
+
 	// In case the additional containers are used, tell the agent to wait for them
 	if len(params.AdditionalContainers) > 0 {
 		var ports []string
@@ -97,7 +97,7 @@ func NewFromProto(
 			Arguments:  instance.Arguments,
 		}, nil
 	case *api.PersistentWorkerInstance:
-		return persistentworker.New(instance.Isolation, security.NoSecurityAllowAllVolumes(), nil, nil, logger)
+		return persistentworker.New(instance.Isolation, security.NoSecurityAllowAllVolumes(), nil, nil, nil, logger)
 	case *api.DockerBuilder:
 		// Ensures that we're not trying to run e.g. Windows-specific scripts on macOS
 		instanceOS := strings.ToLower(instance.Platform.String())
@@ -113,7 +113,7 @@ func NewFromProto(
 			Type: &api.Isolation_None_{
 				None: &api.Isolation_None{},
 			},
-		}, security.NoSecurity(), nil, nil, logger)
+		}, security.NoSecurity(), nil, nil, nil, logger)
 	case *api.MacOSInstance:
 		return tart.New(instance.Image, instance.User, instance.Password, 22,
 			instance.Cpu, instance.Memory, tart.WithLogger(logger))
@@ -8,13 +8,16 @@ import (
 	"github.com/cirruslabs/cirrus-cli/internal/executor/platform"
 	"github.com/cirruslabs/cirrus-cli/pkg/api"
 	"go.opentelemetry.io/otel/attribute"
-	"os"
 )
 
 type Container struct {
 	instance *container.Instance
 	tempDir  string
 	cleanup  func() error
+
+	// Whether to bind-mount the working directory from the host
+	// instead of copying it into a working volume
+	bindTempDir bool
 }
 
 func New(image string, cpu float32, memory uint32, volumes []*api.Volume) (*Container, error) {
@@ -34,7 +37,7 @@ func New(image string, cpu float32, memory uint32, volumes []*api.Volume) (*Cont
 		},
 		tempDir: tempDir,
 		cleanup: func() error {
-			return os.RemoveAll(tempDir)
+			return pwdir.Remove(tempDir)
 		},
 	}, nil
 }
@@ -50,6 +53,7 @@ func (cont *Container) Attributes() []attribute.KeyValue {
 func (cont *Container) Run(ctx context.Context, config *runconfig.RunConfig) (err error) {
 	if config.ProjectDir == "" {
 		config.ProjectDir = cont.tempDir
+		config.DirtyMode = cont.bindTempDir
 	}
 
 	return cont.instance.Run(ctx, config)
@@ -59,6 +63,12 @@ func (cont *Container) WorkingDirectory(projectDir string, dirtyMode bool) strin
 	return cont.instance.WorkingDirectory(projectDir, dirtyMode)
 }
 
+func (cont *Container) UseHostWorkingDirectory() string {
+	cont.bindTempDir = true
+
+	return cont.tempDir
+}
+
 func (cont *Container) Close(ctx context.Context) error {
 	if err := cont.instance.Close(ctx); err != nil {
 		return err
@@ -11,8 +11,10 @@ import (
 	"github.com/cirruslabs/cirrus-cli/pkg/privdrop"
 	"github.com/otiai10/copy"
 	"go.opentelemetry.io/otel/attribute"
+	"io/fs"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"runtime"
 	"time"
 )
@@ -37,7 +39,7 @@ func New(opts ...Option) (*PersistentWorkerInstance, error) {
 	pwi := &PersistentWorkerInstance{
 		tempDir: tempDir,
 		cleanup: func() error {
-			return os.RemoveAll(tempDir)
+			return pwdir.Remove(tempDir)
 		},
 	}
 
@@ -101,6 +103,38 @@ func (pwi *PersistentWorkerInstance) Run(ctx context.Context, config *runconfig.
 		cmd.Dir = pwi.tempDir
 	}
 
+	// Expose the artifacts of the tasks this task depends on
+	if len(config.UpstreamArtifacts) > 0 {
+		upstreamArtifactsDir, err := os.MkdirTemp("", "cirrus-upstream-artifacts-")
+		if err != nil {
+			return err
+		}
+		defer func() {
+			// Restore the write permissions, otherwise the directory contents can't be removed
+			_ = chmodAll(upstreamArtifactsDir, func(mode fs.FileMode) fs.FileMode {
+				return mode | 0200
+			})
+			_ = os.RemoveAll(upstreamArtifactsDir)
+		}()
+
+		for taskName, artifactsDir := range config.UpstreamArtifacts {
+			taskArtifactsDir := filepath.Join(upstreamArtifactsDir, taskName)
+
+			if err := copy.Copy(artifactsDir, taskArtifactsDir); err != nil {
+				return fmt.Errorf("%w: while copying %s's artifacts into %s: %v",
+					ErrPopulateFailed, taskName, taskArtifactsDir, err)
+			}
+		}
+
+		// Prevent the task from modifying the artifacts of the upstream tasks
+		if err := makeReadOnly(upstreamArtifactsDir, privdrop.ChownTo); err != nil {
+			return fmt.Errorf("%w: while making %s read-only: %v", ErrPopulateFailed, upstreamArtifactsDir, err)
+		}
+
+		cmd.Env = append(os.Environ(), fmt.Sprintf("%s=%s",
+			runconfig.UpstreamArtifactsDirEnvironmentVariable, upstreamArtifactsDir))
+	}
+
 	// Run the agent
 	if err := cmd.Start(); err != nil {
 		return err
@@ -151,6 +185,59 @@ func (pwi *PersistentWorkerInstance) WorkingDirectory(projectDir string, dirtyMo
 	return pwi.tempDir
 }
 
+func (pwi *PersistentWorkerInstance) UseHostWorkingDirectory() string {
+	return pwi.tempDir
+}
+
 func (pwi *PersistentWorkerInstance) Close(context.Context) error {
 	return pwi.cleanup()
 }
+
+// makeReadOnly removes the write permissions from the directory and its contents.
+//
+// When privilege dropping was requested, the contents are additionally handed over
+// to the privilege-dropped user's group with the same read and execute permissions
+// as their owner has. The ownership itself is retained, otherwise the privilege-dropped
+// user would be able to restore the write permissions.
+func makeReadOnly(dir string, chownTo *privdrop.Chown) error {
+	if chownTo != nil {
+		if err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			return os.Lchown(path, -1, chownTo.GID)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return chmodAll(dir, func(mode fs.FileMode) fs.FileMode {
+		if chownTo != nil {
+			mode |= (mode & 0500) >> 3
+		}
+
+		return mode &^ 0222
+	})
+}
+
+// chmodAll changes the permissions of the directory and its contents using the modify function.
+func chmodAll(dir string, modify func(mode fs.FileMode) fs.FileMode) error {
+	return filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// Symbolic links have no permissions of their own
+		if entry.Type()&fs.ModeSymlink != 0 {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		return os.Chmod(path, modify(info.Mode().Perm()))
+	})
+}
@@ -0,0 +1,44 @@
+//nolint:testpackage // we need to test the chmodAll(), which is private
+package none
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChmodAllReadOnly(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Windows has no Unix permissions")
+	}
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "build", "bin"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "build", "bin", "app"), []byte("app"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "build", "report.txt"), []byte("report"), 0644))
+
+	require.NoError(t, chmodAll(dir, func(mode fs.FileMode) fs.FileMode {
+		return mode &^ 0222
+	}))
+
+	for path, expectedMode := range map[string]fs.FileMode{
+		dir:                                0555,
+		filepath.Join(dir, "build", "bin"): 0555,
+		filepath.Join(dir, "build", "bin", "app"): 0555,
+		filepath.Join(dir, "build", "report.txt"): 0444,
+	} {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		require.Equal(t, expectedMode, info.Mode().Perm(), path)
+	}
+
+	// Restoring the write permissions allows removing the directory
+	require.NoError(t, chmodAll(dir, func(mode fs.FileMode) fs.FileMode {
+		return mode | 0200
+	}))
+	require.NoError(t, os.RemoveAll(dir))
+}
@@ -0,0 +1,54 @@
+//nolint:testpackage // we need to test the makeReadOnly(), which is private
+package none
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/pkg/privdrop"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeReadOnlyPrivilegeDropped(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("changing the group ownership and running as a different user requires root")
+	}
+
+	nobody := &privdrop.Chown{UID: 65534, GID: 65534}
+
+	// Lay out the artifacts the same way the artifact storage does
+	dir, err := os.MkdirTemp("", "cirrus-upstream-artifacts-")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+	require.NoError(t, os.Chmod(dir, 0700))
+
+	nestedDir := filepath.Join(dir, "build", "binaries", "linux")
+	require.NoError(t, os.MkdirAll(nestedDir, 0700))
+	nestedFile := filepath.Join(nestedDir, "app")
+	require.NoError(t, os.WriteFile(nestedFile, []byte("app"), 0600))
+
+	require.NoError(t, makeReadOnly(dir, nobody))
+
+	runAsNobody := func(name string, args ...string) error {
+		cmd := exec.Command(name, args...)
+		cmd.SysProcAttr = &syscall.SysProcAttr{
+			Credential: &syscall.Credential{Uid: uint32(nobody.UID), Gid: uint32(nobody.GID)},
+		}
+
+		return cmd.Run()
+	}
+
+	// The privilege-dropped user can read the nested artifacts...
+	require.NoError(t, runAsNobody("cat", nestedFile))
+	require.NoError(t, runAsNobody("ls", nestedDir))
+
+	// ...but can neither modify them, nor restore the write permissions
+	require.Error(t, runAsNobody("sh", "-c", "echo modified > "+nestedFile))
+	require.Error(t, runAsNobody("touch", filepath.Join(nestedDir, "new")))
+	require.Error(t, runAsNobody("chmod", "u+w", nestedFile))
+}
@@ -0,0 +1,11 @@
+package ssh
+
+import "github.com/cirruslabs/cirrus-cli/internal/logger"
+
+type Option func(*SSH)
+
+func WithLogger(logger logger.Lightweight) Option {
+	return func(ssh *SSH) {
+		ssh.logger = logger
+	}
+}
@@ -0,0 +1,173 @@
+package ssh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"path"
+	"sync"
+
+	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/persistentworker/projectdirsyncer"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/persistentworker/remoteagent"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/runconfig"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/platform"
+	"github.com/cirruslabs/cirrus-cli/internal/logger"
+	"github.com/cirruslabs/cirrus-cli/internal/worker/sshinventory"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	cryptossh "golang.org/x/crypto/ssh"
+)
+
+var (
+	ErrFailed     = errors.New("SSH isolation failed")
+	ErrSyncFailed = errors.New("failed to sync project directory")
+)
+
+// SSH runs tasks on a pre-existing host leased from the Persistent Worker's SSH inventory.
+type SSH struct {
+	logger     logger.Lightweight
+	request    *sshinventory.Request
+	workingDir string
+
+	// Set once the host is leased in Run()
+	lease    *sshinventory.Lease
+	leaseMtx sync.Mutex
+}
+
+func New(request *sshinventory.Request, opts ...Option) (*SSH, error) {
+	ssh := &SSH{
+		request: request,
+		// Use a unique working directory since multiple
+		// tasks might be running on the same host
+		workingDir: path.Join(platform.NewUnix().CirrusDir(), "build-"+uuid.NewString()),
+	}
+
+	// Apply options
+	for _, opt := range opts {
+		opt(ssh)
+	}
+
+	// Apply default options (to cover those that weren't specified)
+	if ssh.logger == nil {
+		ssh.logger = &logger.LightweightStub{}
+	}
+
+	return ssh, nil
+}
+
+func (ssh *SSH) Attributes() []attribute.KeyValue {
+	attributes := []attribute.KeyValue{
+		attribute.String("instance_type", "ssh"),
+	}
+
+	ssh.leaseMtx.Lock()
+	defer ssh.leaseMtx.Unlock()
+
+	if ssh.lease != nil {
+		attributes = append(attributes, attribute.String("host", ssh.lease.Host.Name))
+	}
+
+	return attributes
+}
+
+func (ssh *SSH) Run(ctx context.Context, config *runconfig.RunConfig) error {
+	ssh.logger.Debugf("waiting for a free SSH host...")
+
+	lease, err := ssh.request.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: failed to lease an SSH host: %v", ErrFailed, err)
+	}
+
+	ssh.leaseMtx.Lock()
+	ssh.lease = lease
+	ssh.leaseMtx.Unlock()
+
+	host := lease.Host
+
+	ssh.logger.Debugf("running agent on SSH host %s (%s)...", host.Name, host.Addr())
+
+	agentEnv := map[string]string{
+		"CIRRUS_SSH_HOST": host.Name,
+	}
+
+	maps.Copy(agentEnv, config.AdditionalEnvironment)
+
+	return remoteagent.WaitForAgentWithClientConfig(ctx, ssh.logger, host.Addr(), host.ClientConfig(),
+		host.GetOS(), host.GetArchitecture(), config, false, ssh.initializeHooks(config),
+		ssh.terminateHooks(host), ssh.workingDir, agentEnv, config.LocalNetworkHelper)
+}
+
+func (ssh *SSH) WorkingDirectory(projectDir string, dirtyMode bool) string {
+	return ssh.workingDir
+}
+
+func (ssh *SSH) Close(context.Context) error {
+	ssh.leaseMtx.Lock()
+	defer ssh.leaseMtx.Unlock()
+
+	if ssh.lease != nil {
+		ssh.lease.Release()
+	}
+
+	return nil
+}
+
+func (ssh *SSH) initializeHooks(config *runconfig.RunConfig) []remoteagent.WaitForAgentHook {
+	hooks := []remoteagent.WaitForAgentHook{
+		func(ctx context.Context, sshClient *cryptossh.Client) error {
+			if err := runCommand(sshClient, fmt.Sprintf("mkdir -p %q", ssh.workingDir)); err != nil {
+				return fmt.Errorf("%w: failed to create working directory: %v", ErrFailed, err)
+			}
+
+			return nil
+		},
+	}
+
+	if config.ProjectDir != "" {
+		hooks = append(hooks, func(ctx context.Context, sshClient *cryptossh.Client) error {
+			syncLogger := config.Logger().Scoped("syncing working directory")
+
+			if err := projectdirsyncer.SyncProjectDirTo(config.ProjectDir, ssh.workingDir, sshClient); err != nil {
+				syncLogger.Finish(false)
+
+				return fmt.Errorf("%w: %v", ErrSyncFailed, err)
+			}
+
+			syncLogger.Finish(true)
+
+			return nil
+		})
+	}
+
+	return hooks
+}
+
+func (ssh *SSH) terminateHooks(host *sshinventory.Host) []remoteagent.WaitForAgentHook {
+	return []remoteagent.WaitForAgentHook{
+		func(ctx context.Context, sshClient *cryptossh.Client) error {
+			// Unlike the VMs, the host is not discarded after the task, so clean up after ourselves
+			if err := runCommand(sshClient, fmt.Sprintf("rm -rf %q", ssh.workingDir)); err != nil {
+				ssh.logger.Debugf("failed to remove working directory %s on SSH host %s: %v",
+					ssh.workingDir, host.Name, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func runCommand(sshClient *cryptossh.Client, command string) error {
+	sess, err := sshClient.NewSession()
+	if err != nil {
+		return err
+	}
+	defer sess.Close()
+
+	output, err := sess.CombinedOutput(command)
+	if err != nil {
+		return fmt.Errorf("%w, output: %s", err, string(output))
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+package ssh_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cirruslabs/cirrus-cli/internal/executor/endpoint"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/persistentworker/isolation/ssh"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/runconfig"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/platform"
+	"github.com/cirruslabs/cirrus-cli/internal/worker/sshinventory"
+	"github.com/pkg/sftp"
+	"github.com/stretchr/testify/require"
+	cryptossh "golang.org/x/crypto/ssh"
+)
+
+var errUnauthorized = errors.New("unauthorized")
+
+// startSSHServer starts an in-process SSH server that executes commands locally
+// and supports the SFTP subsystem, which is enough to run the agent.
+func startSSHServer(t *testing.T, authorizedKey cryptossh.PublicKey) (string, cryptossh.PublicKey) {
+	_, hostPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	hostSigner, err := cryptossh.NewSignerFromKey(hostPrivateKey)
+	require.NoError(t, err)
+
+	serverConfig := &cryptossh.ServerConfig{
+		PublicKeyCallback: func(_ cryptossh.ConnMetadata, key cryptossh.PublicKey) (*cryptossh.Permissions, error) {
+			if !bytes.Equal(key.Marshal(), authorizedKey.Marshal()) {
+				return nil, errUnauthorized
+			}
+
+			return nil, nil
+		},
+	}
+	serverConfig.AddHostKey(hostSigner)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	go func() {
+		for {
+			netConn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go serveSSHConn(netConn, serverConfig)
+		}
+	}()
+
+	return listener.Addr().String(), hostSigner.PublicKey()
+}
+
+func serveSSHConn(netConn net.Conn, serverConfig *cryptossh.ServerConfig) {
+	_, chans, reqs, err := cryptossh.NewServerConn(netConn, serverConfig)
+	if err != nil {
+		return
+	}
+
+	go cryptossh.DiscardRequests(reqs)
+
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			_ = newChannel.Reject(cryptossh.UnknownChannelType, "unsupported channel type")
+
+			continue
+		}
+
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			continue
+		}
+
+		go serveSSHSession(channel, requests)
+	}
+}
+
+func serveSSHSession(channel cryptossh.Channel, requests <-chan *cryptossh.Request) {
+	defer channel.Close()
+
+	for req := range requests {
+		switch req.Type {
+		case "exec":
+			var payload struct{ Command string }
+			if err := cryptossh.Unmarshal(req.Payload, &payload); err != nil {
+				_ = req.Reply(false, nil)
+
+				continue
+			}
+			_ = req.Reply(true, nil)
+
+			runShell(channel, exec.Command("sh", "-c", payload.Command))
+
+			return
+		case "shell":
+			_ = req.Reply(true, nil)
+
+			cmd := exec.Command("sh")
+
+			// Don't use cmd.Stdin directly since cmd.Wait()
+			// would block until the client closes the channel
+			stdin, err := cmd.StdinPipe()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				_, _ = io.Copy(stdin, channel)
+				_ = stdin.Close()
+			}()
+
+			runShell(channel, cmd)
+
+			return
+		case "subsystem":
+			var payload struct{ Name string }
+			if err := cryptossh.Unmarshal(req.Payload, &payload); err != nil || payload.Name != "sftp" {
+				_ = req.Reply(false, nil)
+
+				continue
+			}
+			_ = req.Reply(true, nil)
+
+			server, err := sftp.NewServer(channel)
+			if err != nil {
+				return
+			}
+			_ = server.Serve()
+
+			return
+		default:
+			_ = req.Reply(req.Type == "env", nil)
+		}
+	}
+}
+
+func runShell(channel cryptossh.Channel, cmd *exec.Cmd) {
+	cmd.Stdout = channel
+	cmd.Stderr = channel.Stderr()
+
+	var exitStatus uint32
+
+	if err := cmd.Run(); err != nil {
+		exitStatus = 1
+
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			exitStatus = uint32(exitErr.ExitCode())
+		}
+	}
+
+	_, _ = channel.SendRequest("exit-status", false, cryptossh.Marshal(struct{ Status uint32 }{exitStatus}))
+}
+
+func TestSSH(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("this test relies on the XDG cache directory and POSIX shell")
+	}
+
+	// Generate a client key
+	_, clientPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	clientSigner, err := cryptossh.NewSignerFromKey(clientPrivateKey)
+	require.NoError(t, err)
+
+	pemBlock, err := cryptossh.MarshalPrivateKey(clientPrivateKey, "")
+	require.NoError(t, err)
+
+	privateKeyPath := filepath.Join(t.TempDir(), "id_ed25519")
+	require.NoError(t, os.WriteFile(privateKeyPath, pem.EncodeToMemory(pemBlock), 0600))
+
+	addr, hostKey := startSSHServer(t, clientSigner.PublicKey())
+
+	// Put a stub agent into the cache to avoid downloading it
+	cacheDir := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", cacheDir)
+
+	agentCacheDir := filepath.Join(cacheDir, "cirrus", "agent")
+	require.NoError(t, os.MkdirAll(agentCacheDir, 0755))
+
+	outputPath := filepath.Join(t.TempDir(), "agent-output")
+	stubAgent := fmt.Sprintf("#!/bin/sh\necho \"$@\" > %s\n[ -d \"${11}\" ] && echo exists >> %s\n",
+		outputPath, outputPath)
+	stubAgentPath := filepath.Join(agentCacheDir,
+		fmt.Sprintf("cirrus-%s-linux-%s", platform.DefaultAgentVersion, runtime.GOARCH))
+	require.NoError(t, os.WriteFile(stubAgentPath, []byte(stubAgent), 0700))
+
+	// Lease a host from the inventory and run the instance
+	inventory, err := sshinventory.New(&sshinventory.Host{
+		Name:         "bare-metal",
+		Address:      addr,
+		User:         "ci",
+		PrivateKey:   privateKeyPath,
+		HostKey:      string(cryptossh.MarshalAuthorizedKey(hostKey)),
+		Architecture: runtime.GOARCH,
+	})
+	require.NoError(t, err)
+
+	request, err := inventory.Request(nil, nil)
+	require.NoError(t, err)
+
+	inst, err := ssh.New(request)
+	require.NoError(t, err)
+
+	workingDir := inst.WorkingDirectory("", false)
+
+	require.NoError(t, inst.Run(context.Background(), &runconfig.RunConfig{
+		Endpoint:     endpoint.NewRemote("http://127.0.0.1:1"),
+		ServerSecret: "server-secret",
+		ClientSecret: "client-secret",
+		TaskID:       "42",
+	}))
+
+	output, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+	require.Contains(t, string(output), "-task-id 42")
+	require.Contains(t, string(output), "-pre-created-working-dir "+workingDir)
+	require.True(t, strings.HasSuffix(strings.TrimSpace(string(output)), "exists"))
+
+	// Working directory should be cleaned up
+	require.NoDirExists(t, workingDir)
+
+	// The host should be returned to the inventory on close
+	busyCtx, busyCancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer busyCancel()
+
+	_, err = inventory.Acquire(busyCtx, nil, nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	require.NoError(t, inst.Close(context.Background()))
+
+	_, err = inventory.Acquire(context.Background(), nil, nil)
+	require.NoError(t, err)
+}
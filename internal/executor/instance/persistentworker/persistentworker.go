@@ -10,11 +10,13 @@ import (
 	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/persistentworker/isolation/container"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/persistentworker/isolation/none"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/persistentworker/isolation/parallels"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/persistentworker/isolation/ssh"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/persistentworker/isolation/tart"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/persistentworker/isolation/vetu"
 	"github.com/cirruslabs/cirrus-cli/internal/logger"
 	"github.com/cirruslabs/cirrus-cli/internal/worker/resourcemodifier"
 	"github.com/cirruslabs/cirrus-cli/internal/worker/security"
+	"github.com/cirruslabs/cirrus-cli/internal/worker/sshinventory"
 	"github.com/cirruslabs/cirrus-cli/internal/worker/tuning"
 	"github.com/cirruslabs/cirrus-cli/pkg/api"
 )
@@ -26,6 +28,7 @@ func New(
 	security *security.Security,
 	resourceModifier *resourcemodifier.Modifier,
 	tuning *tuning.Tuning,
+	sshRequest *sshinventory.Request,
 	logger logger.Lightweight,
 ) (abstract.Instance, error) {
 	if isolation == nil {
@@ -77,6 +80,8 @@ func New(
 		return newTart(iso, security, logger)
 	case *api.Isolation_Vetu_:
 		return newVetu(iso, security, resourceModifier, tuning, logger)
+	case *api.Isolation_Ssh_:
+		return newSSH(security, sshRequest, logger)
 	default:
 		return nil, fmt.Errorf("%w: unsupported isolation type %T", ErrInvalidIsolation, iso)
 	}
@@ -179,3 +184,22 @@ func newVetu(
 	return vetu.New(iso.Vetu.Image, iso.Vetu.User, iso.Vetu.Password, uint16(iso.Vetu.Port),
 		iso.Vetu.Cpu, iso.Vetu.Memory, resourceModifier, tuning, opts...)
 }
+
+func newSSH(
+	security *security.Security,
+	sshRequest *sshinventory.Request,
+	logger logger.Lightweight,
+) (*ssh.SSH, error) {
+	if sshRequest == nil {
+		return nil, fmt.Errorf("%w: \"ssh\" isolation requires a Persistent Worker with \"ssh-hosts:\" configured",
+			ErrInvalidIsolation)
+	}
+
+	sshPolicy := security.SSHPolicy()
+	if sshPolicy == nil {
+		return nil, fmt.Errorf("%w: \"ssh\" isolation is not allowed by this Persistent Worker's "+
+			"security settings", ErrInvalidIsolation)
+	}
+
+	return ssh.New(sshRequest, ssh.WithLogger(logger))
+}
@@ -10,6 +10,10 @@ import (
 )
 
 func SyncProjectDir(dir string, sshClient *ssh.Client) error {
+	return SyncProjectDirTo(dir, platform.NewUnix().GenericWorkingDir(), sshClient)
+}
+
+func SyncProjectDirTo(dir string, remoteDir string, sshClient *ssh.Client) error {
 	sftpClient, err := sftp.NewClient(sshClient)
 	if err != nil {
 		return err
@@ -26,7 +30,7 @@ func SyncProjectDir(dir string, sshClient *ssh.Client) error {
 		if err != nil {
 			return err
 		}
-		remotePath := sftp.Join(platform.NewUnix().GenericWorkingDir(), relativePath)
+		remotePath := sftp.Join(remoteDir, relativePath)
 
 		if fileInfo.Mode().IsDir() {
 			return sftpClient.MkdirAll(remotePath)
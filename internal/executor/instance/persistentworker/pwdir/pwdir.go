@@ -1,14 +1,31 @@
 package pwdir
 
 import (
+	"errors"
 	"github.com/cirruslabs/cirrus-cli/pkg/privdrop"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+const (
+	staticDirName    = "cirrus-build"
+	dynamicDirPrefix = "cirrus-build-"
+
+	// Each working directory is accompanied by a file containing
+	// the PID of the process that created it, which allows us
+	// to distinguish the stale directories from the ones that
+	// are still in use by other processes on the same host
+	ownerSuffix = ".owner"
 )
 
 func StaticTempDirWithDynamicFallback() (string, error) {
 	// Prefer static directory for non-Cirrus CI caches efficiency (e.g. ccache)
-	staticTempDir := filepath.Join(os.TempDir(), "cirrus-build")
+	staticTempDir := filepath.Join(os.TempDir(), staticDirName)
 	if err := os.Mkdir(staticTempDir, 0700); err == nil {
 		// Make sure that static directory belongs to the privilege-dropped
 		// user and group, in case privilege dropping was requested
@@ -18,10 +35,14 @@ func StaticTempDirWithDynamicFallback() (string, error) {
 			}
 		}
 
+		if err := writeOwner(staticTempDir); err != nil {
+			return "", err
+		}
+
 		return staticTempDir, nil
 	}
 
-	tempDir, err := os.MkdirTemp("", "cirrus-build-")
+	tempDir, err := os.MkdirTemp("", dynamicDirPrefix)
 	if err != nil {
 		return "", err
 	}
@@ -34,5 +55,127 @@ func StaticTempDirWithDynamicFallback() (string, error) {
 		}
 	}
 
+	if err := writeOwner(tempDir); err != nil {
+		return "", err
+	}
+
 	return tempDir, nil
 }
+
+// Remove removes the working directory created by StaticTempDirWithDynamicFallback().
+func Remove(dir string) error {
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+
+	if err := os.Remove(dir + ownerSuffix); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// Cleanup removes the working directories left behind by the tasks
+// that were running when the previous worker process has crashed.
+//
+// Only the directories whose owner is known to be no longer running
+// are removed, since the directories without an owner file might
+// belong to an older version of the worker.
+func Cleanup() error {
+	candidates, err := filepath.Glob(filepath.Join(os.TempDir(), dynamicDirPrefix+"*"))
+	if err != nil {
+		return err
+	}
+
+	candidates = append(candidates, filepath.Join(os.TempDir(), staticDirName))
+
+	var result error
+
+	for _, candidate := range candidates {
+		if strings.HasSuffix(candidate, ownerSuffix) {
+			continue
+		}
+
+		info, err := os.Lstat(candidate)
+		if err != nil || !info.IsDir() {
+			continue
+		}
+
+		if !ownerDead(candidate) {
+			continue
+		}
+
+		if err := Remove(candidate); err != nil {
+			result = errors.Join(result, err)
+		}
+	}
+
+	return result
+}
+
+func writeOwner(dir string) error {
+	return os.WriteFile(dir+ownerSuffix, []byte(strconv.Itoa(os.Getpid())), 0600)
+}
+
+// ownerDead returns true only when the directory has an owner file
+// and the process referenced in it is no longer running.
+func ownerDead(dir string) bool {
+	ownerBytes, err := os.ReadFile(dir + ownerSuffix)
+	if err != nil {
+		return false
+	}
+
+	pid, err := strconv.Atoi(strings.TrimSpace(string(ownerBytes)))
+	if err != nil || pid <= 0 {
+		return false
+	}
+
+	// On Windows, os.FindProcess() only succeeds for the running processes
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return true
+	}
+
+	if runtime.GOOS == "windows" {
+		return false
+	}
+
+	err = process.Signal(syscall.Signal(0))
+
+	return errors.Is(err, os.ErrProcessDone) || errors.Is(err, syscall.ESRCH)
+}
+
+// Usage returns the total size of the regular files in the directory.
+func Usage(dir string) (uint64, error) {
+	var result uint64
+
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			// Files might be removed by the task while we walk
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+
+			return err
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+
+			return err
+		}
+
+		result += uint64(info.Size())
+
+		return nil
+	})
+
+	return result, err
+}
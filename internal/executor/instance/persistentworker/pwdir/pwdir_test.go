@@ -0,0 +1,80 @@
+package pwdir_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/persistentworker/pwdir"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCleanup(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("TMPDIR", tempDir)
+
+	staticDir, err := pwdir.StaticTempDirWithDynamicFallback()
+	require.NoError(t, err)
+
+	staleDir, err := pwdir.StaticTempDirWithDynamicFallback()
+	require.NoError(t, err)
+
+	activeDir, err := pwdir.StaticTempDirWithDynamicFallback()
+	require.NoError(t, err)
+
+	ownerlessDir, err := pwdir.StaticTempDirWithDynamicFallback()
+	require.NoError(t, err)
+
+	// Simulate the directories left behind by a crashed process
+	cmd := exec.Command("go", "version")
+	require.NoError(t, cmd.Run())
+	deadPID := []byte(strconv.Itoa(cmd.Process.Pid))
+	require.NoError(t, os.WriteFile(staleDir+".owner", deadPID, 0600))
+	require.NoError(t, os.WriteFile(staticDir+".owner", deadPID, 0600))
+
+	// Simulate a directory created by an older version
+	require.NoError(t, os.Remove(ownerlessDir+".owner"))
+
+	unrelatedDir := filepath.Join(tempDir, "unrelated")
+	require.NoError(t, os.Mkdir(unrelatedDir, 0700))
+
+	require.NoError(t, pwdir.Cleanup())
+
+	require.NoDirExists(t, staleDir)
+	require.NoFileExists(t, staleDir+".owner")
+	require.NoDirExists(t, staticDir)
+	require.NoFileExists(t, staticDir+".owner")
+	require.DirExists(t, activeDir)
+	require.DirExists(t, ownerlessDir)
+	require.DirExists(t, unrelatedDir)
+
+	require.NoError(t, pwdir.Remove(activeDir))
+	require.NoDirExists(t, activeDir)
+	require.NoFileExists(t, activeDir+".owner")
+}
+
+func TestCleanupKeepsStaticDirInUse(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+
+	staticDir, err := pwdir.StaticTempDirWithDynamicFallback()
+	require.NoError(t, err)
+
+	require.NoError(t, pwdir.Cleanup())
+
+	require.DirExists(t, staticDir)
+	require.FileExists(t, staticDir+".owner")
+}
+
+func TestUsage(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a"), make([]byte, 1000), 0600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "nested", "deeper"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "nested", "deeper", "b"), make([]byte, 234), 0600))
+
+	usage, err := pwdir.Usage(dir)
+	require.NoError(t, err)
+	require.EqualValues(t, 1234, usage)
+}
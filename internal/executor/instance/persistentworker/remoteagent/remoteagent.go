@@ -57,11 +57,33 @@ func WaitForAgent(
 	preCreatedWorkingDir string,
 	env map[string]string,
 	localNetworkHelper *localnetworkhelper.LocalNetworkHelper,
+) error {
+	return WaitForAgentWithClientConfig(ctx, logger, addr, PasswordClientConfig(sshUser, sshPassword),
+		agentOS, agentArchitecture, config, synchronizeTime, initializeHooks, terminateHooks,
+		preCreatedWorkingDir, env, localNetworkHelper)
+}
+
+// WaitForAgentWithClientConfig is similar to WaitForAgent, but allows the caller to fully customize
+// the SSH client configuration, for example, to use public key authentication and verify host keys.
+func WaitForAgentWithClientConfig(
+	ctx context.Context,
+	logger logger.Lightweight,
+	addr string,
+	sshConfig *ssh.ClientConfig,
+	agentOS string,
+	agentArchitecture string,
+	config *runconfig.RunConfig,
+	synchronizeTime bool,
+	initializeHooks WaitForAgentHooks,
+	terminateHooks WaitForAgentHooks,
+	preCreatedWorkingDir string,
+	env map[string]string,
+	localNetworkHelper *localnetworkhelper.LocalNetworkHelper,
 ) error {
 	ctx, span := tracer.Start(ctx, "upload-and-wait-for-agent")
 	defer span.End()
 
-	cli, err := connectViaSSH(ctx, logger, addr, sshUser, sshPassword, localNetworkHelper)
+	cli, err := connectViaSSH(ctx, logger, addr, sshConfig, localNetworkHelper)
 	if err != nil {
 		return err
 	}
@@ -255,15 +277,14 @@ func connectViaSSH(
 	ctx context.Context,
 	logger logger.Lightweight,
 	addr string,
-	sshUser string,
-	sshPassword string,
+	sshConfig *ssh.ClientConfig,
 	localNetworkHelper *localnetworkhelper.LocalNetworkHelper,
 ) (*ssh.Client, error) {
 	// Connect to the VM and upload the agent
 
 	logger.Debugf("connecting via SSH to %s...", addr)
 
-	sshClient, err := WaitForSSH(ctx, addr, sshUser, sshPassword, localNetworkHelper, logger)
+	sshClient, err := WaitForSSHWithClientConfig(ctx, addr, sshConfig, localNetworkHelper, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -273,6 +294,21 @@ func connectViaSSH(
 	return sshClient, nil
 }
 
+// PasswordClientConfig returns an SSH client configuration suitable for connecting
+// to the ephemeral VMs: it uses password authentication and accepts any host key.
+func PasswordClientConfig(sshUser string, sshPassword string) *ssh.ClientConfig {
+	return &ssh.ClientConfig{
+		HostKeyCallback: func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			return nil
+		},
+		User: sshUser,
+		Auth: []ssh.AuthMethod{
+			ssh.Password(sshPassword),
+		},
+		Timeout: time.Second,
+	}
+}
+
 func WaitForSSH(
 	ctx context.Context,
 	addr string,
@@ -280,6 +316,17 @@ func WaitForSSH(
 	sshPassword string,
 	localNetworkHelper *localnetworkhelper.LocalNetworkHelper,
 	logger logger.Lightweight,
+) (*ssh.Client, error) {
+	return WaitForSSHWithClientConfig(ctx, addr, PasswordClientConfig(sshUser, sshPassword),
+		localNetworkHelper, logger)
+}
+
+func WaitForSSHWithClientConfig(
+	ctx context.Context,
+	addr string,
+	sshConfig *ssh.ClientConfig,
+	localNetworkHelper *localnetworkhelper.LocalNetworkHelper,
+	logger logger.Lightweight,
 ) (*ssh.Client, error) {
 	ctx, span := tracer.Start(ctx, "wait-for-ssh")
 	defer span.End()
@@ -287,11 +334,16 @@ func WaitForSSH(
 	var chans <-chan ssh.NewChannel
 	var reqs <-chan *ssh.Request
 
+	dialTimeout := sshConfig.Timeout
+	if dialTimeout == 0 {
+		dialTimeout = time.Second
+	}
+
 	if err := retry.Do(func() error {
 		var netConn net.Conn
 		var err error
 
-		boundedCtx, cancel := context.WithTimeout(ctx, time.Second)
+		boundedCtx, cancel := context.WithTimeout(ctx, dialTimeout)
 		defer cancel()
 
 		if localNetworkHelper != nil {
@@ -309,17 +361,6 @@ func WaitForSSH(
 
 		logger.Debugf("successfully dialed %s, performing SSH handshake...", addr)
 
-		sshConfig := &ssh.ClientConfig{
-			HostKeyCallback: func(hostname string, remote net.Addr, key ssh.PublicKey) error {
-				return nil
-			},
-			User: sshUser,
-			Auth: []ssh.AuthMethod{
-				ssh.Password(sshPassword),
-			},
-			Timeout: time.Second,
-		}
-
 		sshConn, chans, reqs, err = ssh.NewClientConn(netConn, addr, sshConfig)
 		if err != nil {
 			_ = netConn.Close()
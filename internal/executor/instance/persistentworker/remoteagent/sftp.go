@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/agent"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/platform"
+	"github.com/google/uuid"
 	"github.com/pkg/sftp"
 	"golang.org/x/crypto/ssh"
 	"io"
@@ -43,8 +44,13 @@ func uploadAgent(
 	}
 
 	// Create agent's binary remotely
+	//
+	// We upload to a temporary file first and then atomically rename it
+	// to avoid truncating the binary that might be already executing
+	// (e.g. when multiple tasks are running on the same SSH host).
 	remoteAgentPath := path.Join(platform.NewUnix().CirrusDir(), "cirrus")
-	remoteAgentFile, err := sftpCli.Create(remoteAgentPath)
+	remoteAgentTmpPath := remoteAgentPath + "." + uuid.NewString() + ".tmp"
+	remoteAgentFile, err := sftpCli.Create(remoteAgentTmpPath)
 	if err != nil {
 		return "", err
 	}
@@ -63,9 +69,19 @@ func uploadAgent(
 	}
 
 	// Agent binary should be executable
-	if err := sftpCli.Chmod(remoteAgentPath, 0700); err != nil {
+	if err := sftpCli.Chmod(remoteAgentTmpPath, 0700); err != nil {
 		return "", err
 	}
 
+	if err := sftpCli.PosixRename(remoteAgentTmpPath, remoteAgentPath); err != nil {
+		// Fall back to the non-atomic rename for servers
+		// that do not support the "posix-rename" extension
+		_ = sftpCli.Remove(remoteAgentPath)
+
+		if err := sftpCli.Rename(remoteAgentTmpPath, remoteAgentPath); err != nil {
+			return "", err
+		}
+	}
+
 	return remoteAgentPath, nil
 }
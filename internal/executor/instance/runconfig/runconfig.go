@@ -11,6 +11,10 @@ import (
 	"github.com/hashicorp/go-version"
 )
 
+// UpstreamArtifactsDirEnvironmentVariable points to a directory containing
+// the artifacts of the tasks the current task depends on, one subdirectory per task.
+const UpstreamArtifactsDirEnvironmentVariable = "CIRRUS_UPSTREAM_ARTIFACTS_DIR"
+
 var stubLogger = echelon.NewLogger(echelon.ErrorLevel, &renderers.StubRenderer{})
 
 type RunConfig struct {
@@ -28,6 +32,10 @@ type RunConfig struct {
 	containerBackend           containerbackend.ContainerBackend
 	AdditionalEnvironment      map[string]string
 	LocalNetworkHelper         *localnetworkhelper.LocalNetworkHelper
+
+	// Maps the names of the tasks the current task depends on
+	// to the host directories containing their artifacts
+	UpstreamArtifacts map[string]string
 }
 
 func (rc *RunConfig) GetContainerBackend() (containerbackend.ContainerBackend, error) {
@@ -2,8 +2,12 @@ package executor
 
 import (
 	"github.com/cirruslabs/chacha/pkg/localnetworkhelper"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/annotations"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/options"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/secrets"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/taskfilter"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/terminalserver"
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
 	"github.com/cirruslabs/echelon"
 	"time"
 )
@@ -81,3 +85,32 @@ func WithLocalNetworkHelper(localNetworkHelper *localnetworkhelper.LocalNetworkH
 		e.localNetworkHelper = localNetworkHelper
 	}
 }
+
+// WithTerminal opens an interactive terminal on the task's instance
+// after all of its commands were run or only when the task fails.
+func WithTerminal(onFailureOnly bool) Option {
+	return func(e *Executor) {
+		e.terminalServer = terminalserver.New()
+
+		if onFailureOnly {
+			e.terminalBehavior = api.Command_ON_FAILURE
+		} else {
+			e.terminalBehavior = api.Command_ALWAYS
+		}
+	}
+}
+
+// WithSecretsProvider resolves the ENCRYPTED[...] environment variable values before the tasks start.
+func WithSecretsProvider(secretsProvider secrets.Provider) Option {
+	return func(e *Executor) {
+		e.secretsProvider = secretsProvider
+	}
+}
+
+// WithAnnotations collects the annotations reported by the tasks,
+// e.g. to summarize them or to export them as SARIF once the build finishes.
+func WithAnnotations(collector *annotations.Collector) Option {
+	return func(e *Executor) {
+		e.annotations = collector
+	}
+}
@@ -0,0 +1,24 @@
+package pathsafe_test
+
+import (
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/executor/pathsafe"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscape(t *testing.T) {
+	for name, expected := range map[string]string{
+		"build":              "build",
+		"services/api/build": "services%2Fapi%2Fbuild",
+	} {
+		escaped, ok := pathsafe.Escape(name)
+		assert.True(t, ok)
+		assert.Equal(t, expected, escaped)
+	}
+
+	for _, name := range []string{"", "/build", "services//build", "services/../build", "services/*"} {
+		_, ok := pathsafe.Escape(name)
+		assert.False(t, ok, name)
+	}
+}
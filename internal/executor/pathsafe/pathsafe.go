@@ -23,3 +23,21 @@ func IsPathSafe(s string) bool {
 			r != '_' && r != '-' && r != ' ' && !isColon
 	}) == -1
 }
+
+// escapedSeparator replaces the slashes separating the monorepo project's directory
+// from the task's name, it can't clash with the other names since "%" is not path-safe.
+const escapedSeparator = "%2F"
+
+// Escape returns a path-safe representation of the task's name, which might
+// be prefixed by the monorepo project's directory (e.g. "services/api/build").
+func Escape(name string) (string, bool) {
+	segments := strings.Split(name, "/")
+
+	for _, segment := range segments {
+		if !IsPathSafe(segment) {
+			return "", false
+		}
+	}
+
+	return strings.Join(segments, escapedSeparator), true
+}
@@ -4,6 +4,10 @@ const (
 	// workingVolumeWorkingDir is a working directory relative to the CirrusDir().
 	workingVolumeWorkingDir = "working-dir"
 
+	// upstreamArtifactsDir is a directory relative to the CirrusDir() where
+	// the artifacts of the tasks the current task depends on are mounted.
+	upstreamArtifactsDir = "upstream-artifacts"
+
 	// workingVolumeAgentBinary is the name of the agent binary relative to the CirrusDir().
 	workingVolumeAgentBinary = "cirrus"
 
@@ -29,4 +33,5 @@ type Platform interface {
 
 	CirrusDir() string
 	GenericWorkingDir() string
+	UpstreamArtifactsDir(elem ...string) string
 }
@@ -52,3 +52,7 @@ func (platform *UnixPlatform) ContainerCopyCommand(populate bool) *CopyCommand {
 func (platform *UnixPlatform) GenericWorkingDir() string {
 	return path.Join(platform.CirrusDir(), workingVolumeWorkingDir)
 }
+
+func (platform *UnixPlatform) UpstreamArtifactsDir(elem ...string) string {
+	return path.Join(append([]string{platform.CirrusDir(), upstreamArtifactsDir}, elem...)...)
+}
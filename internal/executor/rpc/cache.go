@@ -11,15 +11,13 @@ import (
 	"github.com/samber/lo"
 	"google.golang.org/genproto/googleapis/bytestream"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
 const sendBufSize = 1024 * 1024
-const apiEndpointMetadataKey = "org.cirruslabs.api-endpoint"
 
 func (r *RPC) GenerateCacheUploadURL(ctx context.Context, _ *api.CacheKey) (*api.GenerateURLResponse, error) {
-	grpcEndpoint := asGRPCEndpoint(r.cacheEndpoint(ctx))
+	grpcEndpoint := asGRPCEndpoint(r.agentAPIEndpoint(ctx))
 	return &api.GenerateURLResponse{Url: grpcEndpoint}, nil
 }
 
@@ -84,21 +82,10 @@ func (r *RPC) Write(stream bytestream.ByteStream_WriteServer) error {
 }
 
 func (r *RPC) GenerateCacheDownloadURLs(ctx context.Context, _ *api.CacheKey) (*api.GenerateURLsResponse, error) {
-	grpcEndpoint := asGRPCEndpoint(r.cacheEndpoint(ctx))
+	grpcEndpoint := asGRPCEndpoint(r.agentAPIEndpoint(ctx))
 	return &api.GenerateURLsResponse{Urls: []string{grpcEndpoint}}, nil
 }
 
-func (r *RPC) cacheEndpoint(ctx context.Context) string {
-	if md, ok := metadata.FromIncomingContext(ctx); ok {
-		apiEndpoints := md.Get(apiEndpointMetadataKey)
-		if len(apiEndpoints) != 0 && apiEndpoints[0] != "" {
-			return apiEndpoints[0]
-		}
-	}
-
-	return r.ContainerEndpoint()
-}
-
 func asGRPCEndpoint(apiEndpoint string) string {
 	switch {
 	case strings.HasPrefix(apiEndpoint, "http://"):
@@ -63,13 +63,13 @@ func TestGenerateCacheUploadURLFallsBackToContainerEndpoint(t *testing.T) {
 	require.Equal(t, asGRPCEndpoint(rpcServer.ContainerEndpoint()), response.Url)
 }
 
-func startRPCServerAndConnect(t *testing.T, task *api.Task) (*RPC, *grpc.ClientConn) {
+func startRPCServerAndConnect(t *testing.T, task *api.Task, opts ...Option) (*RPC, *grpc.ClientConn) {
 	t.Helper()
 
 	b, err := build.New(t.TempDir(), []*api.Task{task}, &logger.LightweightStub{})
 	require.NoError(t, err)
 
-	rpcServer := New(b)
+	rpcServer := New(b, opts...)
 	require.NoError(t, rpcServer.Start(context.Background(), "localhost:0", true))
 	t.Cleanup(rpcServer.Stop)
 
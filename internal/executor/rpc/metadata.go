@@ -13,6 +13,7 @@ import (
 const (
 	taskIDMetadataKey       = "org.cirruslabs.task-id"
 	clientSecretMetadataKey = "org.cirruslabs.client-secret"
+	apiEndpointMetadataKey  = "org.cirruslabs.api-endpoint"
 )
 
 func (r *RPC) taskFromMetadata(ctx context.Context) (*build.Task, error) {
@@ -55,3 +56,15 @@ func extractTaskAuthMetadata(ctx context.Context) (int64, string, error) {
 
 	return taskID, clientSecretValue[0], nil
 }
+
+// agentAPIEndpoint returns the RPC server address as seen by the agent.
+func (r *RPC) agentAPIEndpoint(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		apiEndpoints := md.Get(apiEndpointMetadataKey)
+		if len(apiEndpoints) != 0 && apiEndpoints[0] != "" {
+			return apiEndpoints[0]
+		}
+	}
+
+	return r.ContainerEndpoint()
+}
@@ -1,6 +1,8 @@
 package rpc
 
 import (
+	"github.com/cirruslabs/cirrus-cli/internal/executor/annotations"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/terminalserver"
 	"github.com/cirruslabs/echelon"
 )
 
@@ -17,3 +19,18 @@ func WithArtifactsDir(artifactsDir string) Option {
 		r.artifactsDir = artifactsDir
 	}
 }
+
+// WithAnnotations collects the annotations reported by the tasks.
+func WithAnnotations(collector *annotations.Collector) Option {
+	return func(r *RPC) {
+		r.annotations = collector
+	}
+}
+
+// WithTerminalServer enables the interactive terminal sessions
+// for the tasks that have a "wait_for_terminal" command.
+func WithTerminalServer(terminalServer *terminalserver.Server) Option {
+	return func(r *RPC) {
+		r.terminalServer = terminalServer
+	}
+}
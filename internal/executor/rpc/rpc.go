@@ -9,10 +9,11 @@ import (
 	"sync"
 	"time"
 
-	"github.com/cirruslabs/cirrus-cli/internal/commands/logs"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/annotations"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/build"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/build/commandstatus"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/heuristic"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/terminalserver"
 	"github.com/cirruslabs/cirrus-cli/pkg/api"
 	"github.com/cirruslabs/echelon"
 	"github.com/cirruslabs/echelon/renderers"
@@ -43,6 +44,11 @@ type RPC struct {
 
 	logger       *echelon.Logger
 	artifactsDir string
+
+	terminalServer *terminalserver.Server
+	annotations    *annotations.Collector
+	terminals      map[int64]*terminal
+	terminalsMtx   sync.Mutex
 }
 
 func New(build *build.Build, opts ...Option) *RPC {
@@ -51,6 +57,7 @@ func New(build *build.Build, opts ...Option) *RPC {
 		serverSecret: uuid.New().String(),
 		clientSecret: uuid.New().String(),
 		build:        build,
+		terminals:    map[int64]*terminal{},
 	}
 
 	// Register itself
@@ -68,6 +75,10 @@ func New(build *build.Build, opts ...Option) *RPC {
 		r.logger = echelon.NewLogger(echelon.InfoLevel, renderer)
 	}
 
+	if r.terminalServer != nil {
+		r.terminalServer.Register(r.server)
+	}
+
 	return r
 }
 
@@ -132,9 +143,10 @@ func (r *RPC) InitialCommands(
 
 	return &api.CommandsResponse{
 		Environment:       task.Environment,
-		Commands:          task.ProtoCommands(),
+		Commands:          r.protoCommands(ctx, task),
 		ServerToken:       r.serverSecret,
 		TimeoutInSeconds:  int64(task.Timeout.Seconds()),
+		SecretsToMask:     task.SecretsToMask,
 		FailedAtLeastOnce: task.FailedAtLeastOnce(),
 	}, nil
 }
@@ -169,6 +181,10 @@ func (r *RPC) ReportCommandUpdates(
 			command.SetStatus(commandstatus.Success)
 			commandLogger.Debugf("command %s was skipped", update.Name)
 			commandLogger.FinishWithType(echelon.FinishTypeSkipped)
+		case update.Status == api.Status_FAILED && update.OomKilled:
+			command.SetStatus(commandstatus.OutOfMemory)
+			commandLogger.Warnf("command %s was killed by the OOM killer", update.Name)
+			commandLogger.FinishWithType(echelon.FinishTypeFailed)
 		case update.Status == api.Status_ABORTED || update.Status == api.Status_FAILED:
 			command.SetStatus(commandstatus.Failure)
 			commandLogger.Debugf("command %s failed", update.Name)
@@ -198,6 +214,8 @@ func (r *RPC) getCommandLogger(task *build.Task, command *build.Command) *echelo
 		commandLoggerScope += " cache"
 	case *api.Command_ArtifactsInstruction:
 		commandLoggerScope += " artifacts"
+	case *api.Command_WaitForTerminalInstruction:
+		commandLoggerScope += " terminal"
 	}
 	return r.logger.Scoped(task.UniqueDescription()).Scoped(commandLoggerScope)
 }
@@ -305,36 +323,24 @@ func (r *RPC) ReportAgentSignal(ctx context.Context, req *api.ReportAgentSignalR
 }
 
 func (r *RPC) ReportAnnotations(ctx context.Context, req *api.ReportAnnotationsCommandRequest) (*empty.Empty, error) {
-	_, err := r.taskFromMetadata(ctx)
+	task, err := r.taskFromMetadata(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	ghaRenderer, ok := r.logger.Renderer().(*logs.GithubActionsLogsRenderer)
+	if r.annotations != nil {
+		r.annotations.Add(task.String(), req.Annotations...)
+	}
+
+	// Renderers that can't display the annotations natively
+	// will get a summary once the build finishes
+	renderer, ok := r.logger.Renderer().(annotations.Renderer)
 	if !ok {
 		return &empty.Empty{}, nil
 	}
 
 	for _, annotation := range req.Annotations {
-		if annotation.FileLocation == nil {
-			continue
-		}
-
-		var mappedLevel string
-
-		switch annotation.Level {
-		case api.Annotation_NOTICE:
-			mappedLevel = "notice"
-		case api.Annotation_WARNING:
-			mappedLevel = "warning"
-		case api.Annotation_FAILURE:
-			mappedLevel = "error"
-		}
-
-		rawMessage := fmt.Sprintf("::%s file=%s,line=%d,endLine=%d,title=%s::%s\n", mappedLevel,
-			annotation.FileLocation.Path, annotation.FileLocation.StartLine, annotation.FileLocation.EndLine,
-			annotation.Message, annotation.RawDetails)
-		ghaRenderer.RenderRawMessage(rawMessage)
+		renderer.RenderAnnotation(task.String(), annotation)
 	}
 
 	return &empty.Empty{}, nil
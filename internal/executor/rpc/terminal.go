@@ -0,0 +1,112 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/cirruslabs/cirrus-cli/internal/executor/build"
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type terminal struct {
+	locator       string
+	trustedSecret string
+	attached      bool
+}
+
+// protoCommands returns the task's commands with the terminal server
+// address pointing to this RPC server when the terminal server is enabled.
+func (r *RPC) protoCommands(ctx context.Context, task *build.Task) []*api.Command {
+	commands := task.ProtoCommands()
+
+	if r.terminalServer == nil {
+		return commands
+	}
+
+	var result []*api.Command
+
+	for _, command := range commands {
+		if command.GetWaitForTerminalInstruction() != nil {
+			command = proto.Clone(command).(*api.Command)
+			command.GetWaitForTerminalInstruction().TerminalServerAddress = r.agentAPIEndpoint(ctx)
+		}
+
+		result = append(result, command)
+	}
+
+	return result
+}
+
+func (r *RPC) ReportTerminalAttached(
+	ctx context.Context,
+	req *api.ReportTerminalAttachedRequest,
+) (*api.ReportTerminalAttachedResponse, error) {
+	task, err := r.taskFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.terminalServer == nil {
+		return nil, status.Error(codes.Unimplemented, "terminal server is not enabled")
+	}
+
+	r.terminalsMtx.Lock()
+	r.terminals[task.ID] = &terminal{
+		locator:       req.Locator,
+		trustedSecret: req.TrustedSecret,
+	}
+	r.terminalsMtx.Unlock()
+
+	r.logger.Scoped(task.UniqueDescription()).Debugf("terminal host registered with locator %s", req.Locator)
+
+	return &api.ReportTerminalAttachedResponse{}, nil
+}
+
+func (r *RPC) ReportTerminalLifecycle(
+	ctx context.Context,
+	req *api.ReportTerminalLifecycleRequest,
+) (*api.ReportTerminalLifecycleResponse, error) {
+	task, err := r.taskFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The countdown starts once the task reaches the "wait_for_terminal" command,
+	// which is the right moment to attach, but only do this once per terminal host
+	if req.GetExpiring() == nil {
+		return &api.ReportTerminalLifecycleResponse{}, nil
+	}
+
+	r.terminalsMtx.Lock()
+	terminal, ok := r.terminals[task.ID]
+	shouldAttach := ok && !terminal.attached
+	if shouldAttach {
+		terminal.attached = true
+	}
+	r.terminalsMtx.Unlock()
+
+	if !shouldAttach {
+		return &api.ReportTerminalLifecycleResponse{}, nil
+	}
+
+	go r.attachTerminal(task, terminal)
+
+	return &api.ReportTerminalLifecycleResponse{}, nil
+}
+
+func (r *RPC) attachTerminal(task *build.Task, terminal *terminal) {
+	logger := r.logger.Scoped(task.UniqueDescription())
+
+	logger.Infof("attaching to the terminal, exit the shell to detach and continue")
+
+	if err := r.terminalServer.AttachInteractive(context.Background(), terminal.locator,
+		terminal.trustedSecret); err != nil {
+		logger.Warnf("failed to attach to the terminal: %v", err)
+
+		return
+	}
+
+	logger.Infof("detached from the terminal")
+}
@@ -0,0 +1,36 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/executor/terminalserver"
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitialCommandsPointsTerminalToItself(t *testing.T) {
+	task := testTask(t)
+	task.Commands = append(task.Commands, &api.Command{
+		Name: "wait_for_terminal",
+		Instruction: &api.Command_WaitForTerminalInstruction{
+			WaitForTerminalInstruction: &api.WaitForTerminalInstruction{},
+		},
+	})
+
+	rpcServer, conn := startRPCServerAndConnect(t, task, WithTerminalServer(terminalserver.New()))
+	cirrusClient := api.NewCirrusCIServiceClient(conn)
+
+	reportedAPIEndpoint := "http://127.0.0.1:31337"
+	ctx := authenticatedContext(t, rpcServer, task.LocalGroupId, reportedAPIEndpoint)
+
+	response, err := cirrusClient.InitialCommands(ctx, &api.InitialCommandsRequest{})
+	require.NoError(t, err)
+
+	require.Len(t, response.Commands, 2)
+	require.Equal(t, reportedAPIEndpoint,
+		response.Commands[1].GetWaitForTerminalInstruction().TerminalServerAddress)
+
+	// The task's own commands are left intact
+	require.Empty(t, rpcServer.build.GetTask(task.LocalGroupId).Commands[1].ProtoCommand.
+		GetWaitForTerminalInstruction().TerminalServerAddress)
+}
@@ -0,0 +1,22 @@
+package secrets
+
+import (
+	"os"
+)
+
+// EnvPrefix is prepended to the variable name to find its plaintext in the CLI's own environment.
+const EnvPrefix = "CIRRUS_SECRET_"
+
+type envProvider struct{}
+
+// NewEnvProvider returns a provider that resolves the variable FOO
+// using the CIRRUS_SECRET_FOO environment variable of the CLI.
+func NewEnvProvider() Provider {
+	return &envProvider{}
+}
+
+func (provider *envProvider) Lookup(name string, _ string) (string, bool, error) {
+	plaintext, ok := os.LookupEnv(EnvPrefix + name)
+
+	return plaintext, ok, nil
+}
@@ -0,0 +1,93 @@
+package secrets
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"gopkg.in/yaml.v3"
+)
+
+// FileStore is a YAML mapping of either the variable names or
+// their full ENCRYPTED[...] values to plaintext, encrypted with age[1]
+// using a passphrase or a recipient.
+//
+// [1]: https://age-encryption.org/
+type FileStore struct {
+	secrets map[string]string
+}
+
+// NewFileStore decrypts the store at the path using the identities,
+// see PassphraseIdentity() and ParseIdentitiesFile().
+func NewFileStore(path string, identities ...age.Identity) (*FileStore, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to open secrets file: %v", ErrSecrets, err)
+	}
+	defer file.Close()
+
+	bufferedFile := bufio.NewReader(file)
+
+	var reader io.Reader = bufferedFile
+
+	// Support both binary and ASCII-armored files
+	if header, err := bufferedFile.Peek(len(armor.Header)); err == nil && string(header) == armor.Header {
+		reader = armor.NewReader(bufferedFile)
+	}
+
+	decryptedReader, err := age.Decrypt(reader, identities...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to decrypt secrets file %s: %v", ErrSecrets, path, err)
+	}
+
+	var secrets map[string]string
+
+	decoder := yaml.NewDecoder(decryptedReader)
+
+	if err := decoder.Decode(&secrets); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: failed to parse secrets file %s: %v", ErrSecrets, path, err)
+	}
+
+	return &FileStore{secrets: secrets}, nil
+}
+
+func (store *FileStore) Lookup(name string, encryptedValue string) (string, bool, error) {
+	// Encrypted value is more specific, so it takes precedence
+	if plaintext, ok := store.secrets[encryptedValue]; ok {
+		return plaintext, true, nil
+	}
+
+	plaintext, ok := store.secrets[name]
+
+	return plaintext, ok, nil
+}
+
+// PassphraseIdentity returns an identity that decrypts the files encrypted with "age --passphrase".
+func PassphraseIdentity(passphrase string) (age.Identity, error) {
+	identity, err := age.NewScryptIdentity(passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSecrets, err)
+	}
+
+	return identity, nil
+}
+
+// ParseIdentitiesFile parses the identities generated by "age-keygen".
+func ParseIdentitiesFile(path string) ([]age.Identity, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to open identity file: %v", ErrSecrets, err)
+	}
+	defer file.Close()
+
+	identities, err := age.ParseIdentities(file)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse identity file %s: %v", ErrSecrets, path, err)
+	}
+
+	return identities, nil
+}
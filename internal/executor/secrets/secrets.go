@@ -0,0 +1,74 @@
+// Package secrets resolves the ENCRYPTED[...] environment variable values
+// locally, since only Cirrus Cloud is able to decrypt them.
+package secrets
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrSecrets = errors.New("failed to resolve secrets")
+
+// Provider maps an encrypted value of the environment variable
+// with the specified name to its plaintext.
+type Provider interface {
+	Lookup(name string, encryptedValue string) (string, bool, error)
+}
+
+// IsEncrypted returns true for the values that are only decryptable by Cirrus Cloud.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, "ENCRYPTED[") && strings.HasSuffix(value, "]")
+}
+
+type chain []Provider
+
+// Chain returns a provider that consults the providers in order
+// and returns the first plaintext found.
+func Chain(providers ...Provider) Provider {
+	return chain(providers)
+}
+
+func (providers chain) Lookup(name string, encryptedValue string) (string, bool, error) {
+	for _, provider := range providers {
+		plaintext, ok, err := provider.Lookup(name, encryptedValue)
+		if err != nil {
+			return "", false, err
+		}
+
+		if ok {
+			return plaintext, true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
+// Resolve replaces the encrypted values in the environment with their plaintext
+// and returns the resolved values, which should be masked in the logs, and
+// the names of the variables that remained encrypted.
+func Resolve(
+	provider Provider,
+	environment map[string]string,
+) (sensitiveValues []string, unresolved []string, err error) {
+	for name, value := range environment {
+		if !IsEncrypted(value) {
+			continue
+		}
+
+		plaintext, ok, err := provider.Lookup(name, value)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !ok {
+			unresolved = append(unresolved, name)
+
+			continue
+		}
+
+		environment[name] = plaintext
+		sensitiveValues = append(sensitiveValues, plaintext)
+	}
+
+	return sensitiveValues, unresolved, nil
+}
@@ -0,0 +1,127 @@
+package secrets_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/secrets"
+	"github.com/stretchr/testify/require"
+)
+
+const storeContents = `
+GITHUB_TOKEN: token-by-name
+ENCRYPTED[abcdef]: token-by-value
+`
+
+func writeStore(t *testing.T, armored bool, recipients ...age.Recipient) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	var output io.WriteCloser = nopCloser{&buf}
+	if armored {
+		output = armor.NewWriter(&buf)
+	}
+
+	writer, err := age.Encrypt(output, recipients...)
+	require.NoError(t, err)
+	_, err = writer.Write([]byte(storeContents))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	require.NoError(t, output.Close())
+
+	path := filepath.Join(t.TempDir(), "secrets.age")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0600))
+
+	return path
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
+
+func TestFileStorePassphrase(t *testing.T) {
+	recipient, err := age.NewScryptRecipient("correct horse battery staple")
+	require.NoError(t, err)
+	recipient.SetWorkFactor(10)
+
+	path := writeStore(t, false, recipient)
+
+	identity, err := secrets.PassphraseIdentity("correct horse battery staple")
+	require.NoError(t, err)
+
+	store, err := secrets.NewFileStore(path, identity)
+	require.NoError(t, err)
+
+	// Encrypted value takes precedence over the variable name
+	plaintext, ok, err := store.Lookup("GITHUB_TOKEN", "ENCRYPTED[abcdef]")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "token-by-value", plaintext)
+
+	plaintext, ok, err = store.Lookup("GITHUB_TOKEN", "ENCRYPTED[123456]")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "token-by-name", plaintext)
+
+	_, ok, err = store.Lookup("OTHER", "ENCRYPTED[123456]")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	wrongIdentity, err := secrets.PassphraseIdentity("wrong")
+	require.NoError(t, err)
+
+	_, err = secrets.NewFileStore(path, wrongIdentity)
+	require.ErrorIs(t, err, secrets.ErrSecrets)
+}
+
+func TestFileStoreIdentityArmored(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	path := writeStore(t, true, identity.Recipient())
+
+	identityPath := filepath.Join(t.TempDir(), "key.txt")
+	require.NoError(t, os.WriteFile(identityPath, []byte(identity.String()+"\n"), 0600))
+
+	identities, err := secrets.ParseIdentitiesFile(identityPath)
+	require.NoError(t, err)
+
+	store, err := secrets.NewFileStore(path, identities...)
+	require.NoError(t, err)
+
+	plaintext, ok, err := store.Lookup("GITHUB_TOKEN", "ENCRYPTED[123456]")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "token-by-name", plaintext)
+}
+
+func TestResolve(t *testing.T) {
+	t.Setenv(secrets.EnvPrefix+"FROM_ENV", "plaintext-from-env")
+
+	environment := map[string]string{
+		"FROM_ENV":  "ENCRYPTED[111]",
+		"MISSING":   "ENCRYPTED[222]",
+		"PLAINTEXT": "not a secret",
+	}
+
+	sensitiveValues, unresolved, err := secrets.Resolve(secrets.Chain(secrets.NewEnvProvider()), environment)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"plaintext-from-env"}, sensitiveValues)
+	require.Equal(t, []string{"MISSING"}, unresolved)
+	require.Equal(t, map[string]string{
+		"FROM_ENV":  "plaintext-from-env",
+		"MISSING":   "ENCRYPTED[222]",
+		"PLAINTEXT": "not a secret",
+	}, environment)
+}
@@ -0,0 +1,136 @@
+package terminalserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/term"
+)
+
+var ErrNotATerminal = errors.New("standard input is not a terminal")
+
+const resizeInterval = time.Second
+
+var (
+	stdinChunks     chan []byte
+	stdinReaderOnce sync.Once
+)
+
+// IsInteractive returns true when both the standard input
+// and the standard output are connected to a terminal.
+func IsInteractive() bool {
+	return term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// AttachInteractive connects the current process' terminal to a new session
+// on the host and returns once the shell on the host exits.
+func (server *Server) AttachInteractive(ctx context.Context, locator string, secret string) error {
+	if !IsInteractive() {
+		return ErrNotATerminal
+	}
+
+	stdinFd := int(os.Stdin.Fd())
+	stdoutFd := int(os.Stdout.Fd())
+
+	width, height, err := term.GetSize(stdoutFd)
+	if err != nil {
+		return fmt.Errorf("%w: failed to determine the terminal size: %v", ErrAttachFailed, err)
+	}
+
+	session, err := server.Attach(ctx, locator, secret, uint32(width), uint32(height))
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	oldState, err := term.MakeRaw(stdinFd)
+	if err != nil {
+		return fmt.Errorf("%w: failed to put the terminal into raw mode: %v", ErrAttachFailed, err)
+	}
+	defer func() {
+		_ = term.Restore(stdinFd, oldState)
+	}()
+
+	outputErrCh := make(chan error, 1)
+
+	go func() {
+		for {
+			output, err := session.Recv()
+			if err != nil {
+				outputErrCh <- err
+
+				return
+			}
+
+			if _, err := os.Stdout.Write(output); err != nil {
+				outputErrCh <- err
+
+				return
+			}
+		}
+	}()
+
+	// Periodically re-send the dimensions to follow the local terminal
+	// resizes, this also keeps the session active on the host
+	ticker := time.NewTicker(resizeInterval)
+	defer ticker.Stop()
+
+	input := stdin()
+
+	for {
+		select {
+		case chunk := <-input:
+			if _, err := session.Write(chunk); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			width, height, err := term.GetSize(stdoutFd)
+			if err != nil {
+				continue
+			}
+
+			if err := session.Resize(uint32(width), uint32(height)); err != nil {
+				return err
+			}
+		case err := <-outputErrCh:
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// stdin returns the standard input chunks, the reader is shared between
+// the sessions because a blocked os.Stdin.Read() cannot be interrupted.
+func stdin() <-chan []byte {
+	stdinReaderOnce.Do(func() {
+		stdinChunks = make(chan []byte)
+
+		go func() {
+			const bufSize = 4096
+
+			for {
+				buf := make([]byte, bufSize)
+
+				n, err := os.Stdin.Read(buf)
+				if n > 0 {
+					stdinChunks <- buf[:n]
+				}
+				if err != nil {
+					return
+				}
+			}
+		}()
+	})
+
+	return stdinChunks
+}
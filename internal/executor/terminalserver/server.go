@@ -0,0 +1,239 @@
+package terminalserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cirruslabs/cirrus-cli/pkg/api/terminal"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrHostNotFound = errors.New("terminal host not found")
+	ErrAttachFailed = errors.New("failed to attach to the terminal host")
+)
+
+const dataChannelTimeout = 30 * time.Second
+
+// Server is a minimal terminal server that the agents' terminal hosts register on,
+// which allows the CLI to open interactive terminal sessions on the tasks' instances.
+type Server struct {
+	hosts map[string]*host
+	mtx   sync.Mutex
+}
+
+type host struct {
+	trustedSecret       string
+	dataChannelRequests chan *terminal.HostControlResponse_DataChannelRequest
+	pendingDataChannels map[string]chan *dataChannel
+
+	// Closed once the host disconnects
+	done chan struct{}
+}
+
+type dataChannel struct {
+	stream grpc.ServerStream
+	done   chan struct{}
+}
+
+func New() *Server {
+	return &Server{
+		hosts: map[string]*host{},
+	}
+}
+
+// Register registers the server's HostService on the gRPC server.
+func (server *Server) Register(grpcServer *grpc.Server) {
+	grpcServer.RegisterService(&hostServiceDesc, server)
+}
+
+func (server *Server) controlChannel(stream grpc.ServerStream) error {
+	var request terminal.HostControlRequest
+
+	if err := stream.RecvMsg(&request); err != nil {
+		return err
+	}
+
+	hello := request.GetHello()
+	if hello == nil {
+		return status.Error(codes.InvalidArgument, "expected a Hello message")
+	}
+
+	if hello.TrustedSecret == "" {
+		return status.Error(codes.InvalidArgument, "empty trusted secret")
+	}
+
+	locator := uuid.NewString()
+	host := &host{
+		trustedSecret:       hello.TrustedSecret,
+		dataChannelRequests: make(chan *terminal.HostControlResponse_DataChannelRequest),
+		pendingDataChannels: map[string]chan *dataChannel{},
+		done:                make(chan struct{}),
+	}
+
+	server.mtx.Lock()
+	server.hosts[locator] = host
+	server.mtx.Unlock()
+
+	defer func() {
+		server.mtx.Lock()
+		delete(server.hosts, locator)
+		server.mtx.Unlock()
+
+		close(host.done)
+	}()
+
+	if err := stream.SendMsg(&terminal.HostControlResponse{
+		Operation: &terminal.HostControlResponse_Hello_{
+			Hello: &terminal.HostControlResponse_Hello{
+				Locator: locator,
+			},
+		},
+	}); err != nil {
+		return err
+	}
+
+	// The host doesn't send anything after the Hello message,
+	// so this only serves to detect the host's disconnection
+	recvErrCh := make(chan error, 1)
+
+	go func() {
+		for {
+			if err := stream.RecvMsg(&request); err != nil {
+				recvErrCh <- err
+
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case dataChannelRequest := <-host.dataChannelRequests:
+			if err := stream.SendMsg(&terminal.HostControlResponse{
+				Operation: &terminal.HostControlResponse_DataChannelRequest_{
+					DataChannelRequest: dataChannelRequest,
+				},
+			}); err != nil {
+				return err
+			}
+		case <-recvErrCh:
+			return nil
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+func (server *Server) dataChannel(stream grpc.ServerStream) error {
+	var request terminal.HostDataRequest
+
+	if err := stream.RecvMsg(&request); err != nil {
+		return err
+	}
+
+	hello := request.GetHello()
+	if hello == nil {
+		return status.Error(codes.InvalidArgument, "expected a Hello message")
+	}
+
+	server.mtx.Lock()
+	var pendingDataChannel chan *dataChannel
+	if host, ok := server.hosts[hello.Locator]; ok {
+		pendingDataChannel = host.pendingDataChannels[hello.Token]
+		delete(host.pendingDataChannels, hello.Token)
+	}
+	server.mtx.Unlock()
+
+	if pendingDataChannel == nil {
+		return status.Error(codes.NotFound, "no data channel was requested with this locator and token")
+	}
+
+	dataChannel := &dataChannel{
+		stream: stream,
+		done:   make(chan struct{}),
+	}
+
+	select {
+	case pendingDataChannel <- dataChannel:
+	case <-stream.Context().Done():
+		return stream.Context().Err()
+	}
+
+	// Keep the stream open until the session is closed
+	select {
+	case <-dataChannel.done:
+		return nil
+	case <-stream.Context().Done():
+		return stream.Context().Err()
+	}
+}
+
+// Attach opens a new terminal session on the host with the specified locator,
+// the secret should match the trusted secret provided by the host on registration.
+func (server *Server) Attach(
+	ctx context.Context,
+	locator string,
+	secret string,
+	widthColumns uint32,
+	heightRows uint32,
+) (*Session, error) {
+	token := uuid.NewString()
+	pendingDataChannel := make(chan *dataChannel, 1)
+
+	server.mtx.Lock()
+	host, ok := server.hosts[locator]
+	if ok {
+		host.pendingDataChannels[token] = pendingDataChannel
+	}
+	server.mtx.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrHostNotFound, locator)
+	}
+
+	defer func() {
+		server.mtx.Lock()
+		delete(host.pendingDataChannels, token)
+		server.mtx.Unlock()
+	}()
+
+	if subtle.ConstantTimeCompare([]byte(secret), []byte(host.trustedSecret)) != 1 {
+		return nil, fmt.Errorf("%w: invalid secret", ErrAttachFailed)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dataChannelTimeout)
+	defer cancel()
+
+	// Ask the host to open a new data channel
+	select {
+	case host.dataChannelRequests <- &terminal.HostControlResponse_DataChannelRequest{
+		Token: token,
+		RequestedDimensions: &terminal.TerminalDimensions{
+			WidthColumns: widthColumns,
+			HeightRows:   heightRows,
+		},
+	}:
+	case <-host.done:
+		return nil, fmt.Errorf("%w: host has disconnected", ErrAttachFailed)
+	case <-ctx.Done():
+		return nil, fmt.Errorf("%w: %v", ErrAttachFailed, ctx.Err())
+	}
+
+	// Wait for the host to open the data channel
+	select {
+	case dataChannel := <-pendingDataChannel:
+		return &Session{dataChannel: dataChannel}, nil
+	case <-host.done:
+		return nil, fmt.Errorf("%w: host has disconnected", ErrAttachFailed)
+	case <-ctx.Done():
+		return nil, fmt.Errorf("%w: %v", ErrAttachFailed, ctx.Err())
+	}
+}
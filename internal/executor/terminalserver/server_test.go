@@ -0,0 +1,105 @@
+//go:build !windows
+
+package terminalserver_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cirruslabs/cirrus-cli/internal/executor/terminalserver"
+	"github.com/cirruslabs/terminal/pkg/host"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func startHost(t *testing.T, trustedSecret string) (*terminalserver.Server, string) {
+	t.Helper()
+
+	server := terminalserver.New()
+
+	grpcServer := grpc.NewServer()
+	server.Register(grpcServer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	locatorCh := make(chan string, 1)
+
+	terminalHost, err := host.New(
+		host.WithServerAddress("http://"+listener.Addr().String()),
+		host.WithTrustedSecret(trustedSecret),
+		host.WithLocatorCallback(func(locator string) error {
+			locatorCh <- locator
+
+			return nil
+		}),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	go func() {
+		_ = terminalHost.Run(ctx)
+	}()
+
+	select {
+	case locator := <-locatorCh:
+		return server, locator
+	case <-time.After(30 * time.Second):
+		t.Fatal("terminal host didn't register in time")
+	}
+
+	return nil, ""
+}
+
+func TestSession(t *testing.T) {
+	server, locator := startHost(t, "secret")
+
+	session, err := server.Attach(context.Background(), locator, "secret", 80, 24)
+	require.NoError(t, err)
+	defer session.Close()
+
+	require.NoError(t, session.Resize(100, 30))
+
+	_, err = session.Write([]byte("echo $((20+22)); exit\n"))
+	require.NoError(t, err)
+
+	var output strings.Builder
+
+	for {
+		chunk, err := session.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+
+		output.Write(chunk)
+	}
+
+	require.Contains(t, output.String(), "42")
+}
+
+func TestAttachInvalidSecret(t *testing.T) {
+	server, locator := startHost(t, "secret")
+
+	_, err := server.Attach(context.Background(), locator, "not a secret", 80, 24)
+	require.ErrorIs(t, err, terminalserver.ErrAttachFailed)
+}
+
+func TestAttachUnknownLocator(t *testing.T) {
+	server, _ := startHost(t, "secret")
+
+	_, err := server.Attach(context.Background(), "unknown", "secret", 80, 24)
+	require.ErrorIs(t, err, terminalserver.ErrHostNotFound)
+}
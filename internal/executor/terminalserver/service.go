@@ -0,0 +1,38 @@
+package terminalserver
+
+import (
+	"google.golang.org/grpc"
+)
+
+// hostService is implemented by the Server.
+type hostService interface {
+	controlChannel(stream grpc.ServerStream) error
+	dataChannel(stream grpc.ServerStream) error
+}
+
+// hostServiceDesc describes the HostService using its upstream package-less
+// name, see api/terminal/terminal.proto for more details.
+var hostServiceDesc = grpc.ServiceDesc{
+	ServiceName: "HostService",
+	HandlerType: (*hostService)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName: "ControlChannel",
+			Handler: func(srv interface{}, stream grpc.ServerStream) error {
+				return srv.(hostService).controlChannel(stream)
+			},
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName: "DataChannel",
+			Handler: func(srv interface{}, stream grpc.ServerStream) error {
+				return srv.(hostService).dataChannel(stream)
+			},
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "terminal.proto",
+}
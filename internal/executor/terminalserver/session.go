@@ -0,0 +1,87 @@
+package terminalserver
+
+import (
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/cirruslabs/cirrus-cli/pkg/api/terminal"
+)
+
+var ErrProtocol = errors.New("terminal protocol error")
+
+// Session is an interactive terminal session opened on the host.
+type Session struct {
+	dataChannel *dataChannel
+
+	sendMtx   sync.Mutex
+	closeOnce sync.Once
+}
+
+// Write sends the input to the terminal.
+func (session *Session) Write(input []byte) (int, error) {
+	if err := session.send(&terminal.HostDataResponse{
+		Operation: &terminal.HostDataResponse_Input{
+			Input: &terminal.Data{Data: input},
+		},
+	}); err != nil {
+		return 0, err
+	}
+
+	return len(input), nil
+}
+
+// Resize changes the dimensions of the terminal.
+func (session *Session) Resize(widthColumns uint32, heightRows uint32) error {
+	return session.send(&terminal.HostDataResponse{
+		Operation: &terminal.HostDataResponse_ChangeDimensions{
+			ChangeDimensions: &terminal.TerminalDimensions{
+				WidthColumns: widthColumns,
+				HeightRows:   heightRows,
+			},
+		},
+	})
+}
+
+// Recv returns the next chunk of the terminal's output
+// or io.EOF once the shell on the host exits.
+func (session *Session) Recv() ([]byte, error) {
+	var request terminal.HostDataRequest
+
+	if err := session.dataChannel.stream.RecvMsg(&request); err != nil {
+		if errors.Is(err, io.EOF) || session.dataChannel.stream.Context().Err() != nil {
+			return nil, io.EOF
+		}
+
+		return nil, err
+	}
+
+	output := request.GetOutput()
+	if output == nil {
+		return nil, ErrProtocol
+	}
+
+	return output.Data, nil
+}
+
+// Close terminates the session.
+func (session *Session) Close() error {
+	session.closeOnce.Do(func() {
+		close(session.dataChannel.done)
+	})
+
+	return nil
+}
+
+func (session *Session) send(response *terminal.HostDataResponse) error {
+	session.sendMtx.Lock()
+	defer session.sendMtx.Unlock()
+
+	select {
+	case <-session.dataChannel.done:
+		return io.ErrClosedPipe
+	default:
+	}
+
+	return session.dataChannel.stream.SendMsg(response)
+}
@@ -0,0 +1,23 @@
+// Package githubactions contains helpers for emitting GitHub Actions workflow commands[1].
+//
+// [1]: https://docs.github.com/en/actions/using-workflows/workflow-commands-for-github-actions
+package githubactions
+
+import "strings"
+
+var (
+	dataReplacer     = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A")
+	propertyReplacer = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C")
+)
+
+// EscapeData escapes the message of a workflow command,
+// see https://github.com/actions/toolkit/blob/main/packages/core/src/command.ts.
+func EscapeData(data string) string {
+	return dataReplacer.Replace(data)
+}
+
+// EscapeProperty escapes the value of a workflow command's property (e.g. "file=..."),
+// which additionally can't contain the property and value separators.
+func EscapeProperty(property string) string {
+	return propertyReplacer.Replace(property)
+}
@@ -0,0 +1,13 @@
+package githubactions_test
+
+import (
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/githubactions"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscape(t *testing.T) {
+	require.Equal(t, "100%25 done%0D%0Anext: a, b", githubactions.EscapeData("100% done\r\nnext: a, b"))
+	require.Equal(t, "100%25 done%0D%0Anext%3A a%2C b", githubactions.EscapeProperty("100% done\r\nnext: a, b"))
+}
@@ -0,0 +1,94 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"strings"
+)
+
+var ErrProtocol = errors.New("LSP protocol error")
+
+// JSON-RPC 2.0 error codes[1].
+//
+// [1]: https://www.jsonrpc.org/specification#error_object
+const (
+	codeInvalidParams  = -32602
+	codeMethodNotFound = -32601
+	codeInternalError  = -32603
+)
+
+type message struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id,omitempty"`
+	Method  string           `json:"method,omitempty"`
+	Params  json.RawMessage  `json:"params,omitempty"`
+	Result  json.RawMessage  `json:"result,omitempty"`
+	Error   *responseError   `json:"error,omitempty"`
+}
+
+type responseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (rpcErr *responseError) Error() string {
+	return rpcErr.Message
+}
+
+func (msg *message) isRequest() bool {
+	return msg.ID != nil && msg.Method != ""
+}
+
+// readMessage reads a single message framed with the base protocol[1] headers.
+//
+// [1]: https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#baseProtocol
+func readMessage(reader *bufio.Reader) (*message, error) {
+	headers, err := textproto.NewReader(reader).ReadMIMEHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	rawContentLength := headers.Get("Content-Length")
+	if rawContentLength == "" {
+		return nil, fmt.Errorf("%w: missing Content-Length header", ErrProtocol)
+	}
+
+	contentLength, err := strconv.Atoi(strings.TrimSpace(rawContentLength))
+	if err != nil || contentLength < 0 {
+		return nil, fmt.Errorf("%w: invalid Content-Length header %q", ErrProtocol, rawContentLength)
+	}
+
+	body := make([]byte, contentLength)
+	if _, err := io.ReadFull(reader, body); err != nil {
+		return nil, err
+	}
+
+	var msg message
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProtocol, err)
+	}
+
+	return &msg, nil
+}
+
+func writeMessage(writer io.Writer, msg *message) error {
+	msg.JSONRPC = "2.0"
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(writer, "Content-Length: %d\r\n\r\n", len(body)); err != nil {
+		return err
+	}
+
+	_, err = writer.Write(body)
+
+	return err
+}
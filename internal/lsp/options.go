@@ -0,0 +1,20 @@
+package lsp
+
+import "google.golang.org/protobuf/reflect/protoreflect"
+
+type Option func(*Server)
+
+// WithAdditionalInstances makes the server aware of the instance types
+// supported by the Cirrus Cloud, both for diagnostics and completion.
+func WithAdditionalInstances(additionalInstances map[string]protoreflect.MessageDescriptor) Option {
+	return func(server *Server) {
+		server.additionalInstances = additionalInstances
+	}
+}
+
+// WithEnvironment sets the environment variables passed to the parser and the Starlark interpreter.
+func WithEnvironment(environment map[string]string) Option {
+	return func(server *Server) {
+		server.environment = environment
+	}
+}
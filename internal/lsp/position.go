@@ -0,0 +1,80 @@
+package lsp
+
+import (
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// The server works with the byte offsets into the document's lines, while the clients
+// count the characters in UTF-16 code units[1] and the parser and the Starlark interpreter
+// report the columns in Unicode code points.
+//
+// [1]: https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#position
+
+// utf16ToByteOffset converts the client's character offset into a byte offset in the line.
+func utf16ToByteOffset(line string, character int) int {
+	var units int
+
+	for offset, r := range line {
+		if units >= character {
+			return offset
+		}
+
+		units += utf16.RuneLen(r)
+	}
+
+	return len(line)
+}
+
+// byteToUTF16Offset converts the byte offset in the line into the client's character offset.
+func byteToUTF16Offset(line string, offset int) int {
+	var units int
+
+	for runeOffset, r := range line {
+		if runeOffset >= offset {
+			break
+		}
+
+		units += utf16.RuneLen(r)
+	}
+
+	return units
+}
+
+// runeToByteOffset converts the code point offset in the line into a byte offset.
+func runeToByteOffset(line string, column int) int {
+	offset := 0
+
+	for range max(column, 0) {
+		if offset >= len(line) {
+			break
+		}
+
+		_, size := utf8.DecodeRuneInString(line[offset:])
+		offset += size
+	}
+
+	return offset
+}
+
+// fromClientPosition converts the client's position into a position with a byte offset.
+func fromClientPosition(lines []string, pos position) position {
+	if pos.Line < 0 || pos.Line >= len(lines) {
+		return pos
+	}
+
+	return position{Line: pos.Line, Character: utf16ToByteOffset(lines[pos.Line], pos.Character)}
+}
+
+// toClientRange converts the range with byte offsets into the client's range.
+func toClientRange(lines []string, r lspRange) lspRange {
+	convert := func(pos position) position {
+		if pos.Line < 0 || pos.Line >= len(lines) {
+			return pos
+		}
+
+		return position{Line: pos.Line, Character: byteToUTF16Offset(lines[pos.Line], pos.Character)}
+	}
+
+	return lspRange{Start: convert(r.Start), End: convert(r.End)}
+}
@@ -0,0 +1,182 @@
+package lsp
+
+import (
+	"encoding/json"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/lestrrat-go/jsschema"
+)
+
+// schemaNode is a JSON schema in its generic JSON form, which is easier
+// to navigate than the jsschema structures with their draft-specific quirks.
+type schemaNode map[string]any
+
+// patternNameRegex matches the "^(.*)task$"-like patterns used
+// for the instructions with user-defined prefixes.
+var patternNameRegex = regexp.MustCompile(`^\^\(\.\*\)([a-z_]+)\$$`)
+
+func newSchemaNode(jsonSchema *schema.Schema) (schemaNode, error) {
+	schemaBytes, err := json.Marshal(jsonSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	var result schemaNode
+	if err := json.Unmarshal(schemaBytes, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// alternatives returns the node itself along with the schemas it can be substituted
+// with, i.e. its array items and the anyOf/oneOf/allOf subschemas.
+func (node schemaNode) alternatives() []schemaNode {
+	if node == nil {
+		return nil
+	}
+
+	result := []schemaNode{node}
+
+	for _, key := range []string{"items", "anyOf", "oneOf", "allOf"} {
+		switch typed := node[key].(type) {
+		case map[string]any:
+			result = append(result, schemaNode(typed).alternatives()...)
+		case []any:
+			for _, item := range typed {
+				if subschema, ok := item.(map[string]any); ok {
+					result = append(result, schemaNode(subschema).alternatives()...)
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// child returns the schema of the key nested in this node, if any.
+func (node schemaNode) child(key string) schemaNode {
+	for _, alternative := range node.alternatives() {
+		if properties, ok := alternative["properties"].(map[string]any); ok {
+			if property, ok := properties[key].(map[string]any); ok {
+				return property
+			}
+		}
+
+		if patternProperties, ok := alternative["patternProperties"].(map[string]any); ok {
+			for _, pattern := range slices.Sorted(maps.Keys(patternProperties)) {
+				regex, err := regexp.Compile(pattern)
+				if err != nil || !regex.MatchString(key) {
+					continue
+				}
+
+				if property, ok := patternProperties[pattern].(map[string]any); ok {
+					return property
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// lookup walks the node along the path of keys.
+func (node schemaNode) lookup(path []string) schemaNode {
+	current := node
+
+	for _, key := range path {
+		current = current.child(key)
+		if current == nil {
+			return nil
+		}
+	}
+
+	return current
+}
+
+// keys returns the names of the keys that can be nested in this node
+// along with their schemas, including the canonical names of the
+// instructions with user-defined prefixes (e.g. "task" for "^(.*)task$").
+func (node schemaNode) keys() map[string]schemaNode {
+	result := map[string]schemaNode{}
+
+	for _, alternative := range node.alternatives() {
+		if properties, ok := alternative["properties"].(map[string]any); ok {
+			for key, property := range properties {
+				if typed, ok := property.(map[string]any); ok {
+					result[key] = typed
+				}
+			}
+		}
+
+		if patternProperties, ok := alternative["patternProperties"].(map[string]any); ok {
+			for pattern, property := range patternProperties {
+				matches := patternNameRegex.FindStringSubmatch(pattern)
+				if matches == nil {
+					continue
+				}
+
+				if typed, ok := property.(map[string]any); ok {
+					result[matches[1]] = typed
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+func (node schemaNode) description() string {
+	for _, alternative := range node.alternatives() {
+		if description, ok := alternative["description"].(string); ok && description != "" {
+			return description
+		}
+	}
+
+	return ""
+}
+
+// typeName returns a human-readable summary of the types this node accepts.
+func (node schemaNode) typeName() string {
+	var types []string
+
+	for _, alternative := range node.alternatives() {
+		switch typed := alternative["type"].(type) {
+		case string:
+			types = append(types, typed)
+		case []any:
+			for _, item := range typed {
+				if itemType, ok := item.(string); ok {
+					types = append(types, itemType)
+				}
+			}
+		}
+	}
+
+	slices.Sort(types)
+
+	return strings.Join(slices.Compact(types), " | ")
+}
+
+// enum returns the values this node is restricted to, if any.
+func (node schemaNode) enum() []string {
+	var result []string
+
+	for _, alternative := range node.alternatives() {
+		values, ok := alternative["enum"].([]any)
+		if !ok {
+			continue
+		}
+
+		for _, value := range values {
+			if stringValue, ok := value.(string); ok {
+				result = append(result, stringValue)
+			}
+		}
+	}
+
+	return result
+}
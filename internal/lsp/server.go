@@ -0,0 +1,392 @@
+package lsp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cirruslabs/cirrus-cli/internal/version"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	diagnosticsTimeout = 30 * time.Second
+
+	// Delay before evaluating the document, so that the evaluations
+	// triggered by the changes made in a quick succession are coalesced
+	defaultDiagnosticsDelay = 300 * time.Millisecond
+)
+
+var errExit = errors.New("exit requested")
+
+// Server is a Language Server Protocol implementation for .cirrus.yml and .cirrus.star files
+// that communicates with a single client over a stream, typically the standard input and output.
+type Server struct {
+	additionalInstances map[string]protoreflect.MessageDescriptor
+	environment         map[string]string
+
+	schema    schemaNode
+	documents map[string]string
+
+	// Diagnostics are evaluated in the background, since evaluating a .cirrus.star
+	// might take a while and shouldn't block the processing of other messages
+	diagnosticsDelay time.Duration
+	evaluations      map[string]context.CancelFunc
+	evaluationsWG    sync.WaitGroup
+
+	// Guards the writer, which is used both by the message loop
+	// and by the evaluations publishing the diagnostics
+	writerLock sync.Mutex
+	writer     io.Writer
+}
+
+func New(opts ...Option) (*Server, error) {
+	server := &Server{
+		environment:      map[string]string{},
+		documents:        map[string]string{},
+		diagnosticsDelay: defaultDiagnosticsDelay,
+		evaluations:      map[string]context.CancelFunc{},
+	}
+
+	// Apply options
+	for _, opt := range opts {
+		opt(server)
+	}
+
+	schema, err := newSchemaNode(parser.New(server.parserOptions()...).Schema())
+	if err != nil {
+		return nil, err
+	}
+	server.schema = schema
+
+	return server, nil
+}
+
+// Serve processes the client's messages until the client requests an exit or closes the stream.
+func (server *Server) Serve(ctx context.Context, reader io.Reader, writer io.Writer) error {
+	server.writer = writer
+	bufferedReader := bufio.NewReader(reader)
+
+	// Stop the evaluations that are still running once we're done
+	ctx, cancel := context.WithCancel(ctx)
+	defer func() {
+		cancel()
+		server.evaluationsWG.Wait()
+	}()
+
+	for {
+		msg, err := readMessage(bufferedReader)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		// We never send requests to the client, so there's no need to process the responses
+		if msg.Method == "" {
+			continue
+		}
+
+		result, respErr := server.handle(ctx, msg)
+		if errors.Is(respErr, errExit) {
+			return nil
+		}
+
+		if !msg.isRequest() {
+			if respErr != nil {
+				slog.Warn("failed to process a notification", "method", msg.Method, "err", respErr)
+			}
+
+			continue
+		}
+
+		if err := server.respond(msg.ID, result, respErr); err != nil {
+			return err
+		}
+	}
+}
+
+func (server *Server) respond(id *json.RawMessage, result any, err error) error {
+	response := &message{ID: id}
+
+	if err != nil {
+		var rpcErr *responseError
+		if !errors.As(err, &rpcErr) {
+			rpcErr = &responseError{Code: codeInternalError, Message: err.Error()}
+		}
+		response.Error = rpcErr
+	} else {
+		rawResult, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+		response.Result = rawResult
+	}
+
+	server.writerLock.Lock()
+	defer server.writerLock.Unlock()
+
+	return writeMessage(server.writer, response)
+}
+
+func (server *Server) notify(method string, params any) error {
+	server.writerLock.Lock()
+	defer server.writerLock.Unlock()
+
+	return server.notifyLocked(method, params)
+}
+
+func (server *Server) notifyLocked(method string, params any) error {
+	rawParams, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	return writeMessage(server.writer, &message{Method: method, Params: rawParams})
+}
+
+//nolint:gocognit,gocyclo,nilnil // a flat dispatch table is easier to follow, and null is a valid result
+func (server *Server) handle(ctx context.Context, msg *message) (any, error) {
+	switch msg.Method {
+	case "initialize":
+		return &initializeResult{
+			Capabilities: serverCapabilities{
+				TextDocumentSync: textDocumentSyncFull,
+				CompletionProvider: completionOptions{
+					TriggerCharacters: []string{"\"", "'"},
+				},
+				HoverProvider:      true,
+				DefinitionProvider: true,
+			},
+			ServerInfo: serverInfo{
+				Name:    "cirrus",
+				Version: version.FullVersion,
+			},
+		}, nil
+	case "shutdown":
+		return nil, nil
+	case "exit":
+		return nil, errExit
+	case "textDocument/didOpen":
+		var params didOpenTextDocumentParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+
+		server.documents[params.TextDocument.URI] = params.TextDocument.Text
+		server.scheduleDiagnostics(ctx, params.TextDocument.URI)
+
+		return nil, nil
+	case "textDocument/didChange":
+		var params didChangeTextDocumentParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+
+		// We only support full document synchronization, so the last change contains the whole document
+		if len(params.ContentChanges) == 0 {
+			return nil, nil
+		}
+		server.documents[params.TextDocument.URI] = params.ContentChanges[len(params.ContentChanges)-1].Text
+		server.scheduleDiagnostics(ctx, params.TextDocument.URI)
+
+		return nil, nil
+	case "textDocument/didClose":
+		var params didCloseTextDocumentParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+
+		delete(server.documents, params.TextDocument.URI)
+
+		server.writerLock.Lock()
+		defer server.writerLock.Unlock()
+
+		server.cancelDiagnosticsLocked(params.TextDocument.URI)
+
+		return nil, server.notifyLocked("textDocument/publishDiagnostics", &publishDiagnosticsParams{
+			URI:         params.TextDocument.URI,
+			Diagnostics: []diagnostic{},
+		})
+	case "textDocument/completion", "textDocument/hover", "textDocument/definition":
+		var params textDocumentPositionParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+
+		text, ok := server.documents[params.TextDocument.URI]
+		if !ok {
+			return nil, nil
+		}
+
+		return server.handlePosition(msg.Method, params, text), nil
+	default:
+		if msg.isRequest() {
+			return nil, &responseError{Code: codeMethodNotFound, Message: "method not found: " + msg.Method}
+		}
+
+		// Notifications that we don't support (e.g. "initialized" or "$/cancelRequest") are safe to ignore
+		return nil, nil
+	}
+}
+
+func (server *Server) handlePosition(method string, params textDocumentPositionParams, text string) any {
+	isStarlark := isStarlarkURI(params.TextDocument.URI)
+	lines := splitLines(text)
+	pos := fromClientPosition(lines, params.Position)
+
+	switch method {
+	case "textDocument/completion":
+		if isStarlark {
+			return server.starlarkCompletion(text, pos)
+		}
+
+		return server.yamlCompletion(text, pos)
+	case "textDocument/hover":
+		if isStarlark {
+			return nil
+		}
+
+		result := server.yamlHover(text, pos)
+		if result != nil && result.Range != nil {
+			clientRange := toClientRange(lines, *result.Range)
+			result.Range = &clientRange
+		}
+
+		return result
+	default:
+		if isStarlark {
+			return nil
+		}
+
+		result := yamlDefinition(params.TextDocument.URI, text, pos)
+		for i := range result {
+			result[i].Range = toClientRange(lines, result[i].Range)
+		}
+
+		return result
+	}
+}
+
+// scheduleDiagnostics evaluates the document in the background and publishes
+// its diagnostics, superseding the evaluation scheduled for the previous version
+// of the document.
+func (server *Server) scheduleDiagnostics(ctx context.Context, uri string) {
+	text := server.documents[uri]
+
+	evaluationCtx, cancel := context.WithCancel(ctx)
+
+	server.writerLock.Lock()
+	server.cancelDiagnosticsLocked(uri)
+	server.evaluations[uri] = cancel
+	server.writerLock.Unlock()
+
+	server.evaluationsWG.Add(1)
+
+	go func() {
+		defer server.evaluationsWG.Done()
+
+		select {
+		case <-evaluationCtx.Done():
+			return
+		case <-time.After(server.diagnosticsDelay):
+		}
+
+		diagnostics := server.diagnostics(evaluationCtx, uri, text)
+
+		server.writerLock.Lock()
+		defer server.writerLock.Unlock()
+
+		// Don't publish the diagnostics for the outdated version of the document
+		if evaluationCtx.Err() != nil {
+			return
+		}
+
+		if err := server.notifyLocked("textDocument/publishDiagnostics", &publishDiagnosticsParams{
+			URI:         uri,
+			Diagnostics: diagnostics,
+		}); err != nil {
+			slog.Warn("failed to publish diagnostics", "uri", uri, "err", err)
+		}
+	}()
+}
+
+func (server *Server) cancelDiagnosticsLocked(uri string) {
+	if cancel, ok := server.evaluations[uri]; ok {
+		cancel()
+		delete(server.evaluations, uri)
+	}
+}
+
+func (server *Server) diagnostics(ctx context.Context, uri string, text string) []diagnostic {
+	ctx, cancel := context.WithTimeout(ctx, diagnosticsTimeout)
+	defer cancel()
+
+	var diagnostics []diagnostic
+	if isStarlarkURI(uri) {
+		diagnostics = server.starlarkDiagnostics(ctx, uri, text)
+	} else {
+		diagnostics = server.yamlDiagnostics(ctx, uri, text)
+	}
+
+	lines := splitLines(text)
+	for i := range diagnostics {
+		diagnostics[i].Range = toClientRange(lines, diagnostics[i].Range)
+	}
+
+	if diagnostics == nil {
+		diagnostics = []diagnostic{}
+	}
+
+	return diagnostics
+}
+
+func (server *Server) parserOptions() []parser.Option {
+	opts := []parser.Option{
+		parser.WithEnvironment(server.environment),
+		parser.WithLint(),
+	}
+
+	if server.additionalInstances != nil {
+		opts = append(opts, parser.WithAdditionalInstances(server.additionalInstances))
+	} else {
+		opts = append(opts, parser.WithMissingInstancesAllowed())
+	}
+
+	return opts
+}
+
+func unmarshalParams(msg *message, params any) error {
+	if err := json.Unmarshal(msg.Params, params); err != nil {
+		return &responseError{Code: codeInvalidParams, Message: fmt.Sprintf("invalid params: %v", err)}
+	}
+
+	return nil
+}
+
+func isStarlarkURI(uri string) bool {
+	return strings.HasSuffix(uri, ".star")
+}
+
+// uriDir returns the local directory containing the document,
+// falling back to the current directory for non-file URIs.
+func uriDir(uri string) string {
+	parsedURI, err := url.Parse(uri)
+	if err != nil || parsedURI.Scheme != "file" {
+		return "."
+	}
+
+	return filepath.Dir(filepath.FromSlash(parsedURI.Path))
+}
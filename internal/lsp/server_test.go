@@ -0,0 +1,292 @@
+//nolint:testpackage // we need to decode the unexported protocol structures
+package lsp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const yamlURI = "file:///project/.cirrus.yml"
+
+const yamlConfig = `container:
+  image: debian:latest
+
+base: &base
+  script: make
+
+build_task:
+  <<: *base
+
+test_task:
+  name: Tests
+  depends_on: build
+  cont
+`
+
+type client struct {
+	t      *testing.T
+	writer io.Writer
+	reader *bufio.Reader
+	nextID int
+}
+
+func newClient(t *testing.T) *client {
+	server, err := New()
+	require.NoError(t, err)
+
+	// Evaluate the documents as soon as they're opened
+	server.diagnosticsDelay = 0
+
+	clientReader, serverWriter := io.Pipe()
+	serverReader, clientWriter := io.Pipe()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.Serve(context.Background(), serverReader, serverWriter)
+		_ = serverWriter.Close()
+	}()
+
+	t.Cleanup(func() {
+		_ = clientWriter.Close()
+		require.NoError(t, <-errCh)
+	})
+
+	c := &client{t: t, writer: clientWriter, reader: bufio.NewReader(clientReader)}
+	c.request("initialize", map[string]any{}, nil)
+
+	return c
+}
+
+func (c *client) send(msg *message) {
+	require.NoError(c.t, writeMessage(c.writer, msg))
+}
+
+func (c *client) notify(method string, params any) {
+	rawParams, err := json.Marshal(params)
+	require.NoError(c.t, err)
+
+	c.send(&message{Method: method, Params: rawParams})
+}
+
+func (c *client) request(method string, params any, result any) {
+	c.nextID++
+	id := json.RawMessage(strconv.Itoa(c.nextID))
+
+	rawParams, err := json.Marshal(params)
+	require.NoError(c.t, err)
+
+	c.send(&message{ID: &id, Method: method, Params: rawParams})
+
+	response := c.receive(func(msg *message) bool {
+		return msg.ID != nil && string(*msg.ID) == string(id)
+	})
+	require.Nil(c.t, response.Error)
+
+	if result != nil {
+		require.NoError(c.t, json.Unmarshal(response.Result, result))
+	}
+}
+
+func (c *client) receive(matches func(msg *message) bool) *message {
+	for {
+		msg, err := readMessage(c.reader)
+		require.NoError(c.t, err)
+
+		if matches(msg) {
+			return msg
+		}
+	}
+}
+
+func (c *client) open(uri string, text string) []diagnostic {
+	c.notify("textDocument/didOpen", &didOpenTextDocumentParams{
+		TextDocument: textDocumentItem{URI: uri, Text: text},
+	})
+
+	return c.diagnostics(uri)
+}
+
+func (c *client) diagnostics(uri string) []diagnostic {
+	msg := c.receive(func(msg *message) bool {
+		return msg.Method == "textDocument/publishDiagnostics"
+	})
+
+	var params publishDiagnosticsParams
+	require.NoError(c.t, json.Unmarshal(msg.Params, &params))
+	require.Equal(c.t, uri, params.URI)
+
+	return params.Diagnostics
+}
+
+func (c *client) positionRequest(method string, uri string, line int, character int, result any) {
+	c.request(method, &textDocumentPositionParams{
+		TextDocument: textDocumentIdentifier{URI: uri},
+		Position:     position{Line: line, Character: character},
+	}, result)
+}
+
+func labels(items []completionItem) []string {
+	var result []string
+
+	for _, item := range items {
+		result = append(result, item.Label)
+	}
+
+	return result
+}
+
+func TestYAMLDiagnostics(t *testing.T) {
+	c := newClient(t)
+
+	diagnostics := c.open(yamlURI, "task:\n  container:\n    image: debian:latest\n  script:\n    A: B\n")
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, severityError, diagnostics[0].Severity)
+	assert.Equal(t, lspRange{Start: position{Line: 3, Character: 2}, End: position{Line: 3, Character: 8}},
+		diagnostics[0].Range)
+
+	diagnostics = c.open(yamlURI, yamlConfig[:len(yamlConfig)-len("  cont\n")])
+	assert.Empty(t, diagnostics)
+}
+
+func TestYAMLDiagnosticsSkipIncludedFiles(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "ci"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ci", "test.yml"),
+		[]byte("test_task:\n  container:\n    image: debian:latest\n  script: true\n  artifacts: \"*.log\"\n"), 0600))
+
+	c := newClient(t)
+
+	// Only the issue in the edited document is reported, the one in the included file
+	// has a position in a different file
+	diagnostics := c.open("file://"+filepath.ToSlash(filepath.Join(dir, ".cirrus.yml")),
+		"include: ci/test.yml\n\ntask_build:\n  container:\n    image: debian:latest\n  script: true\n")
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, "you've probably meant build_task", diagnostics[0].Message)
+	assert.Equal(t, 2, diagnostics[0].Range.Start.Line)
+}
+
+func TestYAMLCompletion(t *testing.T) {
+	c := newClient(t)
+	c.open(yamlURI, yamlConfig)
+
+	var items []completionItem
+	c.positionRequest("textDocument/completion", yamlURI, 12, 6, &items)
+	assert.Contains(t, labels(items), "container")
+	assert.Contains(t, labels(items), "only_if")
+	assert.Contains(t, labels(items), "script")
+
+	c.positionRequest("textDocument/completion", yamlURI, 11, 14, &items)
+	assert.Equal(t, []string{"Tests", "build", "test"}, labels(items))
+
+	c.positionRequest("textDocument/completion", yamlURI, 0, 0, &items)
+	assert.Contains(t, labels(items), "task")
+	assert.Contains(t, labels(items), "docker_builder")
+}
+
+func TestYAMLHover(t *testing.T) {
+	c := newClient(t)
+	c.open(yamlURI, yamlConfig)
+
+	var result hover
+	c.positionRequest("textDocument/hover", yamlURI, 1, 3, &result)
+	assert.Contains(t, result.Contents.Value, "**image**")
+	assert.Contains(t, result.Contents.Value, "Docker Image to use.")
+}
+
+func TestYAMLDefinition(t *testing.T) {
+	c := newClient(t)
+	c.open(yamlURI, yamlConfig)
+
+	var locations []location
+
+	// depends_on
+	c.positionRequest("textDocument/definition", yamlURI, 11, 16, &locations)
+	require.Len(t, locations, 1)
+	assert.Equal(t, lineLocation(yamlURI, 6, 0, 10), locations[0])
+
+	// YAML alias
+	c.positionRequest("textDocument/definition", yamlURI, 7, 8, &locations)
+	require.Len(t, locations, 1)
+	assert.Equal(t, lineLocation(yamlURI, 3, 6, 11), locations[0])
+}
+
+func TestStarlark(t *testing.T) {
+	const uri = "file:///project/.cirrus.star"
+
+	c := newClient(t)
+
+	diagnostics := c.open(uri, "def main():\n    return [\n")
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, severityError, diagnostics[0].Severity)
+
+	diagnostics = c.open(uri, "def main():\n    fail(\"oops\")\n")
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, 1, diagnostics[0].Range.Start.Line)
+	assert.Contains(t, diagnostics[0].Message, "oops")
+
+	diagnostics = c.open(uri, "load(\"cirrus\", \"env\")\n\ndef main():\n    return []\n")
+	assert.Empty(t, diagnostics)
+
+	var items []completionItem
+	c.positionRequest("textDocument/completion", uri, 0, 16, &items)
+	assert.Contains(t, labels(items), "env")
+	assert.Contains(t, labels(items), "fs")
+	assert.Contains(t, labels(items), "changes_include")
+}
+
+func TestYAMLDefinitionUTF16(t *testing.T) {
+	const config = "build_task:\n  script: make\n\ntest_task:\n  name: \"Тест\"\n  depends_on: [\"Тест\", build]\n"
+
+	c := newClient(t)
+	c.open(yamlURI, config)
+
+	var locations []location
+
+	// The positions are counted in UTF-16 code units, not in bytes
+	c.positionRequest("textDocument/definition", yamlURI, 5, 24, &locations)
+	require.Len(t, locations, 1)
+	assert.Equal(t, lineLocation(yamlURI, 0, 0, 10), locations[0])
+
+	c.positionRequest("textDocument/definition", yamlURI, 5, 18, &locations)
+	require.Len(t, locations, 1)
+	assert.Equal(t, lineLocation(yamlURI, 4, 9, 13), locations[0])
+}
+
+func TestStarlarkSlowEvaluationIsSuperseded(t *testing.T) {
+	const uri = "file:///project/.cirrus.star"
+
+	c := newClient(t)
+
+	// Start an evaluation that would otherwise run until the timeout
+	c.notify("textDocument/didOpen", &didOpenTextDocumentParams{
+		TextDocument: textDocumentItem{
+			URI:  uri,
+			Text: "def main():\n    for i in range(1000000000):\n        pass\n    fail(\"slow\")\n",
+		},
+	})
+
+	// Other requests are processed while the evaluation is running
+	var items []completionItem
+	c.positionRequest("textDocument/completion", uri, 0, 0, &items)
+
+	// Only the diagnostics for the latest version of the document are published
+	c.notify("textDocument/didChange", &didChangeTextDocumentParams{
+		TextDocument: textDocumentIdentifier{URI: uri},
+		ContentChanges: []textDocumentContentChangeEvent{
+			{Text: "def main():\n    fail(\"fast\")\n"},
+		},
+	})
+
+	diagnostics := c.diagnostics(uri)
+	require.Len(t, diagnostics, 1)
+	assert.Contains(t, diagnostics[0].Message, "fast")
+}
@@ -0,0 +1,139 @@
+package lsp
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/cirruslabs/cirrus-cli/pkg/larker"
+	"github.com/cirruslabs/cirrus-cli/pkg/larker/fs/dummy"
+	"github.com/cirruslabs/cirrus-cli/pkg/larker/fs/local"
+	"github.com/cirruslabs/cirrus-cli/pkg/larker/loader"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser"
+	"go.starlark.net/resolve"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"go.starlark.net/syntax"
+)
+
+const starlarkFilename = ".cirrus.star"
+
+// loadCirrusRegex matches an unterminated load() statement for the "cirrus" module.
+var loadCirrusRegex = regexp.MustCompile(`load\(\s*["']cirrus["']\s*,[^)]*$`)
+
+func (server *Server) starlarkDiagnostics(ctx context.Context, uri string, text string) []diagnostic {
+	lrk := larker.New(
+		larker.WithFileSystem(local.New(uriDir(uri))),
+		larker.WithEnvironment(server.environment),
+	)
+
+	result, err := lrk.MainOptional(ctx, text)
+	if err != nil {
+		return starlarkErrorDiagnostics(text, err)
+	}
+
+	if result.YAMLConfig == "" {
+		return nil
+	}
+
+	// The generated configuration has no relation to the lines of the Starlark file,
+	// so only report whether it's valid
+	opts := server.parserOptions()
+	opts = append(opts, parser.WithFileSystem(local.New(uriDir(uri))))
+
+	if _, err := parser.New(opts...).Parse(ctx, result.YAMLConfig); err != nil {
+		diag := errorDiagnostic("", err)
+		diag.Range = tokenRange(splitLines(text), 0, 0)
+		diag.Message = "main() returned an invalid configuration: " + diag.Message
+
+		return []diagnostic{diag}
+	}
+
+	return nil
+}
+
+func starlarkErrorDiagnostics(text string, err error) []diagnostic {
+	lines := splitLines(text)
+
+	newDiagnostic := func(pos syntax.Position, message string) diagnostic {
+		return diagnostic{
+			Range:    tokenRange(lines, int(pos.Line)-1, int(pos.Col)-1),
+			Severity: severityError,
+			Source:   "cirrus",
+			Message:  message,
+		}
+	}
+
+	var syntaxErr syntax.Error
+	if errors.As(err, &syntaxErr) {
+		return []diagnostic{newDiagnostic(syntaxErr.Pos, syntaxErr.Msg)}
+	}
+
+	var resolveErrs resolve.ErrorList
+	if errors.As(err, &resolveErrs) {
+		var result []diagnostic
+
+		for _, resolveErr := range resolveErrs {
+			result = append(result, newDiagnostic(resolveErr.Pos, resolveErr.Msg))
+		}
+
+		return result
+	}
+
+	// Point to the innermost frame of the .cirrus.star
+	// that led to the error, e.g. the call of a failing function
+	var evalErr *starlark.EvalError
+	if errors.As(err, &evalErr) {
+		for i := len(evalErr.CallStack) - 1; i >= 0; i-- {
+			if pos := evalErr.CallStack[i].Pos; pos.Filename() == starlarkFilename {
+				return []diagnostic{newDiagnostic(pos, evalErr.Msg)}
+			}
+		}
+	}
+
+	return []diagnostic{newDiagnostic(syntax.MakePosition(nil, 1, 1), err.Error())}
+}
+
+func (server *Server) starlarkCompletion(text string, pos position) []completionItem {
+	lines := splitLines(text)
+	if pos.Line < 0 || pos.Line >= len(lines) {
+		return []completionItem{}
+	}
+
+	// Consider the preceding lines too since the load() statement may span multiple lines
+	textBeforeCursor := strings.Join(lines[:pos.Line], "\n") + "\n" +
+		lines[pos.Line][:min(pos.Character, len(lines[pos.Line]))]
+
+	if !loadCirrusRegex.MatchString(textBeforeCursor) {
+		return []completionItem{}
+	}
+
+	members, err := loader.NewLoader(context.Background(), dummy.New(), nil, nil, false, nil).CirrusModule()
+	if err != nil {
+		return []completionItem{}
+	}
+
+	result := []completionItem{}
+
+	for _, name := range slices.Sorted(maps.Keys(members)) {
+		kind := completionItemKindValue
+
+		switch members[name].(type) {
+		case *starlarkstruct.Module:
+			kind = completionItemKindModule
+		case *starlark.Builtin:
+			kind = completionItemKindFunction
+		}
+
+		result = append(result, completionItem{
+			Label:  name,
+			Kind:   kind,
+			Detail: members[name].Type(),
+		})
+	}
+
+	return result
+}
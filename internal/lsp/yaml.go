@@ -0,0 +1,449 @@
+package lsp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
+	"github.com/cirruslabs/cirrus-cli/pkg/larker/fs/local"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/issue"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/parsererror"
+)
+
+// keyRegex matches a block mapping key, optionally prefixed with a sequence entry indicator.
+var keyRegex = regexp.MustCompile(`^( *)(- +)?([^\s#'"{}\[\],&*!|>%@` + "`" + `-][^:#]*?) *:(\s|$)`)
+
+// taskRegex matches the top-level keys that define tasks.
+var taskRegex = regexp.MustCompile(`^(?:(.*)_)?(task|docker_builder|pipe)$`)
+
+type yamlKey struct {
+	name   string
+	indent int
+}
+
+func (server *Server) yamlDiagnostics(ctx context.Context, uri string, text string) []diagnostic {
+	opts := server.parserOptions()
+	opts = append(opts, parser.WithFileSystem(local.New(uriDir(uri))))
+
+	result, err := parser.New(opts...).Parse(ctx, text)
+	if err != nil {
+		return []diagnostic{errorDiagnostic(text, err)}
+	}
+
+	lines := splitLines(text)
+
+	var diagnostics []diagnostic
+
+	for _, foundIssue := range result.Issues {
+		// Skip the issues found in the included files, their positions
+		// don't make sense in the edited document
+		if foundIssue.Path != issue.PathYAML {
+			continue
+		}
+
+		severity := severityInformation
+
+		switch foundIssue.Level {
+		case api.Issue_ERROR:
+			severity = severityError
+		case api.Issue_WARNING:
+			severity = severityWarning
+		}
+
+		diagnostics = append(diagnostics, diagnostic{
+			Range:    tokenRange(lines, int(foundIssue.Line)-1, int(foundIssue.Column)-1),
+			Severity: severity,
+			Code:     foundIssue.Rule,
+			Source:   "cirrus",
+			Message:  foundIssue.Message,
+		})
+	}
+
+	return diagnostics
+}
+
+// errorDiagnostic converts the parsing error to a diagnostic, pointing to the beginning
+// of the document when the error has no position or is in one of the included files.
+func errorDiagnostic(text string, err error) diagnostic {
+	lines := splitLines(text)
+
+	var rich *parsererror.Rich
+	if errors.As(err, &rich) && rich.Path() != "" {
+		// The error is in one of the included files
+		return diagnostic{
+			Range:    tokenRange(lines, 0, 0),
+			Severity: severityError,
+			Source:   "cirrus",
+			Message:  fmt.Sprintf("%s:%d:%d: %s", rich.Path(), rich.Line(), rich.Column(), rich.Message()),
+		}
+	}
+
+	if errors.As(err, &rich) {
+		return diagnostic{
+			Range:    tokenRange(lines, rich.Line()-1, rich.Column()-1),
+			Severity: severityError,
+			Source:   "cirrus",
+			Message:  rich.Message(),
+		}
+	}
+
+	return diagnostic{
+		Range:    tokenRange(lines, 0, 0),
+		Severity: severityError,
+		Source:   "cirrus",
+		Message:  err.Error(),
+	}
+}
+
+func (server *Server) yamlCompletion(text string, pos position) []completionItem {
+	lines := splitLines(text)
+	if pos.Line < 0 || pos.Line >= len(lines) {
+		return []completionItem{}
+	}
+
+	line := lines[pos.Line]
+	prefix := line[:min(pos.Character, len(line))]
+
+	// Complete the value if the cursor is past the key
+	if matches := keyRegex.FindStringSubmatch(prefix); matches != nil {
+		key := yamlKey{name: matches[3], indent: len(matches[1]) + len(matches[2])}
+
+		return server.yamlValueCompletion(lines, pos.Line, key)
+	}
+
+	// Complete the items of a depends_on list
+	if trimmed := strings.TrimLeft(prefix, " "); strings.HasPrefix(trimmed, "-") {
+		path := yamlPath(lines, pos.Line, len(prefix)-len(trimmed))
+		if len(path) != 0 && path[len(path)-1] == "depends_on" {
+			return taskNameCompletion(lines)
+		}
+	}
+
+	indent := len(prefix) - len(strings.TrimLeft(prefix, " -"))
+
+	node := server.schema.lookup(yamlPath(lines, pos.Line, indent))
+	if node == nil {
+		return []completionItem{}
+	}
+
+	keys := node.keys()
+
+	result := []completionItem{}
+
+	for _, name := range slices.Sorted(maps.Keys(keys)) {
+		result = append(result, completionItem{
+			Label:         name,
+			Kind:          completionItemKindProperty,
+			Detail:        keys[name].typeName(),
+			Documentation: markdown(keys[name].description()),
+		})
+	}
+
+	return result
+}
+
+func (server *Server) yamlValueCompletion(lines []string, line int, key yamlKey) []completionItem {
+	if key.name == "depends_on" {
+		return taskNameCompletion(lines)
+	}
+
+	node := server.schema.lookup(append(yamlPath(lines, line, key.indent), key.name))
+
+	values := node.enum()
+	if len(values) == 0 && node.typeName() == "boolean" {
+		values = []string{"true", "false"}
+	}
+
+	result := []completionItem{}
+
+	for _, value := range values {
+		result = append(result, completionItem{
+			Label: value,
+			Kind:  completionItemKindValue,
+		})
+	}
+
+	return result
+}
+
+func taskNameCompletion(lines []string) []completionItem {
+	definitions := taskDefinitions(lines)
+
+	result := []completionItem{}
+
+	for _, name := range slices.Sorted(maps.Keys(definitions)) {
+		result = append(result, completionItem{
+			Label:  name,
+			Kind:   completionItemKindValue,
+			Detail: "task",
+		})
+	}
+
+	return result
+}
+
+func (server *Server) yamlHover(text string, pos position) *hover {
+	lines := splitLines(text)
+	if pos.Line < 0 || pos.Line >= len(lines) {
+		return nil
+	}
+
+	matches := keyRegex.FindStringSubmatchIndex(lines[pos.Line])
+	if matches == nil {
+		return nil
+	}
+
+	keyStart, keyEnd := matches[6], matches[7]
+	if pos.Character < keyStart || pos.Character > keyEnd {
+		return nil
+	}
+
+	key := lines[pos.Line][keyStart:keyEnd]
+
+	node := server.schema.lookup(append(yamlPath(lines, pos.Line, keyStart), key))
+	if node == nil {
+		return nil
+	}
+
+	contents := fmt.Sprintf("**%s**", key)
+	if typeName := node.typeName(); typeName != "" {
+		contents += fmt.Sprintf(" `%s`", typeName)
+	}
+	if description := node.description(); description != "" {
+		contents += "\n\n" + description
+	}
+
+	return &hover{
+		Contents: markupContent{Kind: markupKindMarkdown, Value: contents},
+		Range: &lspRange{
+			Start: position{Line: pos.Line, Character: keyStart},
+			End:   position{Line: pos.Line, Character: keyEnd},
+		},
+	}
+}
+
+// yamlDefinition resolves the YAML aliases to their anchors
+// and the task names in depends_on to their definitions.
+func yamlDefinition(uri string, text string, pos position) []location {
+	lines := splitLines(text)
+	if pos.Line < 0 || pos.Line >= len(lines) {
+		return nil
+	}
+
+	word, _ := wordAt(lines[pos.Line], pos.Character)
+	if word == "" {
+		return nil
+	}
+
+	if alias, ok := strings.CutPrefix(word, "*"); ok {
+		anchorRegex := regexp.MustCompile(`&` + regexp.QuoteMeta(alias) + `(\s|$)`)
+
+		for i, line := range lines {
+			if loc := anchorRegex.FindStringIndex(line); loc != nil {
+				return []location{lineLocation(uri, i, loc[0], loc[0]+len(alias)+1)}
+			}
+		}
+
+		return nil
+	}
+
+	if !isDependsOn(lines, pos) {
+		return nil
+	}
+
+	definition, ok := taskDefinitions(lines)[word]
+	if !ok {
+		return nil
+	}
+
+	return []location{lineLocation(uri, definition.line, definition.start, definition.end)}
+}
+
+// isDependsOn checks whether the position points to
+// a value of the depends_on field.
+func isDependsOn(lines []string, pos position) bool {
+	line := lines[pos.Line]
+
+	if matches := keyRegex.FindStringSubmatchIndex(line); matches != nil {
+		return line[matches[6]:matches[7]] == "depends_on" && pos.Character > matches[7]
+	}
+
+	trimmed := strings.TrimLeft(line, " ")
+	if !strings.HasPrefix(trimmed, "-") {
+		return false
+	}
+
+	path := yamlPath(lines, pos.Line, len(line)-len(trimmed))
+
+	return len(path) != 0 && path[len(path)-1] == "depends_on"
+}
+
+type taskDefinition struct {
+	line  int
+	start int
+	end   int
+}
+
+// taskDefinitions finds the names and aliases of the tasks defined in the document,
+// either implicitly by the task's key (e.g. "build_task") or via the name and alias fields.
+func taskDefinitions(lines []string) map[string]taskDefinition {
+	result := map[string]taskDefinition{}
+
+	var inTask bool
+	var fieldIndent int
+
+	for i, line := range lines {
+		matches := keyRegex.FindStringSubmatchIndex(line)
+		if matches == nil {
+			continue
+		}
+
+		indent := matches[6]
+		key := line[matches[6]:matches[7]]
+
+		if indent == 0 {
+			taskMatches := taskRegex.FindStringSubmatch(key)
+			inTask = taskMatches != nil
+			fieldIndent = 0
+
+			if inTask && taskMatches[1] != "" {
+				result[taskMatches[1]] = taskDefinition{line: i, start: 0, end: len(key)}
+			}
+
+			continue
+		}
+
+		if !inTask {
+			continue
+		}
+
+		// Only consider the fields of the task itself and not of its nested instructions
+		if fieldIndent == 0 {
+			fieldIndent = indent
+		}
+		if indent != fieldIndent || (key != "name" && key != "alias") {
+			continue
+		}
+
+		rest := line[matches[7]+1:]
+		start := matches[7] + 1 + len(rest) - len(strings.TrimLeft(rest, " \"'"))
+		value := strings.Trim(strings.TrimSpace(rest), `"'`)
+
+		if value != "" {
+			result[value] = taskDefinition{line: i, start: start, end: start + len(value)}
+		}
+	}
+
+	return result
+}
+
+// yamlPath returns the keys of the mappings enclosing the given
+// line, assuming that its content starts at the given indentation.
+func yamlPath(lines []string, line int, indent int) []string {
+	var result []string
+
+	for i := line - 1; i >= 0 && indent > 0; i-- {
+		matches := keyRegex.FindStringSubmatch(lines[i])
+		if matches == nil {
+			continue
+		}
+
+		keyIndent := len(matches[1]) + len(matches[2])
+		if keyIndent >= indent {
+			continue
+		}
+
+		result = append(result, matches[3])
+		indent = keyIndent
+	}
+
+	slices.Reverse(result)
+
+	return result
+}
+
+// wordAt returns the word under the cursor and the position of its first character.
+func wordAt(line string, character int) (string, int) {
+	isDelimiter := func(c byte) bool {
+		return strings.IndexByte(" \t,[]{}\"':", c) != -1
+	}
+
+	character = min(max(character, 0), len(line))
+
+	start := character
+	for start > 0 && !isDelimiter(line[start-1]) {
+		start--
+	}
+
+	end := character
+	for end < len(line) && !isDelimiter(line[end]) {
+		end++
+	}
+
+	return line[start:end], start
+}
+
+// tokenRange returns the range spanning from the given position till the end
+// of the token under it, or till the end of the line if there's no token.
+//
+// The column is counted in code points, as reported by the parser and the Starlark interpreter.
+func tokenRange(lines []string, line int, column int) lspRange {
+	if line < 0 || line >= len(lines) {
+		line = 0
+		column = 0
+	}
+
+	var lineText string
+	if len(lines) != 0 {
+		lineText = lines[line]
+	}
+
+	character := runeToByteOffset(lineText, column)
+
+	end := character
+	for end < len(lineText) && lineText[end] != ' ' && lineText[end] != ':' {
+		end++
+	}
+	if end == character {
+		end = len(lineText)
+	}
+
+	return lspRange{
+		Start: position{Line: line, Character: character},
+		End:   position{Line: line, Character: end},
+	}
+}
+
+func lineLocation(uri string, line int, start int, end int) location {
+	return location{
+		URI: uri,
+		Range: lspRange{
+			Start: position{Line: line, Character: start},
+			End:   position{Line: line, Character: end},
+		},
+	}
+}
+
+func splitLines(text string) []string {
+	lines := strings.Split(text, "\n")
+
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+
+	return lines
+}
+
+func markdown(value string) *markupContent {
+	if value == "" {
+		return nil
+	}
+
+	return &markupContent{Kind: markupKindMarkdown, Value: value}
+}
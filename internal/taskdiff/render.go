@@ -0,0 +1,95 @@
+package taskdiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+var Formats = []string{FormatText, FormatJSON}
+
+// Write renders the diff in one of the Formats.
+func (diff *Diff) Write(writer io.Writer, format string) error {
+	switch format {
+	case FormatText:
+		return diff.WriteText(writer)
+	case FormatJSON:
+		return diff.WriteJSON(writer)
+	default:
+		return fmt.Errorf("%w: %q, supported formats are: %s", ErrUnsupportedFormat, format,
+			strings.Join(Formats, ", "))
+	}
+}
+
+// WriteText renders the diff for humans, with the multi-line
+// values (e.g. scripts) rendered line by line.
+func (diff *Diff) WriteText(writer io.Writer) error {
+	var sb strings.Builder
+
+	if diff.Empty() {
+		sb.WriteString("No task-level differences\n")
+	}
+
+	for _, task := range diff.Added {
+		fmt.Fprintf(&sb, "+ %s\n", task)
+	}
+
+	for _, task := range diff.Removed {
+		fmt.Fprintf(&sb, "- %s\n", task)
+	}
+
+	for _, taskChange := range diff.Changed {
+		fmt.Fprintf(&sb, "~ %s\n", taskChange.Task)
+
+		for _, change := range taskChange.Changes {
+			writeChange(&sb, change)
+		}
+	}
+
+	_, err := io.WriteString(writer, sb.String())
+
+	return err
+}
+
+func writeChange(sb *strings.Builder, change *Change) {
+	if !strings.Contains(change.Old, "\n") && !strings.Contains(change.New, "\n") {
+		switch change.Type {
+		case ChangeAdded:
+			fmt.Fprintf(sb, "    %s: added %q\n", change.Field, change.New)
+		case ChangeRemoved:
+			fmt.Fprintf(sb, "    %s: removed %q\n", change.Field, change.Old)
+		default:
+			fmt.Fprintf(sb, "    %s: %q -> %q\n", change.Field, change.Old, change.New)
+		}
+
+		return
+	}
+
+	fmt.Fprintf(sb, "    %s: %s\n", change.Field, change.Type)
+
+	if change.Old != "" {
+		for _, line := range strings.Split(change.Old, "\n") {
+			fmt.Fprintf(sb, "      - %s\n", line)
+		}
+	}
+
+	if change.New != "" {
+		for _, line := range strings.Split(change.New, "\n") {
+			fmt.Fprintf(sb, "      + %s\n", line)
+		}
+	}
+}
+
+// WriteJSON renders the diff for bots.
+func (diff *Diff) WriteJSON(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(diff)
+}
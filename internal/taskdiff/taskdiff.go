@@ -0,0 +1,369 @@
+// Package taskdiff compares the tasks evaluated from two versions of
+// the configuration, which is easier to review than the source changes.
+package taskdiff
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported diff format")
+
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeChanged = "changed"
+)
+
+// Properties that differ between the evaluations without any changes to the configuration.
+var ignoredProperties = []string{"indexWithinBuild"}
+
+type Diff struct {
+	Added   []string      `json:"added"`
+	Removed []string      `json:"removed"`
+	Changed []*TaskChange `json:"changed"`
+}
+
+type TaskChange struct {
+	Task    string    `json:"task"`
+	Changes []*Change `json:"changes"`
+}
+
+// Change describes a single field of a task, e.g. "image", "env.GOFLAGS" or "script main".
+type Change struct {
+	Field string `json:"field"`
+	Type  string `json:"type"`
+	Old   string `json:"old,omitempty"`
+	New   string `json:"new,omitempty"`
+}
+
+// New matches the old and new tasks by their name and unique labels and compares them.
+func New(oldTasks []*api.Task, newTasks []*api.Task) *Diff {
+	diff := &Diff{
+		Added:   []string{},
+		Removed: []string{},
+		Changed: []*TaskChange{},
+	}
+
+	oldByKey, oldNames := index(oldTasks)
+	newByKey, newNames := index(newTasks)
+
+	for _, key := range slices.Sorted(maps.Keys(oldByKey)) {
+		if _, ok := newByKey[key]; !ok {
+			diff.Removed = append(diff.Removed, key)
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(newByKey)) {
+		oldTask, ok := oldByKey[key]
+		if !ok {
+			diff.Added = append(diff.Added, key)
+
+			continue
+		}
+
+		changes := compareTasks(oldTask, oldNames, newByKey[key], newNames)
+		if len(changes) != 0 {
+			diff.Changed = append(diff.Changed, &TaskChange{Task: key, Changes: changes})
+		}
+	}
+
+	return diff
+}
+
+func (diff *Diff) Empty() bool {
+	return len(diff.Added) == 0 && len(diff.Removed) == 0 && len(diff.Changed) == 0
+}
+
+// index returns the tasks by their key and the task keys by their local group ID.
+func index(tasks []*api.Task) (map[string]*api.Task, map[int64]string) {
+	byKey := map[string]*api.Task{}
+	keys := map[int64]string{}
+
+	for _, task := range tasks {
+		key := task.Name
+
+		if labels := task.GetMetadata().GetUniqueLabels(); len(labels) != 0 {
+			key = fmt.Sprintf("%s (%s)", key, strings.Join(labels, ", "))
+		}
+
+		// Tasks that can't be told apart are matched in their order
+		base := key
+		for i := 2; byKey[key] != nil; i++ {
+			key = fmt.Sprintf("%s #%d", base, i)
+		}
+
+		byKey[key] = task
+		keys[task.LocalGroupId] = key
+	}
+
+	return byKey, keys
+}
+
+func compareTasks(
+	oldTask *api.Task,
+	oldNames map[int64]string,
+	newTask *api.Task,
+	newNames map[int64]string,
+) []*Change {
+	var changes []*Change
+
+	changes = append(changes, compareInstances(oldTask.Instance, newTask.Instance)...)
+	changes = append(changes, compareMaps("env.", oldTask.Environment, newTask.Environment)...)
+	changes = append(changes, compareCommands(oldTask.Commands, newTask.Commands)...)
+	changes = append(changes, compareValue("depends_on", dependencies(oldTask, oldNames),
+		dependencies(newTask, newNames))...)
+	changes = append(changes, compareValue("status", oldTask.Status.String(), newTask.Status.String())...)
+
+	oldProperties := maps.Clone(oldTask.GetMetadata().GetProperties())
+	newProperties := maps.Clone(newTask.GetMetadata().GetProperties())
+
+	for _, ignored := range ignoredProperties {
+		delete(oldProperties, ignored)
+		delete(newProperties, ignored)
+	}
+
+	changes = append(changes, compareMaps("", oldProperties, newProperties)...)
+
+	return changes
+}
+
+func compareInstances(oldInstance *anypb.Any, newInstance *anypb.Any) []*Change {
+	oldType, newType := instanceType(oldInstance), instanceType(newInstance)
+	if oldType != newType {
+		return compareValue("instance", oldType, newType)
+	}
+
+	oldFields, newFields := instanceFields(oldInstance), instanceFields(newInstance)
+	oldImage, newImage := oldFields["image"], newFields["image"]
+	delete(oldFields, "image")
+	delete(newFields, "image")
+
+	changes := compareValue("image", stringify(oldImage), stringify(newImage))
+
+	for _, field := range slices.Sorted(maps.Keys(mergedKeys(oldFields, newFields))) {
+		changes = append(changes, compareValue("instance."+field, stringify(oldFields[field]),
+			stringify(newFields[field]))...)
+	}
+
+	return changes
+}
+
+func instanceType(instance *anypb.Any) string {
+	if instance == nil {
+		return "none"
+	}
+
+	return instance.TypeUrl[strings.LastIndex(instance.TypeUrl, ".")+1:]
+}
+
+// instanceFields returns the instance's fields as they're represented in JSON.
+func instanceFields(instance *anypb.Any) map[string]any {
+	fields := map[string]any{}
+
+	if instance == nil {
+		return fields
+	}
+
+	message, err := anypb.UnmarshalNew(instance, proto.UnmarshalOptions{})
+	if err != nil {
+		return fields
+	}
+
+	return messageFields(message)
+}
+
+func compareCommands(oldCommands []*api.Command, newCommands []*api.Command) []*Change {
+	oldByName := map[string]*api.Command{}
+	for _, command := range oldCommands {
+		oldByName[command.Name] = command
+	}
+
+	newByName := map[string]*api.Command{}
+	for _, command := range newCommands {
+		newByName[command.Name] = command
+	}
+
+	var changes []*Change
+
+	for _, command := range oldCommands {
+		if _, ok := newByName[command.Name]; !ok {
+			kind, contents := describeCommand(command)
+			changes = append(changes, &Change{Field: kind + " " + command.Name, Type: ChangeRemoved, Old: contents})
+		}
+	}
+
+	for _, command := range newCommands {
+		newKind, newContents := describeCommand(command)
+
+		oldCommand, ok := oldByName[command.Name]
+		if !ok {
+			changes = append(changes, &Change{Field: newKind + " " + command.Name, Type: ChangeAdded, New: newContents})
+
+			continue
+		}
+
+		oldKind, oldContents := describeCommand(oldCommand)
+		if oldKind != newKind {
+			changes = append(changes, compareValue("instruction "+command.Name, oldKind, newKind)...)
+		}
+
+		changes = append(changes, compareValue(newKind+" "+command.Name, oldContents, newContents)...)
+	}
+
+	// The instructions are executed sequentially, so the order matters too
+	if len(changes) == 0 {
+		changes = compareValue("instructions", commandNames(oldCommands), commandNames(newCommands))
+	}
+
+	return changes
+}
+
+// describeCommand returns the instruction kind as it's named in the configuration
+// (e.g. "script" or "cache") and its contents in a human-readable form.
+func describeCommand(command *api.Command) (string, string) {
+	var kind string
+	var contents string
+
+	switch instruction := command.Instruction.(type) {
+	case *api.Command_ScriptInstruction:
+		kind, contents = "script", strings.Join(instruction.ScriptInstruction.Scripts, "\n")
+	case *api.Command_BackgroundScriptInstruction:
+		kind, contents = "background_script", strings.Join(instruction.BackgroundScriptInstruction.Scripts, "\n")
+	case *api.Command_CacheInstruction:
+		kind, contents = "cache", stringify(messageFields(instruction.CacheInstruction))
+	case *api.Command_UploadCacheInstruction:
+		kind, contents = "upload_caches", stringify(messageFields(instruction.UploadCacheInstruction))
+	case *api.Command_ArtifactsInstruction:
+		kind, contents = "artifacts", stringify(messageFields(instruction.ArtifactsInstruction))
+	case *api.Command_FileInstruction:
+		kind, contents = "file", stringify(messageFields(instruction.FileInstruction))
+	case *api.Command_CloneInstruction:
+		kind = "clone"
+	case *api.Command_ExitInstruction:
+		kind = "exit"
+	case *api.Command_WaitForTerminalInstruction:
+		kind = "wait_for_terminal"
+	default:
+		kind = "instruction"
+	}
+
+	if command.ExecutionBehaviour != api.Command_ON_SUCCESS {
+		contents += fmt.Sprintf(" (%s)", strings.ToLower(command.ExecutionBehaviour.String()))
+	}
+
+	return kind, contents
+}
+
+func messageFields(message proto.Message) map[string]any {
+	fields := map[string]any{}
+
+	jsonBytes, err := protojson.Marshal(message)
+	if err != nil {
+		return fields
+	}
+
+	_ = json.Unmarshal(jsonBytes, &fields)
+
+	return fields
+}
+
+func commandNames(commands []*api.Command) string {
+	var names []string
+
+	for _, command := range commands {
+		names = append(names, command.Name)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+func dependencies(task *api.Task, names map[int64]string) string {
+	var dependencies []string
+
+	for _, id := range task.RequiredGroups {
+		dependencies = append(dependencies, names[id])
+	}
+
+	slices.Sort(dependencies)
+
+	return strings.Join(dependencies, ", ")
+}
+
+func compareMaps(prefix string, oldMap map[string]string, newMap map[string]string) []*Change {
+	var changes []*Change
+
+	for _, key := range slices.Sorted(maps.Keys(mergedKeys(oldMap, newMap))) {
+		oldValue, oldOK := oldMap[key]
+		newValue, newOK := newMap[key]
+
+		switch {
+		case !oldOK:
+			changes = append(changes, &Change{Field: prefix + key, Type: ChangeAdded, New: newValue})
+		case !newOK:
+			changes = append(changes, &Change{Field: prefix + key, Type: ChangeRemoved, Old: oldValue})
+		case oldValue != newValue:
+			changes = append(changes, &Change{Field: prefix + key, Type: ChangeChanged, Old: oldValue, New: newValue})
+		}
+	}
+
+	return changes
+}
+
+func compareValue(field string, oldValue string, newValue string) []*Change {
+	switch {
+	case oldValue == newValue:
+		return nil
+	case oldValue == "":
+		return []*Change{{Field: field, Type: ChangeAdded, New: newValue}}
+	case newValue == "":
+		return []*Change{{Field: field, Type: ChangeRemoved, Old: oldValue}}
+	default:
+		return []*Change{{Field: field, Type: ChangeChanged, Old: oldValue, New: newValue}}
+	}
+}
+
+func mergedKeys[V any](a map[string]V, b map[string]V) map[string]struct{} {
+	result := map[string]struct{}{}
+
+	for key := range a {
+		result[key] = struct{}{}
+	}
+
+	for key := range b {
+		result[key] = struct{}{}
+	}
+
+	return result
+}
+
+// stringify renders the value in a stable form, with the scalars rendered as is.
+func stringify(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	case map[string]any:
+		if len(typed) == 0 {
+			return ""
+		}
+	}
+
+	// Unlike protojson, encoding/json sorts the keys and doesn't randomize the whitespace
+	jsonBytes, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(jsonBytes)
+}
@@ -0,0 +1,203 @@
+package taskdiff_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/taskdiff"
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func container(t *testing.T, image string, cpu float32) *anypb.Any {
+	instance, err := anypb.New(&api.ContainerInstance{Image: image, Cpu: cpu})
+	require.NoError(t, err)
+
+	return instance
+}
+
+func script(name string, scripts ...string) *api.Command {
+	return &api.Command{
+		Name: name,
+		Instruction: &api.Command_ScriptInstruction{
+			ScriptInstruction: &api.ScriptInstruction{Scripts: scripts},
+		},
+	}
+}
+
+func cache(name string, folder string) *api.Command {
+	return &api.Command{
+		Name: name,
+		Instruction: &api.Command_CacheInstruction{
+			CacheInstruction: &api.CacheInstruction{Folder: folder},
+		},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	oldTasks := []*api.Task{
+		{
+			LocalGroupId: 0,
+			Name:         "build",
+			Instance:     container(t, "golang:1.24", 2),
+			Commands:     []*api.Command{script("main", "go build ./...")},
+		},
+		{
+			LocalGroupId: 1,
+			Name:         "test",
+			Instance:     container(t, "golang:1.24", 2),
+			Environment:  map[string]string{"GOFLAGS": "-mod=readonly", "CGO_ENABLED": "0"},
+			Commands: []*api.Command{
+				cache("modules", "~/go/pkg/mod"),
+				script("main", "go test ./..."),
+			},
+			Metadata: &api.Task_Metadata{
+				UniqueLabels: []string{"os:linux"},
+				Properties:   map[string]string{"indexWithinBuild": "1", "timeout_in": "3600"},
+			},
+		},
+		{
+			LocalGroupId: 2,
+			Name:         "lint",
+			Instance:     container(t, "golang:1.24", 2),
+		},
+	}
+
+	newTasks := []*api.Task{
+		{
+			LocalGroupId: 0,
+			Name:         "build",
+			Instance:     container(t, "golang:1.24", 2),
+			Commands:     []*api.Command{script("main", "go build ./...")},
+		},
+		{
+			LocalGroupId:   1,
+			Name:           "test",
+			Instance:       container(t, "golang:1.25", 4),
+			Environment:    map[string]string{"GOFLAGS": "-mod=mod", "GOTOOLCHAIN": "local"},
+			RequiredGroups: []int64{0},
+			Commands: []*api.Command{
+				cache("modules", "/go/pkg/mod"),
+				script("main", "go test ./...", "go test -race ./..."),
+				script("coverage", "go tool cover -func=coverage.txt"),
+			},
+			Metadata: &api.Task_Metadata{
+				UniqueLabels: []string{"os:linux"},
+				Properties:   map[string]string{"indexWithinBuild": "2", "timeout_in": "7200"},
+			},
+		},
+		{
+			LocalGroupId: 2,
+			Name:         "release",
+		},
+	}
+
+	diff := taskdiff.New(oldTasks, newTasks)
+
+	assert.False(t, diff.Empty())
+	assert.Equal(t, []string{"release"}, diff.Added)
+	assert.Equal(t, []string{"lint"}, diff.Removed)
+	assert.Equal(t, []*taskdiff.TaskChange{
+		{
+			Task: "test (os:linux)",
+			Changes: []*taskdiff.Change{
+				{Field: "image", Type: taskdiff.ChangeChanged, Old: "golang:1.24", New: "golang:1.25"},
+				{Field: "instance.cpu", Type: taskdiff.ChangeChanged, Old: "2", New: "4"},
+				{Field: "env.CGO_ENABLED", Type: taskdiff.ChangeRemoved, Old: "0"},
+				{Field: "env.GOFLAGS", Type: taskdiff.ChangeChanged, Old: "-mod=readonly", New: "-mod=mod"},
+				{Field: "env.GOTOOLCHAIN", Type: taskdiff.ChangeAdded, New: "local"},
+				{Field: "cache modules", Type: taskdiff.ChangeChanged, Old: `{"folder":"~/go/pkg/mod"}`,
+					New: `{"folder":"/go/pkg/mod"}`},
+				{Field: "script main", Type: taskdiff.ChangeChanged, Old: "go test ./...",
+					New: "go test ./...\ngo test -race ./..."},
+				{Field: "script coverage", Type: taskdiff.ChangeAdded, New: "go tool cover -func=coverage.txt"},
+				{Field: "depends_on", Type: taskdiff.ChangeAdded, New: "build"},
+				{Field: "timeout_in", Type: taskdiff.ChangeChanged, Old: "3600", New: "7200"},
+			},
+		},
+	}, diff.Changed)
+}
+
+func TestDiffInstructionOrder(t *testing.T) {
+	oldTasks := []*api.Task{
+		{Name: "test", Commands: []*api.Command{script("first", "true"), script("second", "false")}},
+	}
+	newTasks := []*api.Task{
+		{Name: "test", Commands: []*api.Command{script("second", "false"), script("first", "true")}},
+	}
+
+	assert.Equal(t, []*taskdiff.TaskChange{
+		{
+			Task: "test",
+			Changes: []*taskdiff.Change{
+				{Field: "instructions", Type: taskdiff.ChangeChanged, Old: "first, second", New: "second, first"},
+			},
+		},
+	}, taskdiff.New(oldTasks, newTasks).Changed)
+}
+
+func TestDiffIdentical(t *testing.T) {
+	tasks := []*api.Task{
+		{Name: "test", Instance: container(t, "golang:1.25", 2), Commands: []*api.Command{script("main", "true")}},
+	}
+
+	diff := taskdiff.New(tasks, tasks)
+	assert.True(t, diff.Empty())
+
+	var buf bytes.Buffer
+	require.NoError(t, diff.Write(&buf, taskdiff.FormatText))
+	assert.Equal(t, "No task-level differences\n", buf.String())
+}
+
+func TestWrite(t *testing.T) {
+	diff := &taskdiff.Diff{
+		Added:   []string{"release"},
+		Removed: []string{"lint"},
+		Changed: []*taskdiff.TaskChange{
+			{
+				Task: "test",
+				Changes: []*taskdiff.Change{
+					{Field: "image", Type: taskdiff.ChangeChanged, Old: "golang:1.24", New: "golang:1.25"},
+					{Field: "env.GOTOOLCHAIN", Type: taskdiff.ChangeAdded, New: "local"},
+					{Field: "script main", Type: taskdiff.ChangeChanged, Old: "go test ./...",
+						New: "go test ./...\ngo test -race ./..."},
+				},
+			},
+		},
+	}
+
+	var text bytes.Buffer
+	require.NoError(t, diff.Write(&text, taskdiff.FormatText))
+	assert.Equal(t, `+ release
+- lint
+~ test
+    image: "golang:1.24" -> "golang:1.25"
+    env.GOTOOLCHAIN: added "local"
+    script main: changed
+      - go test ./...
+      + go test ./...
+      + go test -race ./...
+`, text.String())
+
+	var json bytes.Buffer
+	require.NoError(t, diff.Write(&json, taskdiff.FormatJSON))
+	assert.JSONEq(t, `{
+  "added": ["release"],
+  "removed": ["lint"],
+  "changed": [
+    {
+      "task": "test",
+      "changes": [
+        {"field": "image", "type": "changed", "old": "golang:1.24", "new": "golang:1.25"},
+        {"field": "env.GOTOOLCHAIN", "type": "added", "new": "local"},
+        {"field": "script main", "type": "changed", "old": "go test ./...",
+         "new": "go test ./...\ngo test -race ./..."}
+      ]
+    }
+  ]
+}`, json.String())
+
+	require.ErrorIs(t, diff.Write(&bytes.Buffer{}, "yaml"), taskdiff.ErrUnsupportedFormat)
+}
@@ -0,0 +1,163 @@
+package taskgraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	FormatDOT     = "dot"
+	FormatMermaid = "mermaid"
+	FormatJSON    = "json"
+)
+
+var Formats = []string{FormatDOT, FormatMermaid, FormatJSON}
+
+// Write renders the graph in one of the Formats.
+func (graph *Graph) Write(writer io.Writer, format string) error {
+	switch format {
+	case FormatDOT:
+		return graph.WriteDOT(writer)
+	case FormatMermaid:
+		return graph.WriteMermaid(writer)
+	case FormatJSON:
+		return graph.WriteJSON(writer)
+	default:
+		return fmt.Errorf("%w: %q, supported formats are: %s", ErrUnsupportedFormat, format,
+			strings.Join(Formats, ", "))
+	}
+}
+
+// WriteDOT renders the graph in the Graphviz[1] DOT language,
+// with the edges pointing from the dependencies to their dependents.
+//
+// [1]: https://graphviz.org/doc/info/lang.html
+func (graph *Graph) WriteDOT(writer io.Writer) error {
+	var sb strings.Builder
+
+	sb.WriteString("digraph tasks {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [shape=box];\n")
+
+	for _, node := range graph.Nodes {
+		attributes := []string{"label=" + dotQuote(strings.Join(node.labelLines(), "\n"))}
+
+		switch node.Status {
+		case StatusSkipped:
+			attributes = append(attributes, `style=dashed`, `color=gray`, `fontcolor=gray`)
+		case StatusManual:
+			attributes = append(attributes, `style=bold`)
+		}
+
+		if node.Service {
+			attributes = append(attributes, `shape=component`)
+		}
+
+		fmt.Fprintf(&sb, "  %d [%s];\n", node.ID, strings.Join(attributes, ", "))
+	}
+
+	for _, node := range graph.Nodes {
+		for _, dependency := range node.DependsOn {
+			fmt.Fprintf(&sb, "  %d -> %d;\n", dependency, node.ID)
+		}
+	}
+
+	sb.WriteString("}\n")
+
+	_, err := io.WriteString(writer, sb.String())
+
+	return err
+}
+
+// dotQuote turns the text into a DOT quoted string, which only needs the quotes and the backslashes
+// escaped, while the line breaks are replaced with the "\n" escape sequence that's centered by Graphviz.
+func dotQuote(text string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\r\n", `\n`, "\r", `\n`, "\n", `\n`)
+
+	return `"` + replacer.Replace(text) + `"`
+}
+
+// WriteMermaid renders the graph as a Mermaid[1] flowchart, which
+// GitHub and GitLab display natively in the Markdown documents.
+//
+// [1]: https://mermaid.js.org/syntax/flowchart.html
+func (graph *Graph) WriteMermaid(writer io.Writer) error {
+	var sb strings.Builder
+
+	sb.WriteString("flowchart LR\n")
+
+	classes := map[string][]string{}
+
+	for _, node := range graph.Nodes {
+		id := fmt.Sprintf("task%d", node.ID)
+
+		label := strings.ReplaceAll(strings.Join(node.labelLines(), "<br/>"), `"`, "#quot;")
+
+		if node.Service {
+			fmt.Fprintf(&sb, "  %s[[\"%s\"]]\n", id, label)
+		} else {
+			fmt.Fprintf(&sb, "  %s[\"%s\"]\n", id, label)
+		}
+
+		if node.Status != StatusTriggered {
+			classes[node.Status] = append(classes[node.Status], id)
+		}
+	}
+
+	for _, node := range graph.Nodes {
+		for _, dependency := range node.DependsOn {
+			fmt.Fprintf(&sb, "  task%d --> task%d\n", dependency, node.ID)
+		}
+	}
+
+	if ids := classes[StatusSkipped]; len(ids) != 0 {
+		sb.WriteString("  classDef skipped stroke-dasharray: 5 5,color:#999\n")
+		fmt.Fprintf(&sb, "  class %s skipped\n", strings.Join(ids, ","))
+	}
+
+	if ids := classes[StatusManual]; len(ids) != 0 {
+		sb.WriteString("  classDef manual stroke-width:3px\n")
+		fmt.Fprintf(&sb, "  class %s manual\n", strings.Join(ids, ","))
+	}
+
+	_, err := io.WriteString(writer, sb.String())
+
+	return err
+}
+
+func (graph *Graph) WriteJSON(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(graph)
+}
+
+func (node *Node) labelLines() []string {
+	lines := []string{node.Name}
+
+	instance := node.Instance
+	if node.Image != "" {
+		instance += ": " + node.Image
+	}
+	lines = append(lines, instance)
+
+	if node.Dockerfile != "" {
+		if node.Service {
+			lines = append(lines, "builds "+node.Dockerfile)
+		} else {
+			lines = append(lines, "from "+node.Dockerfile)
+		}
+	}
+
+	if len(node.Labels) != 0 {
+		lines = append(lines, strings.Join(node.Labels, " "))
+	}
+
+	if node.Status != StatusTriggered {
+		lines = append(lines, "("+node.Status+")")
+	}
+
+	return lines
+}
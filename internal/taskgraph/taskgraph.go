@@ -0,0 +1,146 @@
+// Package taskgraph describes the evaluated tasks and the dependencies
+// between them as a graph that can be rendered for review.
+package taskgraph
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported graph format")
+
+const (
+	StatusTriggered = "triggered"
+	StatusManual    = "manual"
+	StatusSkipped   = "skipped"
+)
+
+type Node struct {
+	ID         int64    `json:"id"`
+	Name       string   `json:"name"`
+	Alias      string   `json:"alias,omitempty"`
+	Labels     []string `json:"labels,omitempty"`
+	Instance   string   `json:"instance"`
+	Image      string   `json:"image,omitempty"`
+	Status     string   `json:"status"`
+	Service    bool     `json:"service,omitempty"`
+	Dockerfile string   `json:"dockerfile,omitempty"`
+	DependsOn  []int64  `json:"depends_on"`
+}
+
+type Graph struct {
+	Nodes []*Node `json:"nodes"`
+}
+
+// New builds a graph from the tasks returned by the parser,
+// including the service tasks that build the Dockerfile images.
+//
+// Dependencies on the tasks that are not passed (e.g. because
+// the tasks were filtered) are omitted, so that each edge
+// connects the nodes present in the graph.
+func New(tasks []*api.Task) *Graph {
+	graph := &Graph{}
+
+	ids := map[int64]struct{}{}
+	for _, task := range tasks {
+		ids[task.LocalGroupId] = struct{}{}
+	}
+
+	for _, task := range tasks {
+		node := &Node{
+			ID:        task.LocalGroupId,
+			Name:      task.Name,
+			Labels:    task.GetMetadata().GetUniqueLabels(),
+			Status:    status(task),
+			DependsOn: []int64{},
+		}
+
+		for _, dependency := range slices.Sorted(slices.Values(task.RequiredGroups)) {
+			if _, ok := ids[dependency]; ok {
+				node.DependsOn = append(node.DependsOn, dependency)
+			}
+		}
+
+		node.Alias = task.GetMetadata().GetProperties()["alias"]
+		node.Instance, node.Image, node.Dockerfile = describeInstance(task.Instance)
+
+		// Service tasks are the only ones that build the Dockerfile images
+		if _, ok := unmarshalInstance(task.Instance).(*api.PrebuiltImageInstance); ok {
+			node.Service = true
+		}
+
+		graph.Nodes = append(graph.Nodes, node)
+	}
+
+	slices.SortFunc(graph.Nodes, func(a, b *Node) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return graph
+}
+
+func status(task *api.Task) string {
+	if task.Status == api.Status_SKIPPED {
+		return StatusSkipped
+	}
+
+	if strings.EqualFold(task.GetMetadata().GetProperties()["trigger_type"], "MANUAL") {
+		return StatusManual
+	}
+
+	return StatusTriggered
+}
+
+func unmarshalInstance(instance *anypb.Any) proto.Message {
+	if instance == nil {
+		return nil
+	}
+
+	message, err := anypb.UnmarshalNew(instance, proto.UnmarshalOptions{})
+	if err != nil {
+		return nil
+	}
+
+	return message
+}
+
+// describeInstance returns the instance type as it's named in the configuration
+// along with the image it uses and the Dockerfile it's built from, if any.
+func describeInstance(instance *anypb.Any) (string, string, string) {
+	if instance == nil {
+		return "none", "", ""
+	}
+
+	switch typed := unmarshalInstance(instance).(type) {
+	case *api.ContainerInstance:
+		kind := "container"
+
+		switch {
+		case typed.Platform == api.Platform_WINDOWS:
+			kind = "windows_container"
+		case typed.Architecture == api.Architecture_ARM64:
+			kind = "arm_container"
+		}
+
+		return kind, typed.Image, typed.Dockerfile
+	case *api.PipeInstance:
+		return "pipe", "", ""
+	case *api.MacOSInstance:
+		return "macos_instance", typed.Image, ""
+	case *api.PersistentWorkerInstance:
+		return "persistent_worker", typed.GetIsolation().GetContainer().GetImage(), ""
+	case *api.PrebuiltImageInstance:
+		return "prebuilt_image", typed.Repository + ":" + typed.Reference, typed.Dockerfile
+	default:
+		// Additional instances (e.g. "gce_instance") whose type is only known to the Cirrus Cloud
+		typeName := instance.TypeUrl[strings.LastIndex(instance.TypeUrl, ".")+1:]
+
+		return strings.ToLower(typeName), "", ""
+	}
+}
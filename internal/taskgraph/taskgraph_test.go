@@ -0,0 +1,108 @@
+package taskgraph_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/taskgraph"
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func tasks(t *testing.T) []*api.Task {
+	containerInstance, err := anypb.New(&api.ContainerInstance{Image: "debian:latest"})
+	require.NoError(t, err)
+
+	macOSInstance, err := anypb.New(&api.MacOSInstance{Image: "macos-sequoia-base:latest"})
+	require.NoError(t, err)
+
+	return []*api.Task{
+		{
+			Name:           "Deploy",
+			LocalGroupId:   2,
+			RequiredGroups: []int64{1, 0},
+			Instance:       containerInstance,
+			Metadata: &api.Task_Metadata{
+				Properties: map[string]string{"trigger_type": "MANUAL"},
+			},
+		},
+		{
+			Name:         "Test",
+			LocalGroupId: 0,
+			Instance:     containerInstance,
+			Metadata: &api.Task_Metadata{
+				UniqueLabels: []string{"VERSION:1.22"},
+			},
+		},
+		{
+			Name:         "macOS",
+			LocalGroupId: 1,
+			Instance:     macOSInstance,
+			Status:       api.Status_SKIPPED,
+		},
+	}
+}
+
+func TestDOT(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, taskgraph.New(tasks(t)).Write(&buf, taskgraph.FormatDOT))
+
+	require.Equal(t, `digraph tasks {
+  rankdir=LR;
+  node [shape=box];
+  0 [label="Test\ncontainer: debian:latest\nVERSION:1.22"];
+  1 [label="macOS\nmacos_instance: macos-sequoia-base:latest\n(skipped)", style=dashed, color=gray, fontcolor=gray];
+  2 [label="Deploy\ncontainer: debian:latest\n(manual)", style=bold];
+  0 -> 2;
+  1 -> 2;
+}
+`, buf.String())
+}
+
+func TestDOTEscaping(t *testing.T) {
+	containerInstance, err := anypb.New(&api.ContainerInstance{Image: "debian:latest"})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, taskgraph.New([]*api.Task{
+		{Name: "Café \"déjà vu\"\tC:\\build\r\nnext", Instance: containerInstance},
+	}).Write(&buf, taskgraph.FormatDOT))
+
+	require.Contains(t, buf.String(), `0 [label="Café \"déjà vu\"`+"\t"+`C:\\build\nnext\ncontainer: debian:latest"];`)
+}
+
+func TestMermaid(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, taskgraph.New(tasks(t)).Write(&buf, taskgraph.FormatMermaid))
+
+	require.Equal(t, `flowchart LR
+  task0["Test<br/>container: debian:latest<br/>VERSION:1.22"]
+  task1["macOS<br/>macos_instance: macos-sequoia-base:latest<br/>(skipped)"]
+  task2["Deploy<br/>container: debian:latest<br/>(manual)"]
+  task0 --> task2
+  task1 --> task2
+  classDef skipped stroke-dasharray: 5 5,color:#999
+  class task1 skipped
+  classDef manual stroke-width:3px
+  class task2 manual
+`, buf.String())
+}
+
+func TestSubsetOmitsDanglingEdges(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, taskgraph.New(tasks(t)[:2]).Write(&buf, taskgraph.FormatDOT))
+
+	require.Equal(t, `digraph tasks {
+  rankdir=LR;
+  node [shape=box];
+  0 [label="Test\ncontainer: debian:latest\nVERSION:1.22"];
+  2 [label="Deploy\ncontainer: debian:latest\n(manual)", style=bold];
+  0 -> 2;
+}
+`, buf.String())
+}
+
+func TestUnsupportedFormat(t *testing.T) {
+	require.ErrorIs(t, taskgraph.New(nil).Write(&bytes.Buffer{}, "svg"), taskgraph.ErrUnsupportedFormat)
+}
@@ -0,0 +1,125 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/persistentworker/pwdir"
+	"github.com/cirruslabs/cirrus-cli/internal/worker/security"
+	"github.com/dustin/go-humanize"
+)
+
+var (
+	ErrDiskQuotaExceeded    = errors.New("task has exceeded the disk quota")
+	ErrDiskQuotaUnsupported = errors.New("disk quota cannot be enforced")
+)
+
+const defaultDiskQuotaCheckInterval = 30 * time.Second
+
+type DiskQuota struct {
+	// Maximum size of the task's working directory in a human-readable form (e.g. 50GB)
+	PerTask       string        `yaml:"per-task"`
+	CheckInterval time.Duration `yaml:"check-interval"`
+}
+
+// PerTaskBytes returns the maximum size of the task's working directory in bytes.
+func (quota *DiskQuota) PerTaskBytes() (uint64, error) {
+	perTaskBytes, err := humanize.ParseBytes(quota.PerTask)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse the per-task disk quota: %w", err)
+	}
+
+	return perTaskBytes, nil
+}
+
+// Validate ensures that the security settings don't explicitly allow the isolations whose
+// working directory is not located on the host, since these can't be checked against the quota.
+//
+// The tasks using such isolations while being allowed by the default security
+// settings are rejected by the worker once they are received.
+func (quota *DiskQuota) Validate(security *security.Security) error {
+	if _, err := quota.PerTaskBytes(); err != nil {
+		return err
+	}
+
+	allowedIsolations := security.AllowedIsolations
+	if allowedIsolations == nil {
+		return nil
+	}
+
+	var unsupported []string
+
+	if allowedIsolations.Parallels != nil {
+		unsupported = append(unsupported, "parallels")
+	}
+	if allowedIsolations.Tart != nil {
+		unsupported = append(unsupported, "tart")
+	}
+	if allowedIsolations.Vetu != nil {
+		unsupported = append(unsupported, "vetu")
+	}
+	if allowedIsolations.SSH != nil {
+		unsupported = append(unsupported, "ssh")
+	}
+
+	if len(unsupported) != 0 {
+		return fmt.Errorf("%w for %s isolation, only allow the \"none\" and \"container\" isolations "+
+			"in the \"security:\" settings to use it", ErrDiskQuotaUnsupported, strings.Join(unsupported, ", "))
+	}
+
+	return nil
+}
+
+func (quota *DiskQuota) checkInterval() time.Duration {
+	if quota.CheckInterval <= 0 {
+		return defaultDiskQuotaCheckInterval
+	}
+
+	return quota.CheckInterval
+}
+
+// enforceDiskQuota periodically scans the task's working directory
+// and cancels the task once it grows larger than the quota.
+func (worker *Worker) enforceDiskQuota(
+	ctx context.Context,
+	taskID string,
+	dir string,
+	quota DiskQuota,
+	cancel context.CancelCauseFunc,
+) {
+	limit, err := quota.PerTaskBytes()
+	if err != nil {
+		worker.logger.Errorf("not enforcing the disk quota for task %s: %v", taskID, err)
+
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(quota.checkInterval()):
+		}
+
+		usage, err := pwdir.Usage(dir)
+		if err != nil {
+			worker.logger.Warnf("failed to determine the disk usage of task %s: %v", taskID, err)
+
+			continue
+		}
+
+		if usage > limit {
+			err := fmt.Errorf("%w: working directory %s uses %s, while only %s is allowed",
+				ErrDiskQuotaExceeded, dir, humanize.IBytes(usage), humanize.IBytes(limit))
+
+			worker.logger.Warnf("canceling task %s: %v", taskID, err)
+
+			cancel(err)
+
+			return
+		}
+	}
+}
@@ -0,0 +1,50 @@
+//nolint:testpackage // we need to call the enforceDiskQuota(), which is private
+package worker
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	upstreampkg "github.com/cirruslabs/cirrus-cli/internal/worker/upstream"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnforceDiskQuota(t *testing.T) {
+	upstream, err := upstreampkg.New("test", "token")
+	require.NoError(t, err)
+
+	worker, err := New(WithUpstream(upstream))
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "small"), make([]byte, 512), 0600))
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+
+	quota := DiskQuota{PerTask: "1KB", CheckInterval: 10 * time.Millisecond}
+
+	done := make(chan struct{})
+
+	go func() {
+		worker.enforceDiskQuota(ctx, "42", dir, quota, cancel)
+		close(done)
+	}()
+
+	// The task is not canceled while it stays within the quota
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, ctx.Err())
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "large"), make([]byte, 1024), 0600))
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("task was not canceled after exceeding the disk quota")
+	}
+
+	require.ErrorIs(t, context.Cause(ctx), ErrDiskQuotaExceeded)
+}
@@ -0,0 +1,162 @@
+package worker
+
+import (
+	"maps"
+	"math"
+	"slices"
+
+	upstreampkg "github.com/cirruslabs/cirrus-cli/internal/worker/upstream"
+)
+
+// entitlementTolerance prevents the floating-point errors from rounding the entitlement up by a whole unit.
+const entitlementTolerance = 1e-9
+
+// upstreamsInFairOrder returns the upstreams sorted by their weighted dominant resource share,
+// so that the upstream that is the furthest behind its fair share gets to poll first and thus
+// gets the first pick of the free resources.
+func (worker *Worker) upstreamsInFairOrder() []*upstreampkg.Upstream {
+	shares := map[*upstreampkg.Upstream]float64{}
+
+	for _, upstream := range worker.upstreams {
+		shares[upstream] = worker.dominantShare(upstream) / upstream.Weight()
+	}
+
+	result := slices.Clone(worker.upstreams)
+
+	slices.SortStableFunc(result, func(a, b *upstreampkg.Upstream) int {
+		switch {
+		case shares[a] < shares[b]:
+			return -1
+		case shares[a] > shares[b]:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return result
+}
+
+// dominantShare returns the largest fraction of any resource used by the upstream's tasks,
+// falling back to the number of the running tasks when no resources are configured.
+func (worker *Worker) dominantShare(upstream *upstreampkg.Upstream) float64 {
+	resources := worker.resources()
+	if len(resources) == 0 {
+		return float64(len(worker.runningTasks(upstream)))
+	}
+
+	var result float64
+
+	for key, value := range worker.resourcesInUseBy(upstream) {
+		total := resources[key]
+		if total <= 0 {
+			continue
+		}
+
+		result = math.Max(result, value/total)
+	}
+
+	return result
+}
+
+func (worker *Worker) resourcesInUseBy(upstream *upstreampkg.Upstream) map[string]float64 {
+	result := map[string]float64{}
+
+	worker.tasks.Range(func(taskID string, task *Task) bool {
+		if task.upstream != upstream {
+			return true
+		}
+
+		for key, value := range task.resourcesToUse {
+			result[key] += value
+		}
+
+		return true
+	})
+
+	return result
+}
+
+// upstreamCapacity returns the total and used resources to advertise to the upstream.
+//
+// Without a quota, the upstream sees the worker's full capacity, otherwise it sees at most
+// the quota as its total. In both cases, the upstream can only use the part of the resources
+// that is still free on the worker, and that doesn't exceed its quota and its weighted share
+// of the resources (see entitlement()) when taking into account its own tasks.
+func (worker *Worker) upstreamCapacity(upstream *upstreampkg.Upstream) (map[string]float64, map[string]float64) {
+	resources := worker.resources()
+	quota := upstream.ResourceQuota()
+
+	total := maps.Clone(resources)
+	inUse := map[string]float64{}
+
+	resourcesNotInUse := worker.resourcesNotInUse()
+	resourcesInUseByUpstream := worker.resourcesInUseBy(upstream)
+
+	for key, value := range resources {
+		// Round the entitlement up to not starve the upstreams whose share
+		// is less than the amount of resources required by a single task
+		entitlement := math.Ceil(worker.entitlement(upstream, key, value) - entitlementTolerance)
+
+		free := math.Min(resourcesNotInUse[key], entitlement-resourcesInUseByUpstream[key])
+
+		if quotaValue, ok := quota[key]; ok {
+			total[key] = math.Min(value, quotaValue)
+			free = math.Min(free, quotaValue-resourcesInUseByUpstream[key])
+		}
+
+		inUse[key] = total[key] - math.Max(free, 0)
+	}
+
+	return total, inUse
+}
+
+// updateIdleness marks the upstream as idle if it had no tasks to start and has no tasks running,
+// so that the other upstreams can use its share of the resources until it gets new tasks.
+func (worker *Worker) updateIdleness(upstream *upstreampkg.Upstream, tasksToStart int) {
+	worker.idleUpstreams[upstream] = tasksToStart == 0 && len(worker.runningTasks(upstream)) == 0
+}
+
+// entitlement returns the amount of the resource that the upstream is entitled to.
+//
+// The resource is split between the upstream and the other upstreams that are not idle
+// proportionally to their weights, with the part of the share that exceeds an upstream's
+// quota being split between the rest of the upstreams.
+func (worker *Worker) entitlement(upstream *upstreampkg.Upstream, key string, amount float64) float64 {
+	competing := slices.DeleteFunc(slices.Clone(worker.upstreams), func(other *upstreampkg.Upstream) bool {
+		return other != upstream && worker.idleUpstreams[other]
+	})
+
+	for {
+		var totalWeight float64
+
+		for _, competitor := range competing {
+			totalWeight += competitor.Weight()
+		}
+
+		// Upstreams whose quota is less than their share get exactly their quota
+		capped := map[*upstreampkg.Upstream]float64{}
+
+		for _, competitor := range competing {
+			quotaValue, ok := competitor.ResourceQuota()[key]
+			if ok && quotaValue < amount*competitor.Weight()/totalWeight {
+				capped[competitor] = quotaValue
+			}
+		}
+
+		if len(capped) == 0 {
+			return amount * upstream.Weight() / totalWeight
+		}
+
+		if quotaValue, ok := capped[upstream]; ok {
+			return quotaValue
+		}
+
+		for competitor, quotaValue := range capped {
+			amount -= quotaValue
+			competing = slices.DeleteFunc(competing, func(other *upstreampkg.Upstream) bool {
+				return other == competitor
+			})
+		}
+	}
+}
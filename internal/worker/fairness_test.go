@@ -0,0 +1,204 @@
+//nolint:testpackage // we need to inspect the worker's internal state
+package worker
+
+import (
+	"strconv"
+	"testing"
+
+	upstreampkg "github.com/cirruslabs/cirrus-cli/internal/worker/upstream"
+	"github.com/stretchr/testify/require"
+)
+
+func newFairnessTestWorker(
+	t *testing.T,
+	resources map[string]float64,
+) (*Worker, *upstreampkg.Upstream, *upstreampkg.Upstream) {
+	heavy, err := upstreampkg.New("test", "heavy token", upstreampkg.WithWeight(3),
+		upstreampkg.WithResourceQuota(map[string]float64{"tart-vms": 1}))
+	require.NoError(t, err)
+
+	light, err := upstreampkg.New("test", "light token")
+	require.NoError(t, err)
+
+	worker, err := New(WithUpstream(light), WithUpstream(heavy), WithResources(resources))
+	require.NoError(t, err)
+
+	return worker, heavy, light
+}
+
+func TestUpstreamCapacityWithoutQuota(t *testing.T) {
+	worker, heavy, light := newFairnessTestWorker(t, map[string]float64{"tart-vms": 2})
+
+	worker.tasks.Store("1", &Task{upstream: heavy, resourcesToUse: map[string]float64{"tart-vms": 1}})
+
+	total, inUse := worker.upstreamCapacity(light)
+	require.Equal(t, map[string]float64{"tart-vms": 2}, total)
+	require.Equal(t, map[string]float64{"tart-vms": 1}, inUse)
+}
+
+func TestUpstreamCapacityWithQuota(t *testing.T) {
+	worker, heavy, light := newFairnessTestWorker(t, map[string]float64{"tart-vms": 2})
+
+	// Heavy upstream is limited to a single VM even though two are available
+	total, inUse := worker.upstreamCapacity(heavy)
+	require.Equal(t, map[string]float64{"tart-vms": 1}, total)
+	require.Equal(t, map[string]float64{"tart-vms": 0}, inUse)
+
+	// Heavy upstream has exhausted its quota
+	worker.tasks.Store("1", &Task{upstream: heavy, resourcesToUse: map[string]float64{"tart-vms": 1}})
+
+	_, inUse = worker.upstreamCapacity(heavy)
+	require.Equal(t, map[string]float64{"tart-vms": 1}, inUse)
+
+	// Heavy upstream has quota left, but the worker has no free VMs
+	worker.tasks.Delete("1")
+	worker.tasks.Store("2", &Task{upstream: light, resourcesToUse: map[string]float64{"tart-vms": 2}})
+
+	_, inUse = worker.upstreamCapacity(heavy)
+	require.Equal(t, map[string]float64{"tart-vms": 1}, inUse)
+}
+
+func TestUpstreamsInFairOrder(t *testing.T) {
+	worker, heavy, light := newFairnessTestWorker(t, map[string]float64{"tart-vms": 4})
+
+	// Idle upstreams are polled in the configuration order
+	require.Equal(t, []*upstreampkg.Upstream{light, heavy}, worker.upstreamsInFairOrder())
+
+	// An upstream that uses more than its weighted share is polled last
+	worker.tasks.Store("1", &Task{upstream: light, resourcesToUse: map[string]float64{"tart-vms": 1}})
+	require.Equal(t, []*upstreampkg.Upstream{heavy, light}, worker.upstreamsInFairOrder())
+
+	// Heavy upstream has 3x weight, so using 2 VMs (0.5 / 3) is still fairer than 1 VM (0.25 / 1)
+	worker.tasks.Store("2", &Task{upstream: heavy, resourcesToUse: map[string]float64{"tart-vms": 2}})
+	require.Equal(t, []*upstreampkg.Upstream{heavy, light}, worker.upstreamsInFairOrder())
+}
+
+// schedulingSimulation mimics the upstreams' schedulers that assign as many tasks
+// requiring a single VM as fit into the capacity advertised by the worker.
+type schedulingSimulation struct {
+	worker   *Worker
+	lastID   int
+	started  map[*upstreampkg.Upstream]int
+	demanded map[*upstreampkg.Upstream]bool
+}
+
+func newSchedulingSimulation(worker *Worker) *schedulingSimulation {
+	simulation := &schedulingSimulation{
+		worker:   worker,
+		started:  map[*upstreampkg.Upstream]int{},
+		demanded: map[*upstreampkg.Upstream]bool{},
+	}
+
+	for _, upstream := range worker.upstreams {
+		simulation.demanded[upstream] = true
+	}
+
+	return simulation
+}
+
+func (simulation *schedulingSimulation) poll() {
+	for _, upstream := range simulation.worker.upstreamsInFairOrder() {
+		var tasksToStart int
+
+		if simulation.demanded[upstream] {
+			total, inUse := simulation.worker.upstreamCapacity(upstream)
+			tasksToStart = int(total["tart-vms"] - inUse["tart-vms"])
+		}
+
+		simulation.worker.updateIdleness(upstream, tasksToStart)
+
+		for range tasksToStart {
+			simulation.lastID++
+			simulation.worker.tasks.Store(strconv.Itoa(simulation.lastID), &Task{
+				upstream:       upstream,
+				resourcesToUse: map[string]float64{"tart-vms": 1},
+			})
+			simulation.started[upstream]++
+		}
+	}
+}
+
+// completeOldest completes the task that was started first.
+func (simulation *schedulingSimulation) completeOldest() {
+	for id := 1; id <= simulation.lastID; id++ {
+		if _, ok := simulation.worker.tasks.LoadAndDelete(strconv.Itoa(id)); ok {
+			return
+		}
+	}
+}
+
+func (simulation *schedulingSimulation) running(upstream *upstreampkg.Upstream) int {
+	return len(simulation.worker.runningTasks(upstream))
+}
+
+func newWeightedTestWorker(t *testing.T, heavyOpts ...upstreampkg.Option) (
+	*Worker, *upstreampkg.Upstream, *upstreampkg.Upstream,
+) {
+	heavy, err := upstreampkg.New("test", "heavy token", append(heavyOpts, upstreampkg.WithWeight(3))...)
+	require.NoError(t, err)
+
+	light, err := upstreampkg.New("test", "light token")
+	require.NoError(t, err)
+
+	worker, err := New(WithUpstream(light), WithUpstream(heavy),
+		WithResources(map[string]float64{"tart-vms": 4}))
+	require.NoError(t, err)
+
+	return worker, heavy, light
+}
+
+func TestWeightedAdmissionDistribution(t *testing.T) {
+	worker, heavy, light := newWeightedTestWorker(t)
+	simulation := newSchedulingSimulation(worker)
+
+	// Busy upstreams share the VMs according to their weights
+	simulation.poll()
+	require.Equal(t, 3, simulation.running(heavy))
+	require.Equal(t, 1, simulation.running(light))
+
+	// ...and keep getting the tasks in the same proportion
+	for range 1000 {
+		simulation.completeOldest()
+		simulation.poll()
+
+		require.Equal(t, 3, simulation.running(heavy))
+		require.Equal(t, 1, simulation.running(light))
+	}
+
+	require.InDelta(t, 0.75, float64(simulation.started[heavy])/
+		float64(simulation.started[heavy]+simulation.started[light]), 0.01)
+}
+
+func TestWeightedAdmissionIdleUpstream(t *testing.T) {
+	worker, heavy, light := newWeightedTestWorker(t)
+	simulation := newSchedulingSimulation(worker)
+
+	// Light upstream has no tasks, so the heavy upstream can use its share
+	simulation.demanded[light] = false
+	simulation.poll()
+	simulation.poll()
+	require.Equal(t, 4, simulation.running(heavy))
+	require.Equal(t, 0, simulation.running(light))
+
+	// Light upstream gets its share back as the heavy upstream's tasks complete
+	simulation.demanded[light] = true
+
+	for range 4 {
+		simulation.completeOldest()
+		simulation.poll()
+	}
+
+	require.Equal(t, 3, simulation.running(heavy))
+	require.Equal(t, 1, simulation.running(light))
+}
+
+func TestWeightedAdmissionQuotaRedistribution(t *testing.T) {
+	worker, heavy, light := newWeightedTestWorker(t,
+		upstreampkg.WithResourceQuota(map[string]float64{"tart-vms": 1}))
+	simulation := newSchedulingSimulation(worker)
+
+	// The part of the heavy upstream's share that exceeds its quota goes to the light upstream
+	simulation.poll()
+	require.Equal(t, 1, simulation.running(heavy))
+	require.Equal(t, 3, simulation.running(light))
+}
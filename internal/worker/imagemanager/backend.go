@@ -0,0 +1,184 @@
+package imagemanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/containerbackend"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/persistentworker/isolation/tart"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/persistentworker/isolation/vetu"
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
+)
+
+var ErrNotInstalled = errors.New("isolation is not available on this host")
+
+// Backend pulls and deletes images of a single isolation type.
+type Backend interface {
+	// Pullable returns false for images that cannot be pulled from
+	// a registry (e.g. locally created Tart VMs) and thus should
+	// never be pre-pulled
+	Pullable(image string) bool
+	// Manages returns false for images that might've been created locally
+	// (e.g. built with "docker build") and thus should never be evicted,
+	// unless they were pulled by the manager itself
+	Manages(image string) bool
+	Pull(ctx context.Context, image string) error
+	Delete(ctx context.Context, image string) error
+}
+
+func defaultBackends() map[Kind]Backend {
+	return map[Kind]Backend{
+		KindTart:      &tartBackend{},
+		KindVetu:      &vetuBackend{},
+		KindContainer: &containerBackend{},
+	}
+}
+
+// isRemote distinguishes the OCI references (e.g. ghcr.io/cirruslabs/macos-sequoia-base:latest)
+// from the local VM names, which cannot contain slashes.
+func isRemote(image string) bool {
+	return strings.Contains(image, "/")
+}
+
+// hasRegistryReference distinguishes the container images that explicitly reference a registry
+// (e.g. ghcr.io/cirruslabs/cirrus-cli:latest) from the ones that might've been built or loaded locally.
+func hasRegistryReference(image string) bool {
+	domain, _, ok := strings.Cut(image, "/")
+	if !ok {
+		return false
+	}
+
+	return strings.ContainsAny(domain, ".:") || domain == "localhost"
+}
+
+type tartBackend struct{}
+
+func (backend *tartBackend) Pullable(image string) bool {
+	return isRemote(image)
+}
+
+func (backend *tartBackend) Manages(image string) bool {
+	return isRemote(image)
+}
+
+func (backend *tartBackend) Pull(ctx context.Context, image string) error {
+	if !tart.Installed() {
+		return fmt.Errorf("%w: Tart is not installed", ErrNotInstalled)
+	}
+
+	_, _, err := tart.Cmd(ctx, nil, "pull", image)
+
+	return err
+}
+
+func (backend *tartBackend) Delete(ctx context.Context, image string) error {
+	if !tart.Installed() {
+		return fmt.Errorf("%w: Tart is not installed", ErrNotInstalled)
+	}
+
+	_, _, err := tart.Cmd(ctx, nil, "delete", image)
+
+	return err
+}
+
+type vetuBackend struct{}
+
+func (backend *vetuBackend) Pullable(image string) bool {
+	return isRemote(image)
+}
+
+func (backend *vetuBackend) Manages(image string) bool {
+	return isRemote(image)
+}
+
+func (backend *vetuBackend) Pull(ctx context.Context, image string) error {
+	if !vetu.Installed() {
+		return fmt.Errorf("%w: Vetu is not installed", ErrNotInstalled)
+	}
+
+	_, _, err := vetu.Cmd(ctx, nil, "pull", image)
+
+	return err
+}
+
+func (backend *vetuBackend) Delete(ctx context.Context, image string) error {
+	if !vetu.Installed() {
+		return fmt.Errorf("%w: Vetu is not installed", ErrNotInstalled)
+	}
+
+	_, _, err := vetu.Cmd(ctx, nil, "delete", image)
+
+	return err
+}
+
+type containerBackend struct {
+	backend containerbackend.ContainerBackend
+	mtx     sync.Mutex
+}
+
+func (backend *containerBackend) Pullable(image string) bool {
+	return true
+}
+
+func (backend *containerBackend) Manages(image string) bool {
+	return hasRegistryReference(image)
+}
+
+func (backend *containerBackend) Pull(ctx context.Context, image string) error {
+	containerBackend, err := backend.get()
+	if err != nil {
+		return err
+	}
+
+	return containerBackend.ImagePull(ctx, image, nil)
+}
+
+func (backend *containerBackend) Delete(ctx context.Context, image string) error {
+	containerBackend, err := backend.get()
+	if err != nil {
+		return err
+	}
+
+	if err := containerBackend.ImageDelete(ctx, image); err != nil && !errors.Is(err, containerbackend.ErrNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+// get lazily instantiates the container backend to avoid
+// failing on hosts where neither Docker nor Podman are installed.
+func (backend *containerBackend) get() (containerbackend.ContainerBackend, error) {
+	backend.mtx.Lock()
+	defer backend.mtx.Unlock()
+
+	if backend.backend != nil {
+		return backend.backend, nil
+	}
+
+	containerBackend, err := containerbackend.New(containerbackend.BackendTypeAuto)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrNotInstalled, err)
+	}
+
+	backend.backend = containerBackend
+
+	return containerBackend, nil
+}
+
+// ImageFromIsolation returns the image used by the isolation, if any.
+func ImageFromIsolation(isolation *api.Isolation) (Image, bool) {
+	switch {
+	case isolation.GetTart() != nil:
+		return Image{Isolation: KindTart, Name: isolation.GetTart().Image}, true
+	case isolation.GetVetu() != nil:
+		return Image{Isolation: KindVetu, Name: isolation.GetVetu().Image}, true
+	case isolation.GetContainer() != nil:
+		return Image{Isolation: KindContainer, Name: isolation.GetContainer().Image}, true
+	default:
+		return Image{}, false
+	}
+}
@@ -0,0 +1,101 @@
+package imagemanager
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+type Kind string
+
+const (
+	KindTart      Kind = "tart"
+	KindVetu      Kind = "vetu"
+	KindContainer Kind = "container"
+)
+
+func (kind *Kind) UnmarshalYAML(value *yaml.Node) error {
+	var raw string
+
+	if err := value.Decode(&raw); err != nil {
+		return err
+	}
+
+	switch Kind(raw) {
+	case KindTart, KindVetu, KindContainer:
+		*kind = Kind(raw)
+	default:
+		return fmt.Errorf("%w: unsupported isolation %q, only %q, %q and %q are supported",
+			ErrInvalidConfig, raw, KindTart, KindVetu, KindContainer)
+	}
+
+	return nil
+}
+
+type Image struct {
+	Isolation Kind   `yaml:"isolation"`
+	Name      string `yaml:"image"`
+}
+
+func (image Image) String() string {
+	return fmt.Sprintf("%s image %q", image.Isolation, image.Name)
+}
+
+type Config struct {
+	// Images to pull ahead of time, these are never evicted
+	PrePull       []Image       `yaml:"pre-pull"`
+	CheckInterval time.Duration `yaml:"check-interval"`
+	Jitter        time.Duration `yaml:"jitter"`
+
+	// Evict images that weren't used by any task for this many days
+	EvictUnusedAfterDays uint `yaml:"evict-unused-after-days"`
+
+	// Evict the least recently used images when the disk
+	// usage of the file system containing DiskPath is
+	// greater than the specified percentage
+	MaxDiskUsage float64 `yaml:"max-disk-usage"`
+	DiskPath     string  `yaml:"disk-path"`
+
+	// File in which the last-used times are persisted between the worker restarts
+	StateFile string `yaml:"state-file"`
+}
+
+func (config *Config) checkInterval() time.Duration {
+	if config.CheckInterval == 0 {
+		return defaultCheckInterval
+	}
+
+	return config.CheckInterval
+}
+
+func (config *Config) pinned(image Image) bool {
+	for _, prePullImage := range config.PrePull {
+		if prePullImage == image {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate checks the settings that cannot be validated during the YAML decoding.
+func (config *Config) Validate() error {
+	if config.CheckInterval < 0 || config.Jitter < 0 {
+		return fmt.Errorf("%w: \"check-interval:\" and \"jitter:\" cannot be negative", ErrInvalidConfig)
+	}
+
+	if config.MaxDiskUsage < 0 || config.MaxDiskUsage > 100 {
+		return fmt.Errorf("%w: \"max-disk-usage:\" should be a percentage between 0 and 100, got %v",
+			ErrInvalidConfig, config.MaxDiskUsage)
+	}
+
+	for _, image := range config.PrePull {
+		if image.Isolation == "" || image.Name == "" {
+			return fmt.Errorf("%w: each \"pre-pull:\" entry requires both \"isolation:\" and \"image:\"",
+				ErrInvalidConfig)
+		}
+	}
+
+	return nil
+}
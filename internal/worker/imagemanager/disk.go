@@ -0,0 +1,24 @@
+//go:build linux || darwin
+
+package imagemanager
+
+import "golang.org/x/sys/unix"
+
+// diskUsage returns the percentage of the used space
+// on the file system that contains the path.
+func diskUsage(path string) (float64, error) {
+	var stat unix.Statfs_t
+
+	if err := unix.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+
+	total := uint64(stat.Blocks) * uint64(stat.Bsize)
+	if total == 0 {
+		return 0, nil
+	}
+
+	available := uint64(stat.Bavail) * uint64(stat.Bsize)
+
+	return float64(total-available) / float64(total) * 100, nil
+}
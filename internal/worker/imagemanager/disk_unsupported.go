@@ -0,0 +1,9 @@
+//go:build !linux && !darwin
+
+package imagemanager
+
+import "fmt"
+
+func diskUsage(path string) (float64, error) {
+	return 0, fmt.Errorf("%w: disk usage monitoring is not supported on this platform", ErrNotInstalled)
+}
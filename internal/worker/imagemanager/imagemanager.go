@@ -0,0 +1,582 @@
+package imagemanager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+var ErrInvalidConfig = errors.New("invalid image management configuration")
+
+const (
+	defaultCheckInterval = time.Hour
+	day                  = 24 * time.Hour
+
+	evictionReasonUnused    = "unused"
+	evictionReasonDiskUsage = "disk-usage"
+)
+
+// Manager pre-pulls the configured images and garbage-collects
+// the images that were used by the tasks but are no longer needed.
+type Manager struct {
+	config   *Config
+	backends map[Kind]Backend
+	images   map[Image]*entry
+	logger   logrus.FieldLogger
+
+	// Image of the standby instance, which is never evicted
+	// since the standby instance can be re-created at any time
+	standbyImage *Image
+
+	// Signals the Run() loop that the configuration has changed
+	wakeup chan struct{}
+
+	meter            metric.Meter
+	pullsCounter     metric.Int64Counter
+	evictionsCounter metric.Int64Counter
+
+	now       func() time.Time
+	diskUsage func(path string) (float64, error)
+
+	mtx sync.Mutex
+
+	// Serializes the state saves, which write to the same temporary file
+	saveMtx sync.Mutex
+}
+
+type entry struct {
+	LastUsed time.Time
+
+	// Whether the image was pulled by the manager, which
+	// makes it eligible for eviction even if not managed
+	Pulled bool
+
+	// Number of the running tasks that use this image,
+	// images that are in use are never evicted
+	inUse int
+}
+
+type stateEntry struct {
+	Isolation Kind      `json:"isolation"`
+	Image     string    `json:"image"`
+	LastUsed  time.Time `json:"last-used"`
+	Pulled    bool      `json:"pulled,omitempty"`
+}
+
+func New(opts ...Option) (*Manager, error) {
+	manager := &Manager{
+		backends:  defaultBackends(),
+		images:    map[Image]*entry{},
+		logger:    logrus.New(),
+		meter:     noop.NewMeterProvider().Meter(""),
+		wakeup:    make(chan struct{}, 1),
+		now:       time.Now,
+		diskUsage: diskUsage,
+	}
+
+	// Apply options
+	for _, opt := range opts {
+		opt(manager)
+	}
+
+	if err := manager.initializeMetrics(); err != nil {
+		return nil, err
+	}
+
+	return manager, nil
+}
+
+func (manager *Manager) initializeMetrics() error {
+	var err error
+
+	meter := manager.meter
+
+	manager.pullsCounter, err = meter.Int64Counter("org.cirruslabs.persistent_worker.images.pulls",
+		metric.WithDescription("Number of images pre-pulled by the Persistent Worker."))
+	if err != nil {
+		return err
+	}
+
+	manager.evictionsCounter, err = meter.Int64Counter("org.cirruslabs.persistent_worker.images.evictions",
+		metric.WithDescription("Number of images evicted by the Persistent Worker."))
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.Int64ObservableGauge("org.cirruslabs.persistent_worker.images.tracked_count",
+		metric.WithDescription("Number of images tracked for eviction by the Persistent Worker."),
+		metric.WithInt64Callback(func(ctx context.Context, observer metric.Int64Observer) error {
+			for kind, count := range manager.trackedCount() {
+				observer.Observe(count, metric.WithAttributes(attribute.String("isolation", string(kind))))
+			}
+
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.Float64ObservableGauge("org.cirruslabs.persistent_worker.images.disk_usage",
+		metric.WithDescription("Disk usage percentage of the file system monitored by the Persistent Worker."),
+		metric.WithFloat64Callback(func(ctx context.Context, observer metric.Float64Observer) error {
+			config := manager.currentConfig()
+			if config == nil || config.MaxDiskUsage == 0 {
+				return nil
+			}
+
+			usage, err := manager.diskUsage(manager.diskPath(config))
+			if err != nil {
+				return nil //nolint:nilerr // disk usage is reported on a best-effort basis
+			}
+
+			observer.Observe(usage)
+
+			return nil
+		}),
+	)
+
+	return err
+}
+
+// SetConfig replaces the image management configuration,
+// a nil configuration disables the pre-pulling and eviction.
+func (manager *Manager) SetConfig(config *Config) {
+	manager.mtx.Lock()
+	oldConfig := manager.config
+	manager.config = config
+	manager.mtx.Unlock()
+
+	if config != nil && (oldConfig == nil || oldConfig.StateFile != config.StateFile) {
+		if err := manager.loadState(config); err != nil {
+			manager.logger.Warnf("failed to load image last-used times: %v", err)
+		}
+	}
+
+	select {
+	case manager.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// SetStandbyImage prevents the eviction of the standby instance's image,
+// a nil image means that there's no standby instance configured.
+func (manager *Manager) SetStandbyImage(image *Image) {
+	if manager == nil {
+		return
+	}
+
+	manager.mtx.Lock()
+	defer manager.mtx.Unlock()
+
+	manager.standbyImage = image
+}
+
+// Use records that the image was used by a task that was just started and
+// prevents its eviction until the returned function is called.
+func (manager *Manager) Use(image Image) func() {
+	if manager == nil {
+		return func() {}
+	}
+
+	backend, ok := manager.backends[image.Isolation]
+	if !ok {
+		return func() {}
+	}
+
+	manager.mtx.Lock()
+	imageEntry, ok := manager.images[image]
+	if !ok {
+		if !backend.Manages(image.Name) {
+			manager.mtx.Unlock()
+
+			return func() {}
+		}
+
+		imageEntry = &entry{}
+		manager.images[image] = imageEntry
+	}
+	imageEntry.LastUsed = manager.now()
+	imageEntry.inUse++
+	manager.mtx.Unlock()
+
+	manager.saveStateLogged()
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			manager.mtx.Lock()
+			imageEntry.LastUsed = manager.now()
+			imageEntry.inUse--
+			manager.mtx.Unlock()
+
+			manager.saveStateLogged()
+		})
+	}
+}
+
+// Run periodically pre-pulls and evicts the images until the context is canceled.
+func (manager *Manager) Run(ctx context.Context) {
+	for {
+		var nextCheckIn time.Duration
+
+		if config := manager.currentConfig(); config != nil {
+			manager.Check(ctx)
+
+			nextCheckIn = config.checkInterval()
+
+			if jitterNanoseconds := config.Jitter.Nanoseconds(); jitterNanoseconds > 0 {
+				//nolint:gosec // G404 is not applicable as we don't need cryptographically secure numbers here
+				nextCheckIn += time.Duration(rand.Int64N(jitterNanoseconds))
+			}
+		}
+
+		var timer <-chan time.Time
+
+		if nextCheckIn != 0 {
+			timer = time.After(nextCheckIn)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-manager.wakeup:
+			// configuration has changed
+		case <-timer:
+			// time for the next check
+		}
+	}
+}
+
+// Check pre-pulls the configured images and evicts the images
+// that weren't used for too long or exceed the disk usage limit.
+func (manager *Manager) Check(ctx context.Context) {
+	config := manager.currentConfig()
+	if config == nil {
+		return
+	}
+
+	manager.prePull(ctx, config)
+	manager.evictUnused(ctx, config)
+	manager.evictForDiskUsage(ctx, config)
+
+	manager.saveStateLogged()
+}
+
+func (manager *Manager) prePull(ctx context.Context, config *Config) {
+	for _, image := range config.PrePull {
+		backend, ok := manager.backends[image.Isolation]
+		if !ok || !backend.Pullable(image.Name) {
+			manager.logger.Warnf("not pre-pulling %s since it's not a remote image", image)
+
+			continue
+		}
+
+		manager.logger.Infof("pre-pulling %s...", image)
+
+		status := "succeeded"
+
+		if err := backend.Pull(ctx, image.Name); err != nil {
+			manager.logger.Errorf("failed to pre-pull %s: %v", image, err)
+
+			status = "failed"
+		} else {
+			// Track the pre-pulled image so that it can be evicted
+			// once it's removed from the pre-pull list and unused
+			manager.mtx.Lock()
+			imageEntry, ok := manager.images[image]
+			if !ok {
+				imageEntry = &entry{LastUsed: manager.now()}
+				manager.images[image] = imageEntry
+			}
+			imageEntry.Pulled = true
+			manager.mtx.Unlock()
+		}
+
+		manager.pullsCounter.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("isolation", string(image.Isolation)),
+			attribute.String("image", image.Name),
+			attribute.String("status", status),
+		))
+	}
+}
+
+func (manager *Manager) evictUnused(ctx context.Context, config *Config) {
+	if config.EvictUnusedAfterDays == 0 {
+		return
+	}
+
+	cutoff := manager.now().Add(-time.Duration(config.EvictUnusedAfterDays) * day)
+
+	for _, image := range manager.evictionCandidates(config) {
+		if !image.lastUsed.Before(cutoff) {
+			// Candidates are sorted by their last-used time
+			break
+		}
+
+		manager.evict(ctx, image.Image, evictionReasonUnused)
+	}
+}
+
+func (manager *Manager) evictForDiskUsage(ctx context.Context, config *Config) {
+	if config.MaxDiskUsage == 0 {
+		return
+	}
+
+	path := manager.diskPath(config)
+
+	for _, image := range manager.evictionCandidates(config) {
+		usage, err := manager.diskUsage(path)
+		if err != nil {
+			manager.logger.Warnf("failed to determine disk usage of %s: %v", path, err)
+
+			return
+		}
+
+		if usage <= config.MaxDiskUsage {
+			return
+		}
+
+		manager.logger.Infof("disk usage of %s is %.1f%%, which exceeds the %.1f%% limit",
+			path, usage, config.MaxDiskUsage)
+
+		manager.evict(ctx, image.Image, evictionReasonDiskUsage)
+	}
+}
+
+type candidate struct {
+	Image
+	lastUsed time.Time
+}
+
+// evictionCandidates returns the images that are neither pre-pulled, nor used by the
+// standby instance, nor in use, with the least recently used images coming first.
+func (manager *Manager) evictionCandidates(config *Config) []candidate {
+	manager.mtx.Lock()
+	defer manager.mtx.Unlock()
+
+	var result []candidate
+
+	for image, imageEntry := range manager.images {
+		if imageEntry.inUse != 0 || config.pinned(image) || manager.isStandbyImage(image) {
+			continue
+		}
+
+		result = append(result, candidate{Image: image, lastUsed: imageEntry.LastUsed})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].lastUsed.Before(result[j].lastUsed)
+	})
+
+	return result
+}
+
+func (manager *Manager) evict(ctx context.Context, image Image, reason string) {
+	manager.mtx.Lock()
+	imageEntry, ok := manager.images[image]
+	inUse := ok && imageEntry.inUse != 0
+	standby := manager.isStandbyImage(image)
+	manager.mtx.Unlock()
+
+	// The image might've been picked up by a task or became the standby
+	// instance's image since we've collected the candidates
+	if !ok || inUse || standby {
+		return
+	}
+
+	manager.logger.Infof("evicting %s (reason: %s)...", image, reason)
+
+	if err := manager.backends[image.Isolation].Delete(ctx, image.Name); err != nil {
+		manager.logger.Errorf("failed to evict %s: %v", image, err)
+
+		return
+	}
+
+	manager.mtx.Lock()
+	if imageEntry.inUse == 0 {
+		delete(manager.images, image)
+	}
+	manager.mtx.Unlock()
+
+	manager.evictionsCounter.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("isolation", string(image.Isolation)),
+		attribute.String("image", image.Name),
+		attribute.String("reason", reason),
+	))
+}
+
+// isStandbyImage must be called with the manager's mutex held.
+func (manager *Manager) isStandbyImage(image Image) bool {
+	return manager.standbyImage != nil && *manager.standbyImage == image
+}
+
+func (manager *Manager) trackedCount() map[Kind]int64 {
+	manager.mtx.Lock()
+	defer manager.mtx.Unlock()
+
+	result := map[Kind]int64{}
+
+	for image := range manager.images {
+		result[image.Isolation]++
+	}
+
+	return result
+}
+
+func (manager *Manager) currentConfig() *Config {
+	manager.mtx.Lock()
+	defer manager.mtx.Unlock()
+
+	return manager.config
+}
+
+func (manager *Manager) diskPath(config *Config) string {
+	if config.DiskPath != "" {
+		return config.DiskPath
+	}
+
+	if homeDir, err := os.UserHomeDir(); err == nil {
+		return homeDir
+	}
+
+	return "/"
+}
+
+func (manager *Manager) statePath(config *Config) (string, error) {
+	if config.StateFile != "" {
+		return config.StateFile, nil
+	}
+
+	userCacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(userCacheDir, "cirrus", "persistent-worker", "images.json"), nil
+}
+
+// loadState merges the last-used times persisted by the previous worker runs.
+func (manager *Manager) loadState(config *Config) error {
+	path, err := manager.statePath(config)
+	if err != nil {
+		return err
+	}
+
+	stateBytes, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+
+		return err
+	}
+
+	var state []stateEntry
+
+	if err := json.Unmarshal(stateBytes, &state); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	manager.mtx.Lock()
+	defer manager.mtx.Unlock()
+
+	for _, stateEntry := range state {
+		image := Image{Isolation: stateEntry.Isolation, Name: stateEntry.Image}
+
+		backend, ok := manager.backends[image.Isolation]
+		if !ok {
+			continue
+		}
+
+		imageEntry, ok := manager.images[image]
+		if !ok {
+			if !stateEntry.Pulled && !backend.Manages(image.Name) {
+				continue
+			}
+
+			imageEntry = &entry{}
+			manager.images[image] = imageEntry
+		}
+
+		imageEntry.Pulled = imageEntry.Pulled || stateEntry.Pulled
+
+		if stateEntry.LastUsed.After(imageEntry.LastUsed) {
+			imageEntry.LastUsed = stateEntry.LastUsed
+		}
+	}
+
+	return nil
+}
+
+func (manager *Manager) saveState() error {
+	manager.saveMtx.Lock()
+	defer manager.saveMtx.Unlock()
+
+	config := manager.currentConfig()
+	if config == nil {
+		return nil
+	}
+
+	path, err := manager.statePath(config)
+	if err != nil {
+		return err
+	}
+
+	manager.mtx.Lock()
+	state := make([]stateEntry, 0, len(manager.images))
+	for image, imageEntry := range manager.images {
+		state = append(state, stateEntry{
+			Isolation: image.Isolation,
+			Image:     image.Name,
+			LastUsed:  imageEntry.LastUsed,
+			Pulled:    imageEntry.Pulled,
+		})
+	}
+	manager.mtx.Unlock()
+
+	sort.Slice(state, func(i, j int) bool {
+		if state[i].Isolation != state[j].Isolation {
+			return state[i].Isolation < state[j].Isolation
+		}
+
+		return state[i].Image < state[j].Image
+	})
+
+	stateBytes, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first to not corrupt the state if we crash mid-write
+	tmpPath := path + ".tmp"
+
+	if err := os.WriteFile(tmpPath, stateBytes, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+func (manager *Manager) saveStateLogged() {
+	if err := manager.saveState(); err != nil {
+		manager.logger.Warnf("failed to save image last-used times: %v", err)
+	}
+}
@@ -0,0 +1,250 @@
+//nolint:testpackage // we need to control the manager's clock and disk usage
+package imagemanager
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeBackend struct {
+	pulled  []string
+	deleted []string
+	mtx     sync.Mutex
+}
+
+func (backend *fakeBackend) Pullable(image string) bool {
+	return isRemote(image)
+}
+
+func (backend *fakeBackend) Manages(image string) bool {
+	return isRemote(image)
+}
+
+func (backend *fakeBackend) Pull(ctx context.Context, image string) error {
+	backend.mtx.Lock()
+	defer backend.mtx.Unlock()
+
+	backend.pulled = append(backend.pulled, image)
+
+	return nil
+}
+
+func (backend *fakeBackend) Delete(ctx context.Context, image string) error {
+	backend.mtx.Lock()
+	defer backend.mtx.Unlock()
+
+	backend.deleted = append(backend.deleted, image)
+
+	return nil
+}
+
+// fakeContainerBackend mimics the container backend, which can pull
+// any image, but only manages the ones that reference a registry.
+type fakeContainerBackend struct {
+	fakeBackend
+}
+
+func (backend *fakeContainerBackend) Pullable(image string) bool {
+	return true
+}
+
+func (backend *fakeContainerBackend) Manages(image string) bool {
+	return hasRegistryReference(image)
+}
+
+func newTestManager(t *testing.T, config *Config) (*Manager, *fakeBackend, *time.Time) {
+	backend := &fakeBackend{}
+
+	manager, err := New(WithBackend(KindTart, backend))
+	require.NoError(t, err)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	manager.now = func() time.Time {
+		return now
+	}
+
+	if config.StateFile == "" {
+		config.StateFile = filepath.Join(t.TempDir(), "images.json")
+	}
+	manager.SetConfig(config)
+
+	return manager, backend, &now
+}
+
+func TestPrePull(t *testing.T) {
+	manager, backend, _ := newTestManager(t, &Config{
+		PrePull: []Image{
+			{Isolation: KindTart, Name: "ghcr.io/cirruslabs/macos-sequoia-base:latest"},
+			{Isolation: KindTart, Name: "local-vm"},
+		},
+	})
+
+	manager.Check(context.Background())
+
+	// Local VMs are never pulled
+	require.Equal(t, []string{"ghcr.io/cirruslabs/macos-sequoia-base:latest"}, backend.pulled)
+}
+
+func TestEvictUnused(t *testing.T) {
+	manager, backend, now := newTestManager(t, &Config{
+		PrePull: []Image{
+			{Isolation: KindTart, Name: "ghcr.io/cirruslabs/pinned:latest"},
+		},
+		EvictUnusedAfterDays: 7,
+	})
+
+	manager.Use(Image{Isolation: KindTart, Name: "ghcr.io/cirruslabs/old:latest"})()
+	manager.Use(Image{Isolation: KindTart, Name: "local-vm"})()
+	release := manager.Use(Image{Isolation: KindTart, Name: "ghcr.io/cirruslabs/running:latest"})
+
+	*now = now.Add(8 * day)
+	manager.Use(Image{Isolation: KindTart, Name: "ghcr.io/cirruslabs/recent:latest"})()
+
+	manager.Check(context.Background())
+
+	// Pinned, running, recently used and local images are kept
+	require.Equal(t, []string{"ghcr.io/cirruslabs/old:latest"}, backend.deleted)
+
+	// Once the task finishes, the image is only evicted after it stays unused for another 7 days
+	release()
+	manager.Check(context.Background())
+	require.Equal(t, []string{"ghcr.io/cirruslabs/old:latest"}, backend.deleted)
+}
+
+func TestLocalContainerImagesAreNotEvicted(t *testing.T) {
+	backend := &fakeContainerBackend{}
+
+	manager, err := New(WithBackend(KindContainer, backend))
+	require.NoError(t, err)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	manager.now = func() time.Time {
+		return now
+	}
+
+	stateFile := filepath.Join(t.TempDir(), "images.json")
+
+	manager.SetConfig(&Config{
+		StateFile: stateFile,
+		PrePull: []Image{
+			{Isolation: KindContainer, Name: "debian:latest"},
+		},
+	})
+	manager.Check(context.Background())
+	require.Equal(t, []string{"debian:latest"}, backend.pulled)
+
+	manager.Use(Image{Isolation: KindContainer, Name: "debian:latest"})()
+	manager.Use(Image{Isolation: KindContainer, Name: "locally-built:latest"})()
+	manager.Use(Image{Isolation: KindContainer, Name: "ghcr.io/cirruslabs/remote:latest"})()
+
+	// Remove the image from the pre-pull list so that it can be evicted
+	now = now.Add(8 * day)
+	manager.SetConfig(&Config{StateFile: stateFile, EvictUnusedAfterDays: 7})
+	manager.Check(context.Background())
+
+	// Images pulled by the manager and the ones referencing a registry are evicted,
+	// while the images that might've been built locally are kept
+	require.ElementsMatch(t, []string{"debian:latest", "ghcr.io/cirruslabs/remote:latest"}, backend.deleted)
+}
+
+func TestStandbyImageIsNotEvicted(t *testing.T) {
+	manager, backend, now := newTestManager(t, &Config{
+		EvictUnusedAfterDays: 7,
+		MaxDiskUsage:         80,
+	})
+	manager.diskUsage = func(path string) (float64, error) {
+		return 95, nil
+	}
+
+	standbyImage := Image{Isolation: KindTart, Name: "ghcr.io/cirruslabs/standby:latest"}
+	manager.SetStandbyImage(&standbyImage)
+
+	manager.Use(standbyImage)()
+	manager.Use(Image{Isolation: KindTart, Name: "ghcr.io/cirruslabs/old:latest"})()
+
+	*now = now.Add(8 * day)
+	manager.Check(context.Background())
+
+	// The standby image is kept even though it's unused and the disk is full
+	require.Equal(t, []string{"ghcr.io/cirruslabs/old:latest"}, backend.deleted)
+
+	// Once it's no longer the standby image, it can be evicted
+	manager.SetStandbyImage(nil)
+	manager.Check(context.Background())
+	require.Equal(t, []string{"ghcr.io/cirruslabs/old:latest", "ghcr.io/cirruslabs/standby:latest"},
+		backend.deleted)
+}
+
+func TestEvictForDiskUsage(t *testing.T) {
+	manager, backend, now := newTestManager(t, &Config{
+		MaxDiskUsage: 80,
+	})
+
+	for _, image := range []string{"ghcr.io/cirruslabs/a:latest", "ghcr.io/cirruslabs/b:latest",
+		"ghcr.io/cirruslabs/c:latest"} {
+		manager.Use(Image{Isolation: KindTart, Name: image})()
+		*now = now.Add(time.Hour)
+	}
+
+	// Each eviction frees 10% of the disk
+	manager.diskUsage = func(path string) (float64, error) {
+		return 95 - 10*float64(len(backend.deleted)), nil
+	}
+
+	manager.Check(context.Background())
+
+	// Least recently used images are evicted first until the usage drops below the limit
+	require.Equal(t, []string{"ghcr.io/cirruslabs/a:latest", "ghcr.io/cirruslabs/b:latest"}, backend.deleted)
+}
+
+func TestStatePersistence(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "images.json")
+
+	manager, _, _ := newTestManager(t, &Config{StateFile: stateFile})
+	manager.Use(Image{Isolation: KindTart, Name: "ghcr.io/cirruslabs/old:latest"})()
+
+	// A new manager (e.g. after the worker restart) picks up the last-used times
+	manager, backend, now := newTestManager(t, &Config{StateFile: stateFile, EvictUnusedAfterDays: 1})
+	*now = now.Add(2 * day)
+
+	manager.Check(context.Background())
+	require.Equal(t, []string{"ghcr.io/cirruslabs/old:latest"}, backend.deleted)
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, (&Config{MaxDiskUsage: 90}).Validate())
+	require.ErrorIs(t, (&Config{MaxDiskUsage: 150}).Validate(), ErrInvalidConfig)
+	require.ErrorIs(t, (&Config{PrePull: []Image{{Isolation: KindTart}}}).Validate(), ErrInvalidConfig)
+}
+
+func TestConcurrentStateSaves(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "images.json")
+
+	manager, _, _ := newTestManager(t, &Config{StateFile: stateFile})
+
+	var wg sync.WaitGroup
+
+	for i := range 32 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			manager.Use(Image{Isolation: KindTart, Name: fmt.Sprintf("ghcr.io/cirruslabs/image-%d:latest", i)})()
+		}()
+	}
+
+	wg.Wait()
+
+	require.NoError(t, manager.saveState())
+
+	// A new manager picks up all the images
+	manager, _, _ = newTestManager(t, &Config{StateFile: stateFile})
+	require.Equal(t, map[Kind]int64{KindTart: 32}, manager.trackedCount())
+}
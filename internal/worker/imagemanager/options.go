@@ -0,0 +1,28 @@
+package imagemanager
+
+import (
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type Option func(*Manager)
+
+func WithLogger(logger logrus.FieldLogger) Option {
+	return func(manager *Manager) {
+		manager.logger = logger
+	}
+}
+
+// WithMeter specifies the OpenTelemetry meter to export the pre-pull and eviction metrics with.
+func WithMeter(meter metric.Meter) Option {
+	return func(manager *Manager) {
+		manager.meter = meter
+	}
+}
+
+// WithBackend overrides the backend used to pull and delete images of the specified isolation type.
+func WithBackend(kind Kind, backend Backend) Option {
+	return func(manager *Manager) {
+		manager.backends[kind] = backend
+	}
+}
@@ -2,8 +2,10 @@ package worker
 
 import (
 	"github.com/cirruslabs/chacha/pkg/localnetworkhelper"
+	"github.com/cirruslabs/cirrus-cli/internal/worker/imagemanager"
 	"github.com/cirruslabs/cirrus-cli/internal/worker/resourcemodifier"
 	"github.com/cirruslabs/cirrus-cli/internal/worker/security"
+	"github.com/cirruslabs/cirrus-cli/internal/worker/sshinventory"
 	"github.com/cirruslabs/cirrus-cli/internal/worker/tuning"
 	"github.com/cirruslabs/cirrus-cli/internal/worker/upstream"
 	"github.com/cirruslabs/cirrus-cli/pkg/api"
@@ -66,8 +68,30 @@ func WithTartPrePull(tartPrePull *TartPrePull) Option {
 	}
 }
 
+// WithImageManagement configures pre-pulling and garbage collection
+// of the Tart, Vetu and container images used by the tasks.
+func WithImageManagement(config *imagemanager.Config) Option {
+	return func(e *Worker) {
+		e.imagesConfig = config
+	}
+}
+
+// WithDiskQuota limits the size of the working directory of each task
+// that runs directly on the host (e.g. with "none" isolation).
+func WithDiskQuota(diskQuota *DiskQuota) Option {
+	return func(e *Worker) {
+		e.diskQuota = diskQuota
+	}
+}
+
 func WithLocalNetworkHelper(localNetworkHelper *localnetworkhelper.LocalNetworkHelper) Option {
 	return func(e *Worker) {
 		e.localNetworkHelper = localNetworkHelper
 	}
 }
+
+func WithSSHInventory(sshInventory *sshinventory.Inventory) Option {
+	return func(e *Worker) {
+		e.sshInventory = sshInventory
+	}
+}
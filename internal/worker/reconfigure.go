@@ -0,0 +1,104 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/cirruslabs/cirrus-cli/internal/worker/resourcemodifier"
+	"github.com/cirruslabs/cirrus-cli/internal/worker/security"
+	"google.golang.org/protobuf/proto"
+)
+
+type reconfiguration struct {
+	opts []Option
+	done chan struct{}
+}
+
+// Reconfigure replaces the worker's labels, resources, security policy, resource modifiers,
+// tuning, standby, Tart pre-pull, image management and disk quota settings with the ones specified in opts.
+//
+// The new settings are applied by the Run() loop between polls, so they only affect the
+// future polls and newly started tasks, while the already running tasks finish with the
+// instances that were created using the old settings.
+func (worker *Worker) Reconfigure(ctx context.Context, opts ...Option) error {
+	reconfiguration := &reconfiguration{
+		opts: opts,
+		done: make(chan struct{}),
+	}
+
+	select {
+	case worker.reconfigurations <- reconfiguration:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case <-reconfiguration.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (worker *Worker) applyReconfiguration(ctx context.Context, reconfiguration *reconfiguration) {
+	defer close(reconfiguration.done)
+
+	oldStandbyParameters := worker.standbyParameters
+	oldResourceModifierManager := worker.resourceModifierManager
+
+	worker.userSpecifiedLock.Lock()
+
+	// Reset the reconfigurable settings to their defaults
+	// to not inherit anything that was removed from the configuration
+	worker.userSpecifiedLabels = make(map[string]string)
+	worker.userSpecifiedResources = nil
+	worker.security = security.NoSecurity()
+	worker.resourceModifierManager = resourcemodifier.NewManager()
+	worker.tuning = nil
+	worker.standbyParameters = nil
+	worker.tartPrePull = nil
+	worker.imagesConfig = nil
+	worker.diskQuota = nil
+
+	for _, opt := range reconfiguration.opts {
+		opt(worker)
+	}
+
+	// Preserve the knowledge of which resource modifiers are held by the running tasks
+	worker.resourceModifierManager = worker.resourceModifierManager.Inherit(oldResourceModifierManager)
+
+	worker.userSpecifiedLock.Unlock()
+
+	worker.imageManager.SetConfig(worker.imagesConfig)
+	worker.pinStandbyImage()
+
+	// Terminate the standby instance if it was created using the old parameters
+	if worker.standbyInstance != nil && !proto.Equal(oldStandbyParameters, worker.standbyParameters) {
+		worker.logger.Infof("terminating the standby instance since the parameters have changed")
+
+		if err := worker.standbyInstance.Close(ctx); err != nil {
+			worker.logger.Errorf("failed to terminate the standby instance: %v", err)
+		}
+
+		worker.standbyInstance = nil
+		worker.standbyInstanceStartedAt = time.Time{}
+	}
+
+	worker.logger.Infof("worker configuration reloaded")
+}
+
+// labels returns the user-specified labels, which are never modified in-place.
+func (worker *Worker) labels() map[string]string {
+	worker.userSpecifiedLock.RLock()
+	defer worker.userSpecifiedLock.RUnlock()
+
+	return worker.userSpecifiedLabels
+}
+
+// resources returns the user-specified resources, which are never modified in-place.
+func (worker *Worker) resources() map[string]float64 {
+	worker.userSpecifiedLock.RLock()
+	defer worker.userSpecifiedLock.RUnlock()
+
+	return worker.userSpecifiedResources
+}
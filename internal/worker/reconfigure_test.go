@@ -0,0 +1,116 @@
+//nolint:testpackage // we need to apply the reconfiguration without running the worker
+package worker
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/worker/resourcemodifier"
+	upstreampkg "github.com/cirruslabs/cirrus-cli/internal/worker/upstream"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReconfigureWhileCollectingMetrics is meant to be run with -race
+// to ensure that the state read by the metric callbacks doesn't race with the reconfiguration.
+func TestReconfigureWhileCollectingMetrics(t *testing.T) {
+	upstream, err := upstreampkg.New("test", "token")
+	require.NoError(t, err)
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	worker, err := New(WithUpstream(upstream), WithLogger(logger),
+		WithResources(map[string]float64{"tart-vms": 2}))
+	require.NoError(t, err)
+
+	worker.tasks.Store("1", &Task{upstream: upstream, resourcesToUse: map[string]float64{"tart-vms": 1}})
+
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+
+	collectCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		// Read the same state as the metric callbacks do
+		for collectCtx.Err() == nil {
+			_ = worker.resourcesNotInUse()
+			_ = worker.resourcesInUse()
+			_ = worker.labels()
+		}
+	}()
+
+	for i := range 1000 {
+		worker.applyReconfiguration(ctx, &reconfiguration{
+			opts: []Option{
+				WithLabels(map[string]string{"iteration": strconv.Itoa(i)}),
+				WithResources(map[string]float64{"tart-vms": float64(2 + i%2)}),
+			},
+			done: make(chan struct{}),
+		})
+	}
+
+	cancel()
+	wg.Wait()
+
+	require.Equal(t, map[string]float64{"tart-vms": 2}, worker.resourcesNotInUse())
+}
+
+func TestReconfigureWhileResourceModifierIsHeld(t *testing.T) {
+	upstream, err := upstreampkg.New("test", "token")
+	require.NoError(t, err)
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	newGPUModifier := func(run ...string) *resourcemodifier.Modifier {
+		return &resourcemodifier.Modifier{
+			Match:  map[string]float64{"gpu": 1},
+			Append: resourcemodifier.Append{Run: run},
+		}
+	}
+
+	worker, err := New(WithUpstream(upstream), WithLogger(logger),
+		WithResourceModifiersManager(resourcemodifier.NewManager(newGPUModifier("--gpu"))))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	gpu := map[string]float64{"gpu": 1}
+
+	// A running task holds the GPU
+	held := worker.resourceModifierManager.Acquire(gpu)
+	require.NotNil(t, held)
+
+	// Reload with the unchanged resource modifiers
+	worker.applyReconfiguration(ctx, &reconfiguration{
+		opts: []Option{
+			WithResourceModifiersManager(resourcemodifier.NewManager(newGPUModifier("--gpu"))),
+		},
+		done: make(chan struct{}),
+	})
+	require.Nil(t, worker.resourceModifierManager.Acquire(gpu))
+
+	// Reload with the changed resource modifiers
+	worker.applyReconfiguration(ctx, &reconfiguration{
+		opts: []Option{
+			WithResourceModifiersManager(resourcemodifier.NewManager(newGPUModifier("--gpu", "--changed"))),
+		},
+		done: make(chan struct{}),
+	})
+	require.Nil(t, worker.resourceModifierManager.Acquire(gpu))
+
+	// Once the running task releases the GPU, the new modifier is handed out
+	held.Unlock()
+
+	acquired := worker.resourceModifierManager.Acquire(gpu)
+	require.NotNil(t, acquired)
+	require.Equal(t, []string{"--gpu", "--changed"}, acquired.Append.Run)
+}
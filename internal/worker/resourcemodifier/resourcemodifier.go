@@ -1,6 +1,8 @@
 package resourcemodifier
 
 import (
+	"reflect"
+	"slices"
 	"sync"
 )
 
@@ -30,9 +32,31 @@ func (modifier *Modifier) Matches(requested map[string]float64) bool {
 	return true
 }
 
+func (modifier *Modifier) sameAs(other *Modifier) bool {
+	return reflect.DeepEqual(modifier.Match, other.Match) && reflect.DeepEqual(modifier.Append, other.Append)
+}
+
+// held reports whether the modifier is currently acquired by someone.
+func (modifier *Modifier) held() bool {
+	if modifier.TryLock() {
+		modifier.Unlock()
+
+		return false
+	}
+
+	return true
+}
+
 type Manager struct {
 	resourceModifiers []*Modifier
 
+	// retired are the modifiers from the previous manager that had no
+	// counterpart in this manager and were still held at the time of Inherit()
+	retired []*Modifier
+
+	// carried are the modifiers taken from the previous manager
+	carried map[*Modifier]struct{}
+
 	mtx sync.Mutex
 }
 
@@ -42,15 +66,91 @@ func NewManager(resourceModifiers ...*Modifier) *Manager {
 	}
 }
 
+// Inherit carries the acquisition state from the previous manager over to this manager.
+//
+// If both managers are configured with the same modifiers, the previous manager is returned as is.
+// Otherwise, the modifiers with the same configuration are taken from the previous manager
+// (along with their held state), and the newly introduced modifiers are not handed out until
+// the holders of the previous manager's remaining modifiers release them, since
+// these might refer to the same underlying resources (e.g. a GPU).
+func (manager *Manager) Inherit(previous *Manager) *Manager {
+	if previous == nil || previous == manager {
+		return manager
+	}
+
+	previous.mtx.Lock()
+	defer previous.mtx.Unlock()
+
+	if len(manager.resourceModifiers) == len(previous.resourceModifiers) {
+		same := true
+
+		for i := range manager.resourceModifiers {
+			if !manager.resourceModifiers[i].sameAs(previous.resourceModifiers[i]) {
+				same = false
+
+				break
+			}
+		}
+
+		if same {
+			return previous
+		}
+	}
+
+	manager.mtx.Lock()
+	defer manager.mtx.Unlock()
+
+	carried := make(map[*Modifier]struct{})
+
+	for i, resourceModifier := range manager.resourceModifiers {
+		for _, previousModifier := range previous.resourceModifiers {
+			if _, ok := carried[previousModifier]; ok {
+				continue
+			}
+
+			if resourceModifier.sameAs(previousModifier) {
+				manager.resourceModifiers[i] = previousModifier
+				carried[previousModifier] = struct{}{}
+
+				break
+			}
+		}
+	}
+
+	for _, previousModifier := range append(previous.resourceModifiers, previous.retired...) {
+		if _, ok := carried[previousModifier]; ok {
+			continue
+		}
+
+		if previousModifier.held() {
+			manager.retired = append(manager.retired, previousModifier)
+		}
+	}
+
+	manager.carried = carried
+
+	return manager
+}
+
 func (manager *Manager) Acquire(resources map[string]float64) *Modifier {
 	manager.mtx.Lock()
 	defer manager.mtx.Unlock()
 
+	// Forget about the retired modifiers that were released
+	manager.retired = slices.DeleteFunc(manager.retired, func(modifier *Modifier) bool {
+		return !modifier.held()
+	})
+
 	for _, resourceModifier := range manager.resourceModifiers {
 		if !resourceModifier.Matches(resources) {
 			continue
 		}
 
+		// Only hand out the newly introduced modifiers once the retired ones are released
+		if _, ok := manager.carried[resourceModifier]; !ok && len(manager.retired) != 0 {
+			continue
+		}
+
 		if resourceModifier.TryLock() {
 			return resourceModifier
 		}
@@ -19,6 +19,10 @@ type IsolationPolicyParallels struct {
 	// nothing for now
 }
 
+type IsolationPolicySSH struct {
+	// nothing for now
+}
+
 type IsolationPolicyTart struct {
 	AllowedImages  AllowedImages       `yaml:"allowed-images"`
 	AllowedVolumes []AllowedVolumeTart `yaml:"allowed-volumes"`
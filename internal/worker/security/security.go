@@ -10,6 +10,7 @@ type AllowedIsolations struct {
 	Parallels *IsolationPolicyParallels `yaml:"parallels"`
 	Tart      *IsolationPolicyTart      `yaml:"tart"`
 	Vetu      *IsolationPolicyVetu      `yaml:"vetu"`
+	SSH       *IsolationPolicySSH       `yaml:"ssh"`
 }
 
 func NoSecurity() *Security {
@@ -30,6 +31,7 @@ func NoSecurityAllowAllVolumes() *Security {
 				},
 			},
 			Vetu: &IsolationPolicyVetu{},
+			SSH:  &IsolationPolicySSH{},
 		},
 	}
 }
@@ -73,3 +75,11 @@ func (security *Security) VetuPolicy() *IsolationPolicyVetu {
 
 	return &IsolationPolicyVetu{}
 }
+
+func (security *Security) SSHPolicy() *IsolationPolicySSH {
+	if isolation := security.AllowedIsolations; isolation != nil {
+		return isolation.SSH
+	}
+
+	return &IsolationPolicySSH{}
+}
@@ -0,0 +1,361 @@
+package sshinventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+var (
+	ErrInvalidHost       = errors.New("invalid SSH host configuration")
+	ErrNoMatchingHosts   = errors.New("no SSH hosts match the requested labels")
+	ErrNoAvailableHosts  = errors.New("no SSH hosts have enough resources to run the task")
+	ErrInventoryDisabled = errors.New("no SSH hosts are configured on this Persistent Worker")
+)
+
+const (
+	defaultPort         = "22"
+	defaultOS           = "linux"
+	defaultArchitecture = "amd64"
+	connectTimeout      = 10 * time.Second
+)
+
+type Host struct {
+	Name                 string             `yaml:"name"`
+	Address              string             `yaml:"address"`
+	User                 string             `yaml:"user"`
+	PrivateKey           string             `yaml:"private-key"`
+	PrivateKeyPassphrase string             `yaml:"private-key-passphrase"`
+	HostKey              string             `yaml:"host-key"`
+	KnownHosts           string             `yaml:"known-hosts"`
+	InsecureSkipHostKey  bool               `yaml:"insecure-skip-host-key-verification"`
+	OS                   string             `yaml:"os"`
+	Architecture         string             `yaml:"arch"`
+	Labels               map[string]string  `yaml:"labels"`
+	Resources            map[string]float64 `yaml:"resources"`
+
+	signer          ssh.Signer
+	hostKeyCallback ssh.HostKeyCallback
+}
+
+// Addr returns the host's address with the port defaulting to 22.
+func (host *Host) Addr() string {
+	if _, _, err := net.SplitHostPort(host.Address); err == nil {
+		return host.Address
+	}
+
+	return net.JoinHostPort(host.Address, defaultPort)
+}
+
+func (host *Host) GetOS() string {
+	if host.OS == "" {
+		return defaultOS
+	}
+
+	return host.OS
+}
+
+func (host *Host) GetArchitecture() string {
+	if host.Architecture == "" {
+		return defaultArchitecture
+	}
+
+	return host.Architecture
+}
+
+// ClientConfig returns an SSH client configuration that authenticates using the host's private key
+// and verifies the host's public key using "host-key:" or "known-hosts:".
+func (host *Host) ClientConfig() *ssh.ClientConfig {
+	return &ssh.ClientConfig{
+		User:            host.User,
+		Auth:            []ssh.AuthMethod{ssh.PublicKeys(host.signer)},
+		HostKeyCallback: host.hostKeyCallback,
+		Timeout:         connectTimeout,
+	}
+}
+
+func (host *Host) load() error {
+	if host.Name == "" {
+		host.Name = host.Address
+	}
+
+	if host.Address == "" {
+		return fmt.Errorf("%w: host %q has no \"address:\" specified", ErrInvalidHost, host.Name)
+	}
+
+	if host.User == "" {
+		return fmt.Errorf("%w: host %q has no \"user:\" specified", ErrInvalidHost, host.Name)
+	}
+
+	if host.PrivateKey == "" {
+		return fmt.Errorf("%w: host %q has no \"private-key:\" specified", ErrInvalidHost, host.Name)
+	}
+
+	privateKeyBytes, err := os.ReadFile(host.PrivateKey)
+	if err != nil {
+		return fmt.Errorf("%w: failed to read private key for host %q: %v", ErrInvalidHost, host.Name, err)
+	}
+
+	if host.PrivateKeyPassphrase != "" {
+		host.signer, err = ssh.ParsePrivateKeyWithPassphrase(privateKeyBytes, []byte(host.PrivateKeyPassphrase))
+	} else {
+		host.signer, err = ssh.ParsePrivateKey(privateKeyBytes)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: failed to parse private key for host %q: %v", ErrInvalidHost, host.Name, err)
+	}
+
+	switch {
+	case host.HostKey != "":
+		hostKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(host.HostKey))
+		if err != nil {
+			return fmt.Errorf("%w: failed to parse host key for host %q: %v", ErrInvalidHost, host.Name, err)
+		}
+
+		host.hostKeyCallback = ssh.FixedHostKey(hostKey)
+	case host.KnownHosts != "":
+		host.hostKeyCallback, err = knownhosts.New(host.KnownHosts)
+		if err != nil {
+			return fmt.Errorf("%w: failed to load known hosts for host %q: %v", ErrInvalidHost, host.Name, err)
+		}
+	case host.InsecureSkipHostKey:
+		host.hostKeyCallback = ssh.InsecureIgnoreHostKey() //nolint:gosec // explicitly opted-in
+	default:
+		return fmt.Errorf("%w: host %q has neither \"host-key:\" nor \"known-hosts:\" specified, "+
+			"set \"insecure-skip-host-key-verification: true\" to connect without verifying the host key",
+			ErrInvalidHost, host.Name)
+	}
+
+	return nil
+}
+
+func (host *Host) matches(labels map[string]string) bool {
+	for key, value := range labels {
+		if host.Labels[key] != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+type Inventory struct {
+	hosts []*Host
+
+	// Resources currently used on each host, a host that has no
+	// "resources:" specified can only run a single task at a time
+	inUse map[*Host]map[string]float64
+	tasks map[*Host]int
+
+	// Closed and replaced each time a host is released
+	// to wake up the tasks waiting for a free host
+	released chan struct{}
+
+	mtx sync.Mutex
+}
+
+// New validates the hosts, loads their private keys and returns an inventory
+// from which the hosts can be leased for the duration of a task.
+func New(hosts ...*Host) (*Inventory, error) {
+	for _, host := range hosts {
+		if err := host.load(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Inventory{
+		hosts:    hosts,
+		inUse:    map[*Host]map[string]float64{},
+		tasks:    map[*Host]int{},
+		released: make(chan struct{}),
+	}, nil
+}
+
+// Request checks that the inventory has hosts with all the requested labels that are able to fit
+// the requested resources and returns a request that leases one of them once they become free.
+func (inventory *Inventory) Request(labels map[string]string, resources map[string]float64) (*Request, error) {
+	if inventory == nil || len(inventory.hosts) == 0 {
+		return nil, ErrInventoryDisabled
+	}
+
+	inventory.mtx.Lock()
+	defer inventory.mtx.Unlock()
+
+	if _, err := inventory.find(labels, resources); err != nil {
+		return nil, err
+	}
+
+	return &Request{
+		inventory: inventory,
+		labels:    labels,
+		resources: resources,
+	}, nil
+}
+
+// Acquire leases the first host that has all the requested labels and enough free resources,
+// waiting for such host to be released if all of them are busy.
+func (inventory *Inventory) Acquire(
+	ctx context.Context,
+	labels map[string]string,
+	resources map[string]float64,
+) (*Lease, error) {
+	if inventory == nil || len(inventory.hosts) == 0 {
+		return nil, ErrInventoryDisabled
+	}
+
+	for {
+		inventory.mtx.Lock()
+
+		host, err := inventory.find(labels, resources)
+		if err != nil {
+			inventory.mtx.Unlock()
+
+			return nil, err
+		}
+
+		if host != nil {
+			lease := inventory.lease(host, resources)
+
+			inventory.mtx.Unlock()
+
+			return lease, nil
+		}
+
+		released := inventory.released
+
+		inventory.mtx.Unlock()
+
+		select {
+		case <-released:
+			continue
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// find returns the first host that has all the requested labels and enough free resources,
+// or nil if such hosts exist, but are busy at the moment.
+func (inventory *Inventory) find(labels map[string]string, resources map[string]float64) (*Host, error) {
+	var matched, fittable bool
+
+	for _, host := range inventory.hosts {
+		if !host.matches(labels) {
+			continue
+		}
+
+		matched = true
+
+		if !canEverFit(host, resources) {
+			continue
+		}
+
+		fittable = true
+
+		if inventory.canFit(host, resources) {
+			return host, nil
+		}
+	}
+
+	if !matched {
+		return nil, ErrNoMatchingHosts
+	}
+
+	if !fittable {
+		return nil, ErrNoAvailableHosts
+	}
+
+	return nil, nil
+}
+
+func (inventory *Inventory) lease(host *Host, resources map[string]float64) *Lease {
+	if inventory.inUse[host] == nil {
+		inventory.inUse[host] = map[string]float64{}
+	}
+	for key, value := range resources {
+		inventory.inUse[host][key] += value
+	}
+	inventory.tasks[host]++
+
+	return &Lease{
+		Host: host,
+		release: func() {
+			inventory.release(host, resources)
+		},
+	}
+}
+
+// canEverFit returns true if the host is able to fit the resources once it has no tasks running.
+func canEverFit(host *Host, resources map[string]float64) bool {
+	for key, value := range resources {
+		if limit, ok := host.Resources[key]; ok && value > limit {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (inventory *Inventory) canFit(host *Host, resources map[string]float64) bool {
+	if len(host.Resources) == 0 {
+		return inventory.tasks[host] == 0
+	}
+
+	for key, value := range resources {
+		limit, ok := host.Resources[key]
+		if !ok {
+			// Not limited on this host
+			continue
+		}
+
+		if inventory.inUse[host][key]+value > limit {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (inventory *Inventory) release(host *Host, resources map[string]float64) {
+	inventory.mtx.Lock()
+	defer inventory.mtx.Unlock()
+
+	for key, value := range resources {
+		inventory.inUse[host][key] -= value
+	}
+	inventory.tasks[host]--
+
+	close(inventory.released)
+	inventory.released = make(chan struct{})
+}
+
+// Request is a pending lease of a host from the inventory.
+type Request struct {
+	inventory *Inventory
+	labels    map[string]string
+	resources map[string]float64
+}
+
+// Acquire leases a host, waiting for one to be released if all the suitable hosts are busy.
+func (request *Request) Acquire(ctx context.Context) (*Lease, error) {
+	return request.inventory.Acquire(ctx, request.labels, request.resources)
+}
+
+type Lease struct {
+	Host *Host
+
+	release func()
+	once    sync.Once
+}
+
+// Release returns the host back to the inventory, it is safe to call it multiple times.
+func (lease *Lease) Release() {
+	lease.once.Do(lease.release)
+}
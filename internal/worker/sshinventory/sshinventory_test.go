@@ -0,0 +1,215 @@
+package sshinventory_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cirruslabs/cirrus-cli/internal/worker/sshinventory"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+func privateKeyFile(t *testing.T) string {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	pemBlock, err := ssh.MarshalPrivateKey(privateKey, "")
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "id_ed25519")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(pemBlock), 0600))
+
+	return path
+}
+
+func TestAcquireMatchesLabels(t *testing.T) {
+	privateKey := privateKeyFile(t)
+
+	inventory, err := sshinventory.New(
+		&sshinventory.Host{Name: "x86", Address: "10.0.0.1", User: "ci", PrivateKey: privateKey,
+			InsecureSkipHostKey: true, Labels: map[string]string{"arch": "amd64"}},
+		&sshinventory.Host{Name: "arm", Address: "10.0.0.2:2222", User: "ci", PrivateKey: privateKey,
+			InsecureSkipHostKey: true, Labels: map[string]string{"arch": "arm64"}},
+	)
+	require.NoError(t, err)
+
+	lease, err := inventory.Acquire(context.Background(), map[string]string{"arch": "arm64"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "arm", lease.Host.Name)
+	require.Equal(t, "10.0.0.2:2222", lease.Host.Addr())
+
+	_, err = inventory.Acquire(context.Background(), map[string]string{"arch": "riscv64"}, nil)
+	require.ErrorIs(t, err, sshinventory.ErrNoMatchingHosts)
+
+	_, err = inventory.Request(map[string]string{"arch": "riscv64"}, nil)
+	require.ErrorIs(t, err, sshinventory.ErrNoMatchingHosts)
+}
+
+func TestAcquireSingleTaskWithoutResources(t *testing.T) {
+	inventory, err := sshinventory.New(
+		&sshinventory.Host{Address: "10.0.0.1", User: "ci", PrivateKey: privateKeyFile(t),
+			InsecureSkipHostKey: true},
+	)
+	require.NoError(t, err)
+
+	lease, err := inventory.Acquire(context.Background(), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.1:22", lease.Host.Addr())
+
+	requireBusy(t, inventory, nil)
+
+	// Releasing multiple times should not corrupt the accounting
+	lease.Release()
+	lease.Release()
+
+	_, err = inventory.Acquire(context.Background(), nil, nil)
+	require.NoError(t, err)
+
+	requireBusy(t, inventory, nil)
+}
+
+func TestAcquireRespectsResourceLimits(t *testing.T) {
+	inventory, err := sshinventory.New(
+		&sshinventory.Host{Address: "10.0.0.1", User: "ci", PrivateKey: privateKeyFile(t),
+			InsecureSkipHostKey: true, Resources: map[string]float64{"cpu": 8}},
+	)
+	require.NoError(t, err)
+
+	first, err := inventory.Acquire(context.Background(), nil, map[string]float64{"cpu": 4})
+	require.NoError(t, err)
+
+	_, err = inventory.Acquire(context.Background(), nil, map[string]float64{"cpu": 4})
+	require.NoError(t, err)
+
+	requireBusy(t, inventory, map[string]float64{"cpu": 1})
+
+	first.Release()
+
+	_, err = inventory.Acquire(context.Background(), nil, map[string]float64{"cpu": 2})
+	require.NoError(t, err)
+
+	// Requests that no host will ever be able to fit should fail right away
+	_, err = inventory.Acquire(context.Background(), nil, map[string]float64{"cpu": 16})
+	require.ErrorIs(t, err, sshinventory.ErrNoAvailableHosts)
+
+	_, err = inventory.Request(nil, map[string]float64{"cpu": 16})
+	require.ErrorIs(t, err, sshinventory.ErrNoAvailableHosts)
+}
+
+func TestAcquireWaitsForRelease(t *testing.T) {
+	inventory, err := sshinventory.New(
+		&sshinventory.Host{Address: "10.0.0.1", User: "ci", PrivateKey: privateKeyFile(t),
+			InsecureSkipHostKey: true},
+	)
+	require.NoError(t, err)
+
+	first, err := inventory.Acquire(context.Background(), nil, nil)
+	require.NoError(t, err)
+
+	// Requesting a busy host should succeed, the host is only leased on Acquire()
+	request, err := inventory.Request(nil, nil)
+	require.NoError(t, err)
+
+	acquired := make(chan *sshinventory.Lease)
+
+	go func() {
+		lease, err := request.Acquire(context.Background())
+		if err != nil {
+			close(acquired)
+
+			return
+		}
+
+		acquired <- lease
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("acquired a host that is still in use")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	first.Release()
+
+	select {
+	case lease, ok := <-acquired:
+		require.True(t, ok)
+		require.Equal(t, "10.0.0.1:22", lease.Host.Addr())
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the host to be acquired after being released")
+	}
+}
+
+func TestInvalidHosts(t *testing.T) {
+	_, err := sshinventory.New(&sshinventory.Host{Address: "10.0.0.1", User: "ci", InsecureSkipHostKey: true})
+	require.ErrorIs(t, err, sshinventory.ErrInvalidHost)
+
+	_, err = sshinventory.New(&sshinventory.Host{Address: "10.0.0.1", User: "ci",
+		PrivateKey: privateKeyFile(t), HostKey: "not a key"})
+	require.ErrorIs(t, err, sshinventory.ErrInvalidHost)
+
+	// Host key verification can only be disabled explicitly
+	_, err = sshinventory.New(&sshinventory.Host{Address: "10.0.0.1", User: "ci",
+		PrivateKey: privateKeyFile(t)})
+	require.ErrorIs(t, err, sshinventory.ErrInvalidHost)
+
+	_, err = sshinventory.New(&sshinventory.Host{Address: "10.0.0.1", User: "ci",
+		PrivateKey: privateKeyFile(t), KnownHosts: filepath.Join(t.TempDir(), "known_hosts")})
+	require.ErrorIs(t, err, sshinventory.ErrInvalidHost)
+
+	var inventory *sshinventory.Inventory
+	_, err = inventory.Acquire(context.Background(), nil, nil)
+	require.ErrorIs(t, err, sshinventory.ErrInventoryDisabled)
+
+	_, err = inventory.Request(nil, nil)
+	require.ErrorIs(t, err, sshinventory.ErrInventoryDisabled)
+}
+
+func TestKnownHosts(t *testing.T) {
+	hostPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	hostKey, err := ssh.NewPublicKey(hostPublicKey)
+	require.NoError(t, err)
+
+	knownHostsPath := filepath.Join(t.TempDir(), "known_hosts")
+	require.NoError(t, os.WriteFile(knownHostsPath,
+		[]byte(knownhosts.Line([]string{"10.0.0.1"}, hostKey)+"\n"), 0600))
+
+	inventory, err := sshinventory.New(&sshinventory.Host{Address: "10.0.0.1", User: "ci",
+		PrivateKey: privateKeyFile(t), KnownHosts: knownHostsPath})
+	require.NoError(t, err)
+
+	lease, err := inventory.Acquire(context.Background(), nil, nil)
+	require.NoError(t, err)
+
+	hostKeyCallback := lease.Host.ClientConfig().HostKeyCallback
+	addr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 22}
+	require.NoError(t, hostKeyCallback(lease.Host.Addr(), addr, hostKey))
+
+	otherPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ssh.NewPublicKey(otherPublicKey)
+	require.NoError(t, err)
+	require.Error(t, hostKeyCallback(lease.Host.Addr(), addr, otherKey))
+}
+
+// requireBusy ensures that acquiring a host with the given resources
+// waits for one to be released instead of failing right away.
+func requireBusy(t *testing.T, inventory *sshinventory.Inventory, resources map[string]float64) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err := inventory.Acquire(ctx, nil, resources)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/abstract"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/persistentworker"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/runconfig"
+	"github.com/cirruslabs/cirrus-cli/internal/worker/imagemanager"
+	"github.com/cirruslabs/cirrus-cli/internal/worker/sshinventory"
 	upstreampkg "github.com/cirruslabs/cirrus-cli/internal/worker/upstream"
 	"github.com/cirruslabs/cirrus-cli/pkg/api"
 	"github.com/getsentry/sentry-go"
@@ -29,7 +31,7 @@ const perCallTimeout = 15 * time.Second
 
 type Task struct {
 	upstream       *upstreampkg.Upstream
-	cancel         context.CancelFunc
+	cancel         context.CancelCauseFunc
 	resourcesToUse map[string]float64
 }
 
@@ -58,7 +60,7 @@ func (worker *Worker) startTask(
 		"org.cirruslabs.client-secret", agentAwareTask.ClientSecret,
 	)
 
-	taskCtx, cancel := context.WithCancel(ctx)
+	taskCtx, cancel := context.WithCancelCause(ctx)
 
 	worker.tasks.Store(taskID, &Task{
 		upstream:       upstream,
@@ -79,8 +81,46 @@ func (worker *Worker) startTask(
 	}
 
 	worker.imagesCounter.Add(ctx, 1, metric.WithAttributes(inst.Attributes()...))
-	go worker.runTask(taskCtx, upstream, inst, agentAwareTask.CliVersion,
-		taskID, agentAwareTask.ClientSecret, agentAwareTask.ServerSecret)
+
+	// Prevent the task from filling up the host's disk
+	if worker.diskQuota != nil {
+		hostDirectoryInstance, ok := inst.(abstract.HostDirectoryInstance)
+		if !ok {
+			err := fmt.Errorf("%w for this isolation", ErrDiskQuotaUnsupported)
+
+			worker.logger.Errorf("failed to start the task %s: %v", taskID, err)
+			_ = upstream.TaskFailed(taskCtx, &api.TaskFailedRequest{
+				TaskIdentification: api.OldTaskIdentification(taskID, agentAwareTask.ClientSecret),
+				Message:            err.Error(),
+			})
+
+			if err := inst.Close(ctx); err != nil {
+				worker.logger.Errorf("failed to close the instance for the task %s: %v", taskID, err)
+			}
+
+			// Release the task's resources since it will never run
+			cancel(err)
+			worker.tasks.Delete(taskID)
+
+			return
+		}
+
+		go worker.enforceDiskQuota(taskCtx, taskID, hostDirectoryInstance.UseHostWorkingDirectory(),
+			*worker.diskQuota, cancel)
+	}
+
+	// Record the image usage to prevent its eviction while the task is running
+	releaseImage := func() {}
+	if image, ok := imagemanager.ImageFromIsolation(agentAwareTask.Isolation); ok {
+		releaseImage = worker.imageManager.Use(image)
+	}
+
+	go func() {
+		defer releaseImage()
+
+		worker.runTask(taskCtx, upstream, inst, agentAwareTask.CliVersion,
+			taskID, agentAwareTask.ClientSecret, agentAwareTask.ServerSecret)
+	}()
 
 	worker.logger.Infof("started task %s", taskID)
 }
@@ -117,9 +157,23 @@ func (worker *Worker) getInstance(
 		}
 	}
 
+	// Make sure that the SSH inventory has a host for the task if it needs one,
+	// the host itself is leased when the task starts running and might need
+	// to wait for other tasks to release it
+	var sshRequest *sshinventory.Request
+
+	if sshIsolation := isolation.GetSsh(); sshIsolation != nil {
+		var err error
+
+		sshRequest, err = worker.sshInventory.Request(sshIsolation.Labels, resourcesToUse)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Otherwise proceed with creating a new instance
 	return persistentworker.New(isolation, worker.security,
-		worker.resourceModifierManager.Acquire(resourcesToUse), worker.tuning, worker.logger)
+		worker.resourceModifierManager.Acquire(resourcesToUse), worker.tuning, sshRequest, worker.logger)
 }
 
 func (worker *Worker) runTask(
@@ -216,7 +270,15 @@ func (worker *Worker) runTask(
 	}
 
 	err := inst.Run(ctx, &config)
-	if err != nil && !errors.Is(err, context.Canceled) && !errors.Is(ctx.Err(), context.Canceled) {
+
+	// Unlike the cancellations requested by the upstream, the cancellations
+	// caused by exceeding the disk quota need to be reported with a reason
+	quotaExceeded := errors.Is(context.Cause(ctx), ErrDiskQuotaExceeded)
+	if quotaExceeded {
+		err = context.Cause(ctx)
+	}
+
+	if err != nil && (quotaExceeded || !errors.Is(err, context.Canceled) && !errors.Is(ctx.Err(), context.Canceled)) {
 		worker.logger.Errorf("failed to run task %s: %v", taskID, err)
 
 		boundedCtx, cancel := context.WithTimeout(backgroundCtxWithSpan, perCallTimeout)
@@ -287,7 +349,7 @@ func (worker *Worker) runTask(
 
 func (worker *Worker) stopTask(taskID string) {
 	if task, ok := worker.tasks.Load(taskID); ok {
-		task.cancel()
+		task.cancel(nil)
 	}
 
 	worker.logger.Infof("sent cancellation signal to task %s", taskID)
@@ -315,7 +377,7 @@ func (worker *Worker) runningTasks(upstream *upstreampkg.Upstream) (result []str
 }
 
 func (worker *Worker) resourcesNotInUse() map[string]float64 {
-	result := maps.Clone(worker.userSpecifiedResources)
+	result := maps.Clone(worker.resources())
 
 	worker.tasks.Range(func(taskID string, task *Task) bool {
 		for key, value := range task.resourcesToUse {
@@ -355,7 +417,7 @@ func (worker *Worker) registerTaskCompletions() {
 		select {
 		case taskID := <-worker.taskCompletions:
 			if task, ok := worker.tasks.Load(taskID); ok {
-				task.cancel()
+				task.cancel(nil)
 				worker.tasks.Delete(taskID)
 				worker.logger.Infof("task %s completed", taskID)
 			} else {
@@ -24,3 +24,19 @@ func WithLogger(logger logrus.FieldLogger) Option {
 		upstream.logger = logger
 	}
 }
+
+// WithWeight sets the upstream's weight used for the fair scheduling
+// between multiple upstreams, the default weight is 1.
+func WithWeight(weight float64) Option {
+	return func(upstream *Upstream) {
+		upstream.weight = weight
+	}
+}
+
+// WithResourceQuota limits the amount of resources
+// that can be used by the tasks from this upstream.
+func WithResourceQuota(resourceQuota map[string]float64) Option {
+	return func(upstream *Upstream) {
+		upstream.resourceQuota = resourceQuota
+	}
+}
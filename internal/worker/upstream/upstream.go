@@ -45,6 +45,9 @@ type Upstream struct {
 
 	pollIntervalSeconds uint32
 
+	weight        float64
+	resourceQuota map[string]float64
+
 	logger logrus.FieldLogger
 
 	connected bool
@@ -57,6 +60,8 @@ func New(workerName string, registrationToken string, opts ...Option) (*Upstream
 
 		pollIntervalSeconds: defaultPollIntervalSeconds,
 
+		weight: 1,
+
 		logger: logrus.New(),
 	}
 
@@ -80,6 +85,9 @@ func New(workerName string, registrationToken string, opts ...Option) (*Upstream
 	if upstream.registrationToken == "" {
 		return nil, fmt.Errorf("%w: must provide a registration token", ErrFailed)
 	}
+	if upstream.weight <= 0 {
+		return nil, fmt.Errorf("%w: weight must be positive", ErrFailed)
+	}
 
 	// Parse endpoint
 	upstream.rpcTarget, upstream.rpcInsecure = grpchelper.TransportSettings(upstream.rpcEndpoint)
@@ -99,6 +107,14 @@ func (upstream *Upstream) PollIntervalSeconds() uint32 {
 	return upstream.pollIntervalSeconds
 }
 
+func (upstream *Upstream) Weight() float64 {
+	return upstream.weight
+}
+
+func (upstream *Upstream) ResourceQuota() map[string]float64 {
+	return upstream.resourceQuota
+}
+
 func (upstream *Upstream) Name() string {
 	return upstream.rpcEndpoint
 }
@@ -7,6 +7,7 @@ import (
 	"math"
 	"os"
 	"runtime"
+	"sync"
 	"time"
 
 	"github.com/cirruslabs/chacha/pkg/localnetworkhelper"
@@ -14,10 +15,13 @@ import (
 	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/persistentworker"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/persistentworker/isolation/tart"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/persistentworker/isolation/vetu"
+	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/persistentworker/pwdir"
 	"github.com/cirruslabs/cirrus-cli/internal/executor/instance/runconfig"
 	"github.com/cirruslabs/cirrus-cli/internal/version"
+	"github.com/cirruslabs/cirrus-cli/internal/worker/imagemanager"
 	"github.com/cirruslabs/cirrus-cli/internal/worker/resourcemodifier"
 	"github.com/cirruslabs/cirrus-cli/internal/worker/security"
+	"github.com/cirruslabs/cirrus-cli/internal/worker/sshinventory"
 	"github.com/cirruslabs/cirrus-cli/internal/worker/tuning"
 	upstreampkg "github.com/cirruslabs/cirrus-cli/internal/worker/upstream"
 	"github.com/cirruslabs/cirrus-cli/pkg/api"
@@ -31,6 +35,8 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+const upstreamCallTimeout = time.Minute
+
 var (
 	ErrInitializationFailed = errors.New("worker initialization failed")
 	ErrShutdown             = errors.New("worker is shutting down")
@@ -42,16 +48,26 @@ var (
 type Worker struct {
 	upstreams []*upstreampkg.Upstream
 
+	// Upstreams that had no tasks to start on their last poll and don't
+	// compete with the other upstreams for the worker's resources
+	idleUpstreams map[*upstreampkg.Upstream]bool
+
 	security                *security.Security
 	resourceModifierManager *resourcemodifier.Manager
 	tuning                  *tuning.Tuning
+	sshInventory            *sshinventory.Inventory
 
+	// Guards the user-specified labels and resources, which are replaced on
+	// reconfiguration while being read by the metric callbacks
+	userSpecifiedLock      sync.RWMutex
 	userSpecifiedLabels    map[string]string
 	userSpecifiedResources map[string]float64
 
 	tasks           *xsync.MapOf[string, *Task]
 	taskCompletions chan string
 
+	reconfigurations chan *reconfiguration
+
 	imagesCounter      metric.Int64Counter
 	tasksCounter       metric.Int64Counter
 	standbyHitCounter  metric.Int64Counter
@@ -65,12 +81,16 @@ type Worker struct {
 	standbyInstanceStartedAt time.Time
 
 	tartPrePull        *TartPrePull
+	imageManager       *imagemanager.Manager
+	imagesConfig       *imagemanager.Config
+	diskQuota          *DiskQuota
 	localNetworkHelper *localnetworkhelper.LocalNetworkHelper
 }
 
 func New(opts ...Option) (*Worker, error) {
 	worker := &Worker{
-		upstreams: []*upstreampkg.Upstream{},
+		upstreams:     []*upstreampkg.Upstream{},
+		idleUpstreams: map[*upstreampkg.Upstream]bool{},
 
 		security:                security.NoSecurity(),
 		resourceModifierManager: resourcemodifier.NewManager(),
@@ -80,6 +100,8 @@ func New(opts ...Option) (*Worker, error) {
 		tasks:           xsync.NewMapOf[string, *Task](),
 		taskCompletions: make(chan string),
 
+		reconfigurations: make(chan *reconfiguration),
+
 		logger:        logrus.New(),
 		echelonLogger: echelon.NewLogger(echelon.TraceLevel, renderers.NewSimpleRenderer(os.Stdout, nil)),
 	}
@@ -102,10 +124,21 @@ func New(opts ...Option) (*Worker, error) {
 	}
 	worker.imagesCounter = imagesCounter
 
+	worker.imageManager, err = imagemanager.New(
+		imagemanager.WithLogger(worker.logger),
+		imagemanager.WithMeter(meter),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to initialize image manager: %v",
+			ErrInitializationFailed, err)
+	}
+	worker.imageManager.SetConfig(worker.imagesConfig)
+	worker.pinStandbyImage()
+
 	return worker, nil
 }
 
-func (worker *Worker) info(workerName string) *api.WorkerInfo {
+func (worker *Worker) info(upstream *upstreampkg.Upstream) *api.WorkerInfo {
 	hostname, err := os.Hostname()
 	if err != nil {
 		hostname = ""
@@ -121,7 +154,7 @@ func (worker *Worker) info(workerName string) *api.WorkerInfo {
 
 	// Create base labels
 	labels := map[string]string{
-		ReservedLabelName:         workerName,
+		ReservedLabelName:         upstream.WorkerName(),
 		ReservedLabelVersion:      version.FullVersion,
 		ReservedLabelHostname:     hostname,
 		ReservedLabelOS:           runtime.GOOS,
@@ -129,13 +162,15 @@ func (worker *Worker) info(workerName string) *api.WorkerInfo {
 	}
 
 	// Merge with the user specified labels
-	for key, value := range worker.userSpecifiedLabels {
+	for key, value := range worker.labels() {
 		labels[key] = value
 	}
 
+	resourcesTotal, _ := worker.upstreamCapacity(upstream)
+
 	return &api.WorkerInfo{
 		Labels:         labels,
-		ResourcesTotal: worker.userSpecifiedResources,
+		ResourcesTotal: resourcesTotal,
 	}
 }
 
@@ -210,10 +245,18 @@ func (worker *Worker) Run(ctx context.Context) error {
 		}
 	}
 
+	// Remove the working directories left behind by the previous worker process
+	if err := pwdir.Cleanup(); err != nil {
+		worker.logger.Warnf("failed to cleanup stale working directories: %v", err)
+	}
+
 	// A sub-context to cancel out all Run() side-effects when it finishes
 	subCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	// Pre-pull and garbage-collect the images in the background
+	go worker.imageManager.Run(subCtx)
+
 	for {
 		if err := worker.pollUpstreams(subCtx); err != nil {
 			if errors.Is(err, ErrShutdown) {
@@ -224,6 +267,8 @@ func (worker *Worker) Run(ctx context.Context) error {
 		select {
 		case <-subCtx.Done():
 			return nil
+		case reconfiguration := <-worker.reconfigurations:
+			worker.applyReconfiguration(subCtx, reconfiguration)
 		case <-time.After(time.Duration(worker.pollIntervalSeconds()) * time.Second):
 			// continue the loop
 		}
@@ -257,7 +302,7 @@ func (worker *Worker) tryCreateStandby(ctx context.Context) error {
 	worker.logger.Debugf("creating a new standby instance with isolation %s", worker.standbyParameters.Isolation)
 
 	standbyInstance, err := persistentworker.New(worker.standbyParameters.Isolation, worker.security,
-		worker.resourceModifierManager.Acquire(worker.standbyParameters.Resources), worker.tuning, worker.logger)
+		worker.resourceModifierManager.Acquire(worker.standbyParameters.Resources), worker.tuning, nil, worker.logger)
 	if err != nil {
 		worker.logger.Errorf("failed to create a standby instance: %v", err)
 
@@ -289,6 +334,12 @@ func (worker *Worker) tryCreateStandby(ctx context.Context) error {
 		worker.tartPrePull.LastCheck = time.Now()
 	}
 
+	// Track the standby instance's image, so that it can be evicted once
+	// it's no longer used by the standby instance and stays unused
+	if image, ok := imagemanager.ImageFromIsolation(worker.standbyParameters.Isolation); ok {
+		worker.imageManager.Use(image)()
+	}
+
 	worker.logger.Debugf("warming-up the standby instance")
 
 	runConfig := &runconfig.RunConfig{
@@ -323,18 +374,31 @@ func (worker *Worker) pollUpstreams(ctx context.Context) error {
 		return standbyErr
 	}
 
-	for _, upstream := range worker.upstreams {
+	// Poll the upstreams that are the furthest behind their fair share first,
+	// and don't let a single failing upstream prevent polling the others
+	var pollErrs []error
+
+	for _, upstream := range worker.upstreamsInFairOrder() {
 		if pollErr := worker.pollSingleUpstream(ctx, upstream); pollErr != nil {
+			if errors.Is(pollErr, ErrShutdown) {
+				return pollErr
+			}
+
 			worker.logger.Errorf("failed to poll the upstream %s: %v", upstream.Name(), pollErr)
-			return pollErr
+			pollErrs = append(pollErrs, pollErr)
 		}
 	}
 
-	return nil
+	return errors.Join(pollErrs...)
 }
 
 func (worker *Worker) pollSingleUpstream(ctx context.Context, upstream *upstreampkg.Upstream) error {
-	if err := upstream.Register(ctx, worker.info(upstream.WorkerName())); err != nil {
+	// Bound the calls to the upstream to not let a hanging upstream delay polling the others,
+	// note that we can't bound the whole function because the tasks inherit the context
+	registerCtx, registerCancel := context.WithTimeout(ctx, upstreamCallTimeout)
+	defer registerCancel()
+
+	if err := upstream.Register(registerCtx, worker.info(upstream)); err != nil {
 		worker.logger.Errorf("failed to register worker with the upstream %s: %v",
 			upstream.Name(), err)
 
@@ -344,11 +408,13 @@ func (worker *Worker) pollSingleUpstream(ctx context.Context, upstream *upstream
 	// De-register completed tasks
 	worker.registerTaskCompletions()
 
+	_, resourcesInUse := worker.upstreamCapacity(upstream)
+
 	request := &api.PollRequest{
-		WorkerInfo:      worker.info(upstream.WorkerName()),
+		WorkerInfo:      worker.info(upstream),
 		RunningTasks:    worker.runningTasks(upstream),
 		OldRunningTasks: worker.oldRunningTasks(upstream),
-		ResourcesInUse:  worker.resourcesInUse(),
+		ResourcesInUse:  resourcesInUse,
 	}
 
 	if worker.standbyInstance != nil {
@@ -360,7 +426,10 @@ func (worker *Worker) pollSingleUpstream(ctx context.Context, upstream *upstream
 		}
 	}
 
-	response, err := upstream.Poll(ctx, request)
+	pollCtx, pollCancel := context.WithTimeout(ctx, upstreamCallTimeout)
+	defer pollCancel()
+
+	response, err := upstream.Poll(pollCtx, request)
 	if err != nil {
 		return err
 	}
@@ -373,6 +442,8 @@ func (worker *Worker) pollSingleUpstream(ctx context.Context, upstream *upstream
 		worker.stopTask(fmt.Sprintf("%d", taskToStop))
 	}
 
+	worker.updateIdleness(upstream, len(response.TasksToStart))
+
 	for _, taskToStart := range response.TasksToStart {
 		worker.startTask(ctx, upstream, taskToStart)
 	}
@@ -467,4 +538,14 @@ func (worker *Worker) UpdateStandby(ctx context.Context, parameters *api.Standby
 	}
 
 	worker.standbyParameters = parameters
+	worker.pinStandbyImage()
+}
+
+// pinStandbyImage prevents the image of the currently configured standby instance from being evicted.
+func (worker *Worker) pinStandbyImage() {
+	if image, ok := imagemanager.ImageFromIsolation(worker.standbyParameters.GetIsolation()); ok {
+		worker.imageManager.SetStandbyImage(&image)
+	} else {
+		worker.imageManager.SetStandbyImage(nil)
+	}
 }
@@ -0,0 +1,800 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.3
+// 	protoc        (unknown)
+// source: api/terminal/terminal.proto
+
+// Messages of the protocol used by the github.com/cirruslabs/terminal hosts to talk to the terminal server.
+//
+// The upstream definitions are package-less, so we declare them in a separate package to avoid conflicting
+// with the upstream definitions in the protobuf registry (they're linked into the same binary via the agent)
+// and register the HostService manually under its upstream name. Only the field numbers and types need to
+// match for the wire compatibility.
+
+package terminal
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type HostControlRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Operation:
+	//
+	//	*HostControlRequest_Hello_
+	Operation     isHostControlRequest_Operation `protobuf_oneof:"operation"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HostControlRequest) Reset() {
+	*x = HostControlRequest{}
+	mi := &file_api_terminal_terminal_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostControlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostControlRequest) ProtoMessage() {}
+
+func (x *HostControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_terminal_terminal_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostControlRequest.ProtoReflect.Descriptor instead.
+func (*HostControlRequest) Descriptor() ([]byte, []int) {
+	return file_api_terminal_terminal_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HostControlRequest) GetOperation() isHostControlRequest_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *HostControlRequest) GetHello() *HostControlRequest_Hello {
+	if x != nil {
+		if x, ok := x.Operation.(*HostControlRequest_Hello_); ok {
+			return x.Hello
+		}
+	}
+	return nil
+}
+
+type isHostControlRequest_Operation interface {
+	isHostControlRequest_Operation()
+}
+
+type HostControlRequest_Hello_ struct {
+	// Mandatory first message from the Host after it opens this channel
+	Hello *HostControlRequest_Hello `protobuf:"bytes,1,opt,name=hello,proto3,oneof"`
+}
+
+func (*HostControlRequest_Hello_) isHostControlRequest_Operation() {}
+
+type HostControlResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Operation:
+	//
+	//	*HostControlResponse_Hello_
+	//	*HostControlResponse_DataChannelRequest_
+	Operation     isHostControlResponse_Operation `protobuf_oneof:"operation"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HostControlResponse) Reset() {
+	*x = HostControlResponse{}
+	mi := &file_api_terminal_terminal_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostControlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostControlResponse) ProtoMessage() {}
+
+func (x *HostControlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_terminal_terminal_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostControlResponse.ProtoReflect.Descriptor instead.
+func (*HostControlResponse) Descriptor() ([]byte, []int) {
+	return file_api_terminal_terminal_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HostControlResponse) GetOperation() isHostControlResponse_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *HostControlResponse) GetHello() *HostControlResponse_Hello {
+	if x != nil {
+		if x, ok := x.Operation.(*HostControlResponse_Hello_); ok {
+			return x.Hello
+		}
+	}
+	return nil
+}
+
+func (x *HostControlResponse) GetDataChannelRequest() *HostControlResponse_DataChannelRequest {
+	if x != nil {
+		if x, ok := x.Operation.(*HostControlResponse_DataChannelRequest_); ok {
+			return x.DataChannelRequest
+		}
+	}
+	return nil
+}
+
+type isHostControlResponse_Operation interface {
+	isHostControlResponse_Operation()
+}
+
+type HostControlResponse_Hello_ struct {
+	// Mandatory reply to the Hello message sent from the Host
+	Hello *HostControlResponse_Hello `protobuf:"bytes,1,opt,name=hello,proto3,oneof"`
+}
+
+type HostControlResponse_DataChannelRequest_ struct {
+	// Emitted when a Guest opens a new terminal channel
+	DataChannelRequest *HostControlResponse_DataChannelRequest `protobuf:"bytes,2,opt,name=data_channel_request,json=dataChannelRequest,proto3,oneof"`
+}
+
+func (*HostControlResponse_Hello_) isHostControlResponse_Operation() {}
+
+func (*HostControlResponse_DataChannelRequest_) isHostControlResponse_Operation() {}
+
+type HostDataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Operation:
+	//
+	//	*HostDataRequest_Hello_
+	//	*HostDataRequest_Output
+	Operation     isHostDataRequest_Operation `protobuf_oneof:"operation"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HostDataRequest) Reset() {
+	*x = HostDataRequest{}
+	mi := &file_api_terminal_terminal_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostDataRequest) ProtoMessage() {}
+
+func (x *HostDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_terminal_terminal_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostDataRequest.ProtoReflect.Descriptor instead.
+func (*HostDataRequest) Descriptor() ([]byte, []int) {
+	return file_api_terminal_terminal_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HostDataRequest) GetOperation() isHostDataRequest_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *HostDataRequest) GetHello() *HostDataRequest_Hello {
+	if x != nil {
+		if x, ok := x.Operation.(*HostDataRequest_Hello_); ok {
+			return x.Hello
+		}
+	}
+	return nil
+}
+
+func (x *HostDataRequest) GetOutput() *Data {
+	if x != nil {
+		if x, ok := x.Operation.(*HostDataRequest_Output); ok {
+			return x.Output
+		}
+	}
+	return nil
+}
+
+type isHostDataRequest_Operation interface {
+	isHostDataRequest_Operation()
+}
+
+type HostDataRequest_Hello_ struct {
+	// Mandatory first message to be sent by the Host
+	Hello *HostDataRequest_Hello `protobuf:"bytes,1,opt,name=hello,proto3,oneof"`
+}
+
+type HostDataRequest_Output struct {
+	// Terminal output to the Guest
+	Output *Data `protobuf:"bytes,2,opt,name=output,proto3,oneof"`
+}
+
+func (*HostDataRequest_Hello_) isHostDataRequest_Operation() {}
+
+func (*HostDataRequest_Output) isHostDataRequest_Operation() {}
+
+type HostDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Operation:
+	//
+	//	*HostDataResponse_ChangeDimensions
+	//	*HostDataResponse_Input
+	Operation     isHostDataResponse_Operation `protobuf_oneof:"operation"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HostDataResponse) Reset() {
+	*x = HostDataResponse{}
+	mi := &file_api_terminal_terminal_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostDataResponse) ProtoMessage() {}
+
+func (x *HostDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_terminal_terminal_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostDataResponse.ProtoReflect.Descriptor instead.
+func (*HostDataResponse) Descriptor() ([]byte, []int) {
+	return file_api_terminal_terminal_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HostDataResponse) GetOperation() isHostDataResponse_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *HostDataResponse) GetChangeDimensions() *TerminalDimensions {
+	if x != nil {
+		if x, ok := x.Operation.(*HostDataResponse_ChangeDimensions); ok {
+			return x.ChangeDimensions
+		}
+	}
+	return nil
+}
+
+func (x *HostDataResponse) GetInput() *Data {
+	if x != nil {
+		if x, ok := x.Operation.(*HostDataResponse_Input); ok {
+			return x.Input
+		}
+	}
+	return nil
+}
+
+type isHostDataResponse_Operation interface {
+	isHostDataResponse_Operation()
+}
+
+type HostDataResponse_ChangeDimensions struct {
+	// Emitted when the Guest decides to change an already created terminal dimensions
+	ChangeDimensions *TerminalDimensions `protobuf:"bytes,1,opt,name=change_dimensions,json=changeDimensions,proto3,oneof"`
+}
+
+type HostDataResponse_Input struct {
+	// Terminal input from the Guest
+	Input *Data `protobuf:"bytes,2,opt,name=input,proto3,oneof"`
+}
+
+func (*HostDataResponse_ChangeDimensions) isHostDataResponse_Operation() {}
+
+func (*HostDataResponse_Input) isHostDataResponse_Operation() {}
+
+type TerminalDimensions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WidthColumns  uint32                 `protobuf:"varint,1,opt,name=width_columns,json=widthColumns,proto3" json:"width_columns,omitempty"`
+	HeightRows    uint32                 `protobuf:"varint,2,opt,name=height_rows,json=heightRows,proto3" json:"height_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalDimensions) Reset() {
+	*x = TerminalDimensions{}
+	mi := &file_api_terminal_terminal_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalDimensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalDimensions) ProtoMessage() {}
+
+func (x *TerminalDimensions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_terminal_terminal_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalDimensions.ProtoReflect.Descriptor instead.
+func (*TerminalDimensions) Descriptor() ([]byte, []int) {
+	return file_api_terminal_terminal_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TerminalDimensions) GetWidthColumns() uint32 {
+	if x != nil {
+		return x.WidthColumns
+	}
+	return 0
+}
+
+func (x *TerminalDimensions) GetHeightRows() uint32 {
+	if x != nil {
+		return x.HeightRows
+	}
+	return 0
+}
+
+type Data struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data) Reset() {
+	*x = Data{}
+	mi := &file_api_terminal_terminal_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data) ProtoMessage() {}
+
+func (x *Data) ProtoReflect() protoreflect.Message {
+	mi := &file_api_terminal_terminal_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data.ProtoReflect.Descriptor instead.
+func (*Data) Descriptor() ([]byte, []int) {
+	return file_api_terminal_terminal_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Data) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type HostControlRequest_Hello struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Symmetric key, the knowledge of which by the Guest can be used to spawn a new terminal on to this Host
+	TrustedSecret string `protobuf:"bytes,1,opt,name=trusted_secret,json=trustedSecret,proto3" json:"trusted_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HostControlRequest_Hello) Reset() {
+	*x = HostControlRequest_Hello{}
+	mi := &file_api_terminal_terminal_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostControlRequest_Hello) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostControlRequest_Hello) ProtoMessage() {}
+
+func (x *HostControlRequest_Hello) ProtoReflect() protoreflect.Message {
+	mi := &file_api_terminal_terminal_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostControlRequest_Hello.ProtoReflect.Descriptor instead.
+func (*HostControlRequest_Hello) Descriptor() ([]byte, []int) {
+	return file_api_terminal_terminal_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *HostControlRequest_Hello) GetTrustedSecret() string {
+	if x != nil {
+		return x.TrustedSecret
+	}
+	return ""
+}
+
+type HostControlResponse_Hello struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A unique identifier that the HostService assigns to this Host
+	Locator       string `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HostControlResponse_Hello) Reset() {
+	*x = HostControlResponse_Hello{}
+	mi := &file_api_terminal_terminal_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostControlResponse_Hello) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostControlResponse_Hello) ProtoMessage() {}
+
+func (x *HostControlResponse_Hello) ProtoReflect() protoreflect.Message {
+	mi := &file_api_terminal_terminal_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostControlResponse_Hello.ProtoReflect.Descriptor instead.
+func (*HostControlResponse_Hello) Descriptor() ([]byte, []int) {
+	return file_api_terminal_terminal_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *HostControlResponse_Hello) GetLocator() string {
+	if x != nil {
+		return x.Locator
+	}
+	return ""
+}
+
+type HostControlResponse_DataChannelRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Token that can be used to create a new data channel
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Dimensions of the new terminal that will be created and attached to the data channel
+	RequestedDimensions *TerminalDimensions `protobuf:"bytes,3,opt,name=requested_dimensions,json=requestedDimensions,proto3" json:"requested_dimensions,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *HostControlResponse_DataChannelRequest) Reset() {
+	*x = HostControlResponse_DataChannelRequest{}
+	mi := &file_api_terminal_terminal_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostControlResponse_DataChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostControlResponse_DataChannelRequest) ProtoMessage() {}
+
+func (x *HostControlResponse_DataChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_terminal_terminal_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostControlResponse_DataChannelRequest.ProtoReflect.Descriptor instead.
+func (*HostControlResponse_DataChannelRequest) Descriptor() ([]byte, []int) {
+	return file_api_terminal_terminal_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *HostControlResponse_DataChannelRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *HostControlResponse_DataChannelRequest) GetRequestedDimensions() *TerminalDimensions {
+	if x != nil {
+		return x.RequestedDimensions
+	}
+	return nil
+}
+
+type HostDataRequest_Hello struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Host's locator
+	Locator string `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator,omitempty"`
+	// Token provided to the Host in DataChannelRequest
+	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HostDataRequest_Hello) Reset() {
+	*x = HostDataRequest_Hello{}
+	mi := &file_api_terminal_terminal_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostDataRequest_Hello) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostDataRequest_Hello) ProtoMessage() {}
+
+func (x *HostDataRequest_Hello) ProtoReflect() protoreflect.Message {
+	mi := &file_api_terminal_terminal_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostDataRequest_Hello.ProtoReflect.Descriptor instead.
+func (*HostDataRequest_Hello) Descriptor() ([]byte, []int) {
+	return file_api_terminal_terminal_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *HostDataRequest_Hello) GetLocator() string {
+	if x != nil {
+		return x.Locator
+	}
+	return ""
+}
+
+func (x *HostDataRequest_Hello) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+var File_api_terminal_terminal_proto protoreflect.FileDescriptor
+
+var file_api_terminal_terminal_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2f, 0x74,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x63,
+	0x69, 0x72, 0x72, 0x75, 0x73, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x22, 0x98, 0x01, 0x0a, 0x12, 0x48, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x05, 0x68, 0x65, 0x6c,
+	0x6c, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x75,
+	0x73, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2e, 0x48,
+	0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f,
+	0x1a, 0x2e, 0x0a, 0x05, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x75,
+	0x73, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x42, 0x0b, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x87, 0x03,
+	0x0a, 0x13, 0x48, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x75, 0x73, 0x6c, 0x61, 0x62,
+	0x73, 0x2e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x48,
+	0x65, 0x6c, 0x6c, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x6f, 0x0a,
+	0x14, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x75, 0x73, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x6c, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x64, 0x61, 0x74, 0x61,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x0a, 0x05, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x1a, 0x86, 0x01, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x5a,
+	0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x6d, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x69, 0x72, 0x72, 0x75, 0x73, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x44, 0x69, 0x6d, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd0, 0x01, 0x0a, 0x0f, 0x48, 0x6f, 0x73, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x05, 0x68,
+	0x65, 0x6c, 0x6c, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x69, 0x72,
+	0x72, 0x75, 0x73, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c,
+	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x12,
+	0x33, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x75, 0x73, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x74, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x1a, 0x37, 0x0a, 0x05, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x18, 0x0a,
+	0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0b, 0x0a,
+	0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xaa, 0x01, 0x0a, 0x10, 0x48,
+	0x6f, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x56, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x69, 0x72,
+	0x72, 0x75, 0x73, 0x6c, 0x61, 0x62, 0x73, 0x2e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c,
+	0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x6d,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x75, 0x73, 0x6c,
+	0x61, 0x62, 0x73, 0x2e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5a, 0x0a, 0x12, 0x54, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x6c, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x77, 0x69, 0x64, 0x74, 0x68, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x72, 0x6f, 0x77,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52,
+	0x6f, 0x77, 0x73, 0x22, 0x1a, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42,
+	0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x69,
+	0x72, 0x72, 0x75, 0x73, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x63, 0x69, 0x72, 0x72, 0x75, 0x73, 0x2d,
+	0x63, 0x6c, 0x69, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_terminal_terminal_proto_rawDescOnce sync.Once
+	file_api_terminal_terminal_proto_rawDescData = file_api_terminal_terminal_proto_rawDesc
+)
+
+func file_api_terminal_terminal_proto_rawDescGZIP() []byte {
+	file_api_terminal_terminal_proto_rawDescOnce.Do(func() {
+		file_api_terminal_terminal_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_terminal_terminal_proto_rawDescData)
+	})
+	return file_api_terminal_terminal_proto_rawDescData
+}
+
+var file_api_terminal_terminal_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_terminal_terminal_proto_goTypes = []any{
+	(*HostControlRequest)(nil),                     // 0: cirruslabs.terminal.HostControlRequest
+	(*HostControlResponse)(nil),                    // 1: cirruslabs.terminal.HostControlResponse
+	(*HostDataRequest)(nil),                        // 2: cirruslabs.terminal.HostDataRequest
+	(*HostDataResponse)(nil),                       // 3: cirruslabs.terminal.HostDataResponse
+	(*TerminalDimensions)(nil),                     // 4: cirruslabs.terminal.TerminalDimensions
+	(*Data)(nil),                                   // 5: cirruslabs.terminal.Data
+	(*HostControlRequest_Hello)(nil),               // 6: cirruslabs.terminal.HostControlRequest.Hello
+	(*HostControlResponse_Hello)(nil),              // 7: cirruslabs.terminal.HostControlResponse.Hello
+	(*HostControlResponse_DataChannelRequest)(nil), // 8: cirruslabs.terminal.HostControlResponse.DataChannelRequest
+	(*HostDataRequest_Hello)(nil),                  // 9: cirruslabs.terminal.HostDataRequest.Hello
+}
+var file_api_terminal_terminal_proto_depIdxs = []int32{
+	6, // 0: cirruslabs.terminal.HostControlRequest.hello:type_name -> cirruslabs.terminal.HostControlRequest.Hello
+	7, // 1: cirruslabs.terminal.HostControlResponse.hello:type_name -> cirruslabs.terminal.HostControlResponse.Hello
+	8, // 2: cirruslabs.terminal.HostControlResponse.data_channel_request:type_name -> cirruslabs.terminal.HostControlResponse.DataChannelRequest
+	9, // 3: cirruslabs.terminal.HostDataRequest.hello:type_name -> cirruslabs.terminal.HostDataRequest.Hello
+	5, // 4: cirruslabs.terminal.HostDataRequest.output:type_name -> cirruslabs.terminal.Data
+	4, // 5: cirruslabs.terminal.HostDataResponse.change_dimensions:type_name -> cirruslabs.terminal.TerminalDimensions
+	5, // 6: cirruslabs.terminal.HostDataResponse.input:type_name -> cirruslabs.terminal.Data
+	4, // 7: cirruslabs.terminal.HostControlResponse.DataChannelRequest.requested_dimensions:type_name -> cirruslabs.terminal.TerminalDimensions
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_api_terminal_terminal_proto_init() }
+func file_api_terminal_terminal_proto_init() {
+	if File_api_terminal_terminal_proto != nil {
+		return
+	}
+	file_api_terminal_terminal_proto_msgTypes[0].OneofWrappers = []any{
+		(*HostControlRequest_Hello_)(nil),
+	}
+	file_api_terminal_terminal_proto_msgTypes[1].OneofWrappers = []any{
+		(*HostControlResponse_Hello_)(nil),
+		(*HostControlResponse_DataChannelRequest_)(nil),
+	}
+	file_api_terminal_terminal_proto_msgTypes[2].OneofWrappers = []any{
+		(*HostDataRequest_Hello_)(nil),
+		(*HostDataRequest_Output)(nil),
+	}
+	file_api_terminal_terminal_proto_msgTypes[3].OneofWrappers = []any{
+		(*HostDataResponse_ChangeDimensions)(nil),
+		(*HostDataResponse_Input)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_terminal_terminal_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_terminal_terminal_proto_goTypes,
+		DependencyIndexes: file_api_terminal_terminal_proto_depIdxs,
+		MessageInfos:      file_api_terminal_terminal_proto_msgTypes,
+	}.Build()
+	File_api_terminal_terminal_proto = out.File
+	file_api_terminal_terminal_proto_rawDesc = nil
+	file_api_terminal_terminal_proto_goTypes = nil
+	file_api_terminal_terminal_proto_depIdxs = nil
+}
@@ -0,0 +1,356 @@
+package formatter
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// The repeated nodes smaller than this (in scalars) are not worth an anchor.
+const minCollapsibleWeight = 4
+
+var anchorUnsafeRegex = regexp.MustCompile("[^A-Za-z0-9_-]")
+
+// expandAnchors replaces the aliases with copies of their anchored nodes and inlines
+// the "<<" merge keys when that doesn't change the result of the merge, leaving only
+// the anchors that are still referenced.
+func expandAnchors(root *yaml.Node) {
+	// Recursive aliases can't be expanded (and are rejected by the parser anyway)
+	if hasRecursiveAlias(root, map[*yaml.Node]struct{}{}, map[*yaml.Node]struct{}{}) {
+		return
+	}
+
+	expandAliases(root)
+
+	referenced := map[*yaml.Node]struct{}{}
+	walk(root, func(node *yaml.Node) {
+		if node.Kind == yaml.AliasNode {
+			referenced[node.Alias] = struct{}{}
+		}
+	})
+
+	walk(root, func(node *yaml.Node) {
+		if _, ok := referenced[node]; !ok {
+			node.Anchor = ""
+		}
+	})
+}
+
+func hasRecursiveAlias(node *yaml.Node, onPath map[*yaml.Node]struct{}, acyclic map[*yaml.Node]struct{}) bool {
+	if _, ok := acyclic[node]; ok {
+		return false
+	}
+
+	if _, ok := onPath[node]; ok {
+		return true
+	}
+
+	onPath[node] = struct{}{}
+	defer delete(onPath, node)
+
+	children := node.Content
+	if node.Kind == yaml.AliasNode {
+		children = []*yaml.Node{node.Alias}
+	}
+
+	for _, child := range children {
+		if hasRecursiveAlias(child, onPath, acyclic) {
+			return true
+		}
+	}
+
+	acyclic[node] = struct{}{}
+
+	return false
+}
+
+func expandAliases(node *yaml.Node) {
+	for i, child := range node.Content {
+		isMergeValue := node.Kind == yaml.MappingNode && i%2 == 1 && node.Content[i-1].Tag == "!!merge"
+
+		if child.Kind == yaml.AliasNode && !isMergeValue {
+			node.Content[i] = deepCopy(child.Alias)
+		}
+
+		if !isMergeValue {
+			expandAliases(node.Content[i])
+		}
+	}
+
+	if node.Kind == yaml.MappingNode {
+		inlineMerges(node)
+	}
+}
+
+// inlineMerges replaces the "<<" merge keys with the merged entries. The keys already
+// present in the mapping might or might not be overridden depending on whether the field
+// is collectible, so such merges are left as is.
+func inlineMerges(mapping *yaml.Node) {
+	var result []*yaml.Node
+
+	seen := map[string]struct{}{}
+
+	for i := 0; i < len(mapping.Content); i += 2 {
+		key, value := mapping.Content[i], mapping.Content[i+1]
+
+		if key.Tag != "!!merge" {
+			seen[key.Value] = struct{}{}
+			result = append(result, key, value)
+
+			continue
+		}
+
+		if merged, ok := mergedEntries(value, seen); ok {
+			result = append(result, merged...)
+		} else {
+			result = append(result, key, value)
+		}
+	}
+
+	mapping.Content = result
+}
+
+func mergedEntries(value *yaml.Node, seen map[string]struct{}) ([]*yaml.Node, bool) {
+	var sources []*yaml.Node
+
+	switch value.Kind {
+	case yaml.AliasNode:
+		sources = []*yaml.Node{value}
+	case yaml.SequenceNode:
+		sources = value.Content
+	default:
+		return nil, false
+	}
+
+	var result []*yaml.Node
+
+	newlySeen := map[string]struct{}{}
+
+	for _, source := range sources {
+		if source.Kind != yaml.AliasNode || source.Alias.Kind != yaml.MappingNode {
+			return nil, false
+		}
+
+		merged := deepCopy(source.Alias)
+		expandAliases(merged)
+
+		for i := 0; i < len(merged.Content); i += 2 {
+			key := merged.Content[i]
+
+			_, alreadySeen := seen[key.Value]
+			_, alreadyMerged := newlySeen[key.Value]
+
+			if key.Tag == "!!merge" || alreadySeen || alreadyMerged {
+				return nil, false
+			}
+
+			newlySeen[key.Value] = struct{}{}
+			result = append(result, key, merged.Content[i+1])
+		}
+	}
+
+	for key := range newlySeen {
+		seen[key] = struct{}{}
+	}
+
+	return result, true
+}
+
+// collapseAnchors replaces the repeated mappings and sequences with aliases
+// to their first occurrence, starting from the largest ones.
+func collapseAnchors(root *yaml.Node) {
+	type group struct {
+		nodes  []*yaml.Node
+		keys   []string
+		weight int
+	}
+
+	var groups []*group
+
+	byFingerprint := map[string]*group{}
+	anchors := map[string]struct{}{}
+
+	walk(root, func(node *yaml.Node) {
+		if node.Anchor != "" {
+			anchors[node.Anchor] = struct{}{}
+		}
+	})
+
+	// Top-level entries are not collapsed, since these are tasks and global fields
+	for i := 0; i < len(root.Content); i += 2 {
+		walkEntries(root.Content[i+1], func(key *yaml.Node, value *yaml.Node) {
+			if key.Tag == "!!merge" || !isCollapsible(value) {
+				return
+			}
+
+			weight := weigh(value)
+			if weight < minCollapsibleWeight {
+				return
+			}
+
+			fingerprint := fingerprint(value)
+
+			existing, ok := byFingerprint[fingerprint]
+			if !ok {
+				existing = &group{weight: weight}
+				byFingerprint[fingerprint] = existing
+				groups = append(groups, existing)
+			}
+
+			existing.nodes = append(existing.nodes, value)
+			existing.keys = append(existing.keys, key.Value)
+		})
+	}
+
+	slices.SortStableFunc(groups, func(a, b *group) int {
+		return cmp.Compare(b.weight, a.weight)
+	})
+
+	replaced := map[*yaml.Node]struct{}{}
+
+	for _, group := range groups {
+		var nodes []*yaml.Node
+
+		for _, node := range group.nodes {
+			if _, ok := replaced[node]; !ok && !containsAnchor(node) {
+				nodes = append(nodes, node)
+			}
+		}
+
+		if len(nodes) < 2 {
+			continue
+		}
+
+		anchored := nodes[0]
+		anchored.Anchor = uniqueAnchor(anchors, group.keys[slices.Index(group.nodes, anchored)])
+
+		for _, node := range nodes[1:] {
+			walk(node, func(descendant *yaml.Node) {
+				replaced[descendant] = struct{}{}
+			})
+
+			// Replace the node in-place, since the parent's reference is not known here
+			*node = yaml.Node{
+				Kind:  yaml.AliasNode,
+				Value: anchored.Anchor,
+				Alias: anchored,
+			}
+		}
+	}
+}
+
+// isCollapsible checks that the node is a mapping or a sequence that
+// doesn't contain any anchors, aliases or comments that would be lost.
+func isCollapsible(node *yaml.Node) bool {
+	if node.Kind != yaml.MappingNode && node.Kind != yaml.SequenceNode {
+		return false
+	}
+
+	collapsible := true
+
+	walk(node, func(descendant *yaml.Node) {
+		if descendant.Anchor != "" || descendant.Kind == yaml.AliasNode || hasComments(descendant) {
+			collapsible = false
+		}
+	})
+
+	return collapsible
+}
+
+func containsAnchor(node *yaml.Node) bool {
+	var found bool
+
+	walk(node, func(descendant *yaml.Node) {
+		if descendant.Anchor != "" {
+			found = true
+		}
+	})
+
+	return found
+}
+
+func weigh(node *yaml.Node) int {
+	var weight int
+
+	walk(node, func(descendant *yaml.Node) {
+		if descendant.Kind == yaml.ScalarNode {
+			weight++
+		}
+	})
+
+	return weight
+}
+
+func fingerprint(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return node.ShortTag() + ":" + strconv.Quote(node.Value)
+	default:
+		var children []string
+
+		for _, child := range node.Content {
+			children = append(children, fingerprint(child))
+		}
+
+		return fmt.Sprintf("%d[%s]", node.Kind, strings.Join(children, ","))
+	}
+}
+
+func uniqueAnchor(anchors map[string]struct{}, key string) string {
+	base := anchorUnsafeRegex.ReplaceAllString(key, "_")
+	if base == "" {
+		base = "anchor"
+	}
+
+	anchor := base
+
+	for i := 2; ; i++ {
+		if _, ok := anchors[anchor]; !ok {
+			break
+		}
+
+		anchor = fmt.Sprintf("%s_%d", base, i)
+	}
+
+	anchors[anchor] = struct{}{}
+
+	return anchor
+}
+
+func deepCopy(node *yaml.Node) *yaml.Node {
+	copied := *node
+	copied.Anchor = ""
+	copied.Content = nil
+
+	for _, child := range node.Content {
+		copied.Content = append(copied.Content, deepCopy(child))
+	}
+
+	return &copied
+}
+
+// walk visits the node and its descendants, without following the aliases.
+func walk(node *yaml.Node, visit func(node *yaml.Node)) {
+	visit(node)
+
+	for _, child := range node.Content {
+		walk(child, visit)
+	}
+}
+
+// walkEntries visits the mapping entries in the node and its descendants
+// in the document order, without following the aliases.
+func walkEntries(node *yaml.Node, visit func(key *yaml.Node, value *yaml.Node)) {
+	for i, child := range node.Content {
+		if node.Kind == yaml.MappingNode && i%2 == 1 {
+			visit(node.Content[i-1], child)
+		}
+
+		walkEntries(child, visit)
+	}
+}
@@ -0,0 +1,147 @@
+// Package formatter rewrites the Cirrus CI YAML configuration into a canonical form,
+// while keeping the comments and anchors that are not affected by the formatting.
+package formatter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var ErrFormat = errors.New("failed to format the configuration")
+
+const indent = 2
+
+var taskRegex = regexp.MustCompile("^(.*)(task|pipe|docker_builder)$")
+
+type Formatter struct {
+	anchors Anchors
+}
+
+func New(opts ...Option) *Formatter {
+	formatter := &Formatter{
+		anchors: AnchorsKeep,
+	}
+
+	for _, opt := range opts {
+		opt(formatter)
+	}
+
+	return formatter
+}
+
+// Format returns the canonical form of the YAML configuration.
+func (formatter *Formatter) Format(source []byte) ([]byte, error) {
+	var document yaml.Node
+
+	if err := yaml.Unmarshal(source, &document); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrFormat, err)
+	}
+
+	// Empty document
+	if document.Kind == 0 || len(document.Content) == 0 {
+		return source, nil
+	}
+
+	root := document.Content[0]
+	if document.Kind != yaml.DocumentNode || len(document.Content) != 1 || root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("%w: YAML document should contain a mapping as it's top-level element", ErrFormat)
+	}
+
+	if formatter.anchors == AnchorsExpand {
+		expandAnchors(root)
+	}
+
+	for i := 0; i < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+
+		if taskRegex.MatchString(key.Value) && value.Kind == yaml.MappingNode {
+			formatTask(value)
+		}
+	}
+
+	if formatter.anchors == AnchorsCollapse {
+		collapseAnchors(root)
+	}
+
+	walk(&document, fixFoldedScalar)
+	walk(&document, fixMergeKey)
+
+	var buf bytes.Buffer
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(indent)
+
+	if err := encoder.Encode(&document); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrFormat, err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrFormat, err)
+	}
+
+	return separateTopLevelEntries(buf.Bytes()), nil
+}
+
+// fixFoldedScalar switches the folded scalars that contain line breaks to the literal
+// style, since the encoder doesn't preserve these line breaks when folding them back.
+func fixFoldedScalar(node *yaml.Node) {
+	if node.Kind != yaml.ScalarNode || node.Style&yaml.FoldedStyle == 0 {
+		return
+	}
+
+	if strings.Contains(strings.TrimRight(node.Value, "\n"), "\n") {
+		node.Style = node.Style&^yaml.FoldedStyle | yaml.LiteralStyle
+	}
+}
+
+// fixMergeKey removes the explicit tag from the "<<" merge keys, which
+// the encoder would otherwise emit as "!!merge <<" since it's not a string.
+func fixMergeKey(node *yaml.Node) {
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!merge" {
+		node.Tag = ""
+	}
+}
+
+// separateTopLevelEntries puts a blank line before each of the top-level
+// entries and their comments, since the encoder doesn't preserve them.
+func separateTopLevelEntries(encoded []byte) []byte {
+	var result []string
+
+	for _, line := range strings.SplitAfter(string(encoded), "\n") {
+		if line == "" {
+			continue
+		}
+
+		if isTopLevelKey(line) {
+			// Keep the comments directly above the entry attached to it
+			insertAt := len(result)
+			for insertAt > 0 && strings.HasPrefix(result[insertAt-1], "#") {
+				insertAt--
+			}
+
+			if insertAt > 0 && result[insertAt-1] != "\n" {
+				result = append(result[:insertAt], append([]string{"\n"}, result[insertAt:]...)...)
+			}
+		}
+
+		result = append(result, line)
+	}
+
+	return []byte(strings.Join(result, ""))
+}
+
+func isTopLevelKey(line string) bool {
+	switch {
+	case line == "\n":
+		return false
+	case strings.HasPrefix(line, " "), strings.HasPrefix(line, "#"), strings.HasPrefix(line, "-"):
+		return false
+	default:
+		return true
+	}
+}
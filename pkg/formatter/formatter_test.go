@@ -0,0 +1,166 @@
+package formatter_test
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/internal/testutil"
+	"github.com/cirruslabs/cirrus-cli/pkg/formatter"
+	"github.com/cirruslabs/cirrus-cli/pkg/larker/fs/memory"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+var allAnchors = []formatter.Anchors{formatter.AnchorsKeep, formatter.AnchorsExpand, formatter.AnchorsCollapse}
+
+func TestFormat(t *testing.T) {
+	for _, anchors := range allAnchors {
+		t.Run(string(anchors), func(t *testing.T) {
+			source, err := os.ReadFile(filepath.Join("testdata", "unformatted.yml"))
+			require.NoError(t, err)
+
+			expected, err := os.ReadFile(filepath.Join("testdata", "formatted-"+string(anchors)+".yml"))
+			require.NoError(t, err)
+
+			actual, err := formatter.New(formatter.WithAnchors(anchors)).Format(source)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), string(actual))
+		})
+	}
+}
+
+// TestIdempotency ensures that the formatted configuration passes "cirrus fmt --check"
+// and that the comments survive, including the ones attached to the flow-style lists.
+func TestIdempotency(t *testing.T) {
+	unformatted, err := os.ReadFile(filepath.Join("testdata", "unformatted.yml"))
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		source   string
+		comments []string
+	}{
+		"unformatted.yml": {
+			source:   string(unformatted),
+			comments: []string{"# Shared settings", "# Use the same image as the tests", "# no cgo in tests"},
+		},
+		"commented flow-style script": {
+			source:   "test_task:\n  script: [echo hi]   # trailing\n  only_if: x\n",
+			comments: []string{"# trailing"},
+		},
+		"commented flow-style script with a matrix": {
+			source: "test_task:\n  matrix:\n    - name: a\n    - name: b\n" +
+				"  script: [echo hi]   # trailing\n  only_if: x\n",
+			comments: []string{"# trailing"},
+		},
+		"commented flow-style multi-command script": {
+			source:   "test_task:\n  script: [echo hi, echo bye] # trailing\n  only_if: x\n",
+			comments: []string{"# trailing"},
+		},
+	}
+
+	for name, testCase := range cases {
+		for _, anchors := range allAnchors {
+			t.Run(name+"/"+string(anchors), func(t *testing.T) {
+				formatter := formatter.New(formatter.WithAnchors(anchors))
+
+				formatted, err := formatter.Format([]byte(testCase.source))
+				require.NoError(t, err)
+
+				reformatted, err := formatter.Format(formatted)
+				require.NoError(t, err)
+				assert.Equal(t, string(formatted), string(reformatted))
+
+				for _, comment := range testCase.comments {
+					assert.Contains(t, string(formatted), comment)
+				}
+			})
+		}
+	}
+}
+
+// TestRoundTrip ensures that formatting the parser's fixtures doesn't change the parsing
+// results and that formatting the already formatted configuration doesn't change it.
+func TestRoundTrip(t *testing.T) {
+	var fixtures []string
+
+	err := filepath.WalkDir(filepath.Join("..", "parser", "testdata"), func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if strings.HasSuffix(path, ".yml") || strings.HasSuffix(path, ".yaml") {
+			fixtures = append(fixtures, path)
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	for _, fixture := range fixtures {
+		for _, anchors := range allAnchors {
+			t.Run(fixture+"/"+string(anchors), func(t *testing.T) {
+				source, err := os.ReadFile(fixture)
+				require.NoError(t, err)
+
+				formatted, err := formatter.New(formatter.WithAnchors(anchors)).Format(source)
+				if err != nil {
+					// Only the fixtures that are not valid YAML can't be formatted
+					var node yaml.Node
+					require.Error(t, yaml.Unmarshal(source, &node))
+
+					return
+				}
+
+				reformatted, err := formatter.New(formatter.WithAnchors(anchors)).Format(formatted)
+				require.NoError(t, err)
+				assert.Equal(t, string(formatted), string(reformatted))
+
+				expectedTasks, expectedErr := parse(t, fixture, source)
+				actualTasks, actualErr := parse(t, fixture, formatted)
+
+				if expectedErr != nil {
+					assert.Error(t, actualErr)
+
+					return
+				}
+
+				require.NoError(t, actualErr, string(formatted))
+				assert.JSONEq(t, expectedTasks, actualTasks)
+			})
+		}
+	}
+}
+
+func parse(t *testing.T, fixture string, config []byte) (string, error) {
+	fileContents := map[string][]byte{}
+
+	fcBytes, err := os.ReadFile(strings.TrimSuffix(fixture, filepath.Ext(fixture)) + ".fc")
+	if err == nil {
+		var files map[string]string
+
+		require.NoError(t, yaml.Unmarshal(fcBytes, &files))
+
+		for path, contents := range files {
+			fileContents[path] = []byte(contents)
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		t.Fatal(err)
+	}
+
+	fs, err := memory.New(fileContents)
+	require.NoError(t, err)
+
+	result, err := parser.New(parser.WithFileSystem(fs)).Parse(context.Background(), string(config))
+	if err != nil {
+		return "", err
+	}
+
+	return string(testutil.TasksToJSON(t, result.Tasks)), nil
+}
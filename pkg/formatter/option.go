@@ -0,0 +1,35 @@
+package formatter
+
+import "fmt"
+
+// Anchors specifies what to do with the YAML anchors and aliases.
+type Anchors string
+
+const (
+	// AnchorsKeep leaves the anchors and aliases as is.
+	AnchorsKeep Anchors = "keep"
+
+	// AnchorsExpand replaces the aliases with the contents of their anchors.
+	AnchorsExpand Anchors = "expand"
+
+	// AnchorsCollapse replaces the repeated mappings and sequences with aliases.
+	AnchorsCollapse Anchors = "collapse"
+)
+
+func ParseAnchors(s string) (Anchors, error) {
+	switch anchors := Anchors(s); anchors {
+	case AnchorsKeep, AnchorsExpand, AnchorsCollapse:
+		return anchors, nil
+	default:
+		return "", fmt.Errorf("%w: unsupported anchors mode %q, supported modes are %s, %s and %s",
+			ErrFormat, s, AnchorsKeep, AnchorsExpand, AnchorsCollapse)
+	}
+}
+
+type Option func(formatter *Formatter)
+
+func WithAnchors(anchors Anchors) Option {
+	return func(formatter *Formatter) {
+		formatter.anchors = anchors
+	}
+}
@@ -0,0 +1,189 @@
+package formatter
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Ranks of the task keys, the keys with the same rank keep their relative order.
+const (
+	rankName = iota
+	rankAlias
+	rankDependsOn
+	rankInstance
+	rankEnv
+	rankProperty
+	rankInstruction
+)
+
+var (
+	instanceRegex    = regexp.MustCompile("^(.*_)?(container|instance)$|^persistent_worker$|^resources$")
+	instructionRegex = regexp.MustCompile("^(.*)(script|cache|artifacts|file)$|^upload_caches$|" +
+		"^on_(success|failure|cancelled|timeout)$|^always$|^steps$")
+	scriptRegex = regexp.MustCompile("^(.*)script$")
+)
+
+type entry struct {
+	key   *yaml.Node
+	value *yaml.Node
+}
+
+func formatTask(task *yaml.Node) {
+	sortKeys(task)
+	normalizeScripts(task)
+
+	// The matrix entries are partial tasks
+	for i := 0; i < len(task.Content); i += 2 {
+		key, value := task.Content[i], task.Content[i+1]
+
+		if key.Value != "matrix" {
+			continue
+		}
+
+		switch value.Kind {
+		case yaml.MappingNode:
+			sortKeys(value)
+		case yaml.SequenceNode:
+			for _, item := range value.Content {
+				if item.Kind == yaml.MappingNode {
+					sortKeys(item)
+				}
+			}
+		}
+	}
+}
+
+// sortKeys orders the task keys by their rank. The "<<" merge keys and the matrices
+// depend on the keys that precede them, so only the keys between them are sorted.
+func sortKeys(task *yaml.Node) {
+	var entries []entry
+
+	for i := 0; i < len(task.Content); i += 2 {
+		entries = append(entries, entry{key: task.Content[i], value: task.Content[i+1]})
+	}
+
+	segmentStart := 0
+
+	for i := 0; i <= len(entries); i++ {
+		if i < len(entries) && !isBarrier(entries[i].key) {
+			continue
+		}
+
+		slices.SortStableFunc(entries[segmentStart:i], func(a, b entry) int {
+			return rank(a.key.Value) - rank(b.key.Value)
+		})
+
+		segmentStart = i + 1
+	}
+
+	task.Content = task.Content[:0]
+
+	for _, entry := range entries {
+		task.Content = append(task.Content, entry.key, entry.value)
+	}
+}
+
+func isBarrier(key *yaml.Node) bool {
+	return key.Tag == "!!merge" || key.Value == "matrix"
+}
+
+func rank(key string) int {
+	switch {
+	case key == "name":
+		return rankName
+	case key == "alias":
+		return rankAlias
+	case key == "depends_on":
+		return rankDependsOn
+	case instanceRegex.MatchString(key):
+		return rankInstance
+	case key == "env" || key == "environment":
+		return rankEnv
+	case instructionRegex.MatchString(key):
+		return rankInstruction
+	default:
+		return rankProperty
+	}
+}
+
+// normalizeScripts turns the single-command script lists into scalars
+// and the flow-style script lists into the block-style ones.
+func normalizeScripts(node *yaml.Node) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+
+			// Environment variable names are not instructions
+			if key.Value == "env" || key.Value == "environment" {
+				continue
+			}
+
+			if scriptRegex.MatchString(key.Value) && value.Kind == yaml.SequenceNode {
+				node.Content[i+1] = normalizeScript(key, value)
+
+				continue
+			}
+
+			normalizeScripts(value)
+		}
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			normalizeScripts(item)
+		}
+	}
+}
+
+// normalizeScript moves the script list's line comment (e.g. "script: [echo hi] # comment")
+// to the single command it's collapsed to or to the key, since the block-style lists can't
+// hold it. The collapsing only depends on the input, so that formatting is idempotent.
+func normalizeScript(key *yaml.Node, script *yaml.Node) *yaml.Node {
+	if command, ok := collapsibleCommand(script); ok {
+		command.LineComment = joinComments(command.LineComment, script.LineComment)
+
+		return command
+	}
+
+	if script.Style&yaml.FlowStyle != 0 {
+		key.LineComment = joinComments(key.LineComment, script.LineComment)
+		script.LineComment = ""
+		script.Style &^= yaml.FlowStyle
+	}
+
+	return script
+}
+
+// collapsibleCommand returns the command of a single-command script list
+// that can be represented as a scalar without losing anything.
+func collapsibleCommand(script *yaml.Node) (*yaml.Node, bool) {
+	// Anchored lists might be used elsewhere where a scalar is not expected
+	if len(script.Content) != 1 || script.Anchor != "" || script.HeadComment != "" || script.FootComment != "" {
+		return nil, false
+	}
+
+	command := script.Content[0]
+	if command.Kind != yaml.ScalarNode || command.Tag == "!!null" || command.Anchor != "" || hasComments(command) {
+		return nil, false
+	}
+
+	return command, true
+}
+
+func joinComments(comments ...string) string {
+	var nonEmpty []string
+
+	for _, comment := range comments {
+		if comment != "" {
+			nonEmpty = append(nonEmpty, comment)
+		}
+	}
+
+	return strings.Join(nonEmpty, " ")
+}
+
+func hasComments(node *yaml.Node) bool {
+	return node.HeadComment != "" || node.LineComment != "" || node.FootComment != ""
+}
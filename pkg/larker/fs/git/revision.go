@@ -0,0 +1,126 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"syscall"
+
+	"github.com/cirruslabs/cirrus-cli/pkg/larker/fs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// Revision is a read-only file system that serves the files of a revision
+// of an already present repository, which unlike Git doesn't require cloning it.
+type Revision struct {
+	tree *object.Tree
+}
+
+func NewRevision(repo *git.Repository, revision string) (*Revision, error) {
+	hash, err := repo.ResolveRevision(plumbing.Revision(revision))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRetrievalFailed, err)
+	}
+
+	commit, err := repo.CommitObject(*hash)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRetrievalFailed, err)
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRetrievalFailed, err)
+	}
+
+	return &Revision{tree: tree}, nil
+}
+
+func (r Revision) Stat(ctx context.Context, path string) (*fs.FileInfo, error) {
+	path = normalize(path)
+
+	if path == "" {
+		return &fs.FileInfo{IsDir: true}, nil
+	}
+
+	entry, err := r.tree.FindEntry(path)
+	if err != nil {
+		return nil, notExist("stat", path, err)
+	}
+
+	return &fs.FileInfo{IsDir: entry.Mode == filemode.Dir}, nil
+}
+
+func (r Revision) Get(ctx context.Context, path string) ([]byte, error) {
+	stat, err := r.Stat(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if stat.IsDir {
+		return nil, fs.ErrNormalizedIsADirectory
+	}
+
+	file, err := r.tree.File(normalize(path))
+	if err != nil {
+		return nil, notExist("open", path, err)
+	}
+
+	contents, err := file.Contents()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(contents), nil
+}
+
+func (r Revision) ReadDir(ctx context.Context, path string) ([]string, error) {
+	stat, err := r.Stat(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !stat.IsDir {
+		return nil, syscall.ENOTDIR
+	}
+
+	tree := r.tree
+
+	if normalizedPath := normalize(path); normalizedPath != "" {
+		tree, err = r.tree.Tree(normalizedPath)
+		if err != nil {
+			return nil, notExist("readdir", path, err)
+		}
+	}
+
+	var entries []string
+	for _, entry := range tree.Entries {
+		entries = append(entries, entry.Name)
+	}
+
+	return entries, nil
+}
+
+func (r Revision) Join(elem ...string) string {
+	return path.Join(elem...)
+}
+
+// normalize converts the path to the form used by the Git trees,
+// that is, relative to the repository root and without the leading slash.
+func normalize(p string) string {
+	return strings.TrimPrefix(path.Clean("/"+p), "/")
+}
+
+func notExist(op string, path string, err error) error {
+	if errors.Is(err, object.ErrEntryNotFound) || errors.Is(err, object.ErrDirectoryNotFound) ||
+		errors.Is(err, object.ErrFileNotFound) {
+		return &os.PathError{Op: op, Path: path, Err: os.ErrNotExist}
+	}
+
+	return err
+}
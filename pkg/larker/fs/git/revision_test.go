@@ -0,0 +1,78 @@
+package git_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/cirruslabs/cirrus-cli/internal/testutil"
+	"github.com/cirruslabs/cirrus-cli/pkg/larker/fs/git"
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevision(t *testing.T) {
+	ctx := context.Background()
+	dir := testutil.TempDir(t)
+
+	repo, err := gogit.PlainInit(dir, false)
+	require.NoError(t, err)
+
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "lib"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "lib", "lib.star"), []byte("v1"), 0600))
+	_, err = worktree.Add("lib/lib.star")
+	require.NoError(t, err)
+
+	signature := &object.Signature{Name: "Charlie Root", Email: "root@localhost", When: time.Now()}
+
+	first, err := worktree.Commit("First", &gogit.CommitOptions{Author: signature})
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "lib", "lib.star"), []byte("v2"), 0600))
+	_, err = worktree.Add("lib/lib.star")
+	require.NoError(t, err)
+	_, err = worktree.Commit("Second", &gogit.CommitOptions{Author: signature})
+	require.NoError(t, err)
+
+	revisionFS, err := git.NewRevision(repo, first.String())
+	require.NoError(t, err)
+
+	contents, err := revisionFS.Get(ctx, "/lib/lib.star")
+	require.NoError(t, err)
+	assert.Equal(t, "v1", string(contents))
+
+	stat, err := revisionFS.Stat(ctx, ".")
+	require.NoError(t, err)
+	assert.True(t, stat.IsDir)
+
+	entries, err := revisionFS.ReadDir(ctx, "lib")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"lib.star"}, entries)
+
+	_, err = revisionFS.Get(ctx, "lib")
+	require.Error(t, err)
+
+	_, err = revisionFS.ReadDir(ctx, "lib/lib.star")
+	require.ErrorIs(t, err, syscall.ENOTDIR)
+
+	_, err = revisionFS.Get(ctx, "missing.star")
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	headFS, err := git.NewRevision(repo, "HEAD")
+	require.NoError(t, err)
+
+	contents, err = headFS.Get(ctx, "lib/lib.star")
+	require.NoError(t, err)
+	assert.Equal(t, "v2", string(contents))
+
+	_, err = git.NewRevision(repo, "nonexistent")
+	require.ErrorIs(t, err, git.ErrRetrievalFailed)
+}
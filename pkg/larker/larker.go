@@ -97,7 +97,7 @@ func (larker *Larker) Main(ctx context.Context, source string) (*MainResult, err
 		// Execute the source code for the main() to be visible
 		globals, err := starlark.ExecFile(thread, ".cirrus.star", source, nil)
 		if err != nil {
-			errCh <- fmt.Errorf("%w: %v", ErrLoadFailed, err)
+			errCh <- fmt.Errorf("%w: %w", ErrLoadFailed, err)
 			return
 		}
 
@@ -209,7 +209,7 @@ func (larker *Larker) Hook(
 		// Execute the source code for the hook to be visible
 		globals, err := starlark.ExecFile(thread, ".cirrus.star", source, nil)
 		if err != nil {
-			errCh <- fmt.Errorf("%w: %v", ErrLoadFailed, err)
+			errCh <- fmt.Errorf("%w: %w", ErrLoadFailed, err)
 			return
 		}
 
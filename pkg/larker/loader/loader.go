@@ -126,6 +126,11 @@ func (loader *Loader) LoadFunc(
 	}
 }
 
+// CirrusModule returns the members of the Cirrus-provided "cirrus" module.
+func (loader *Loader) CirrusModule() (starlark.StringDict, error) {
+	return loader.loadCirrusModule()
+}
+
 func (loader *Loader) loadCirrusModule() (starlark.StringDict, error) {
 	result := make(starlark.StringDict)
 
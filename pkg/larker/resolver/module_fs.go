@@ -97,6 +97,14 @@ func parseLocation(module string) interface{} {
 	return relativeLocation{Path: module}
 }
 
+// IsRelative returns true if the module is located relative
+// to the file system of the module that references it.
+func IsRelative(module string) bool {
+	_, ok := parseLocation(module).(relativeLocation)
+
+	return ok
+}
+
 func FindModuleFS(
 	ctx context.Context,
 	currentFS fs.FileSystem,
@@ -22,7 +22,9 @@ func (p *Parser) bfuncChangesInclude() boolevator.Function {
 			return err
 		}
 
-		matchedFiles, err := CountMatchingAffectedFiles(p.affectedFiles, rawPatterns)
+		affectedFiles := p.scopedAffectedFiles()
+
+		matchedFiles, err := CountMatchingAffectedFiles(affectedFiles, rawPatterns)
 		if err != nil {
 			return err
 		}
@@ -44,11 +46,13 @@ func (p *Parser) bfuncChangesIncludeOnly() boolevator.Function {
 			return err
 		}
 
-		matchedFiles, err := CountMatchingAffectedFiles(p.affectedFiles, rawPatterns)
+		affectedFiles := p.scopedAffectedFiles()
+
+		matchedFiles, err := CountMatchingAffectedFiles(affectedFiles, rawPatterns)
 		if err != nil {
 			return err
 		}
-		if matchedFiles > 0 && matchedFiles == len(p.affectedFiles) {
+		if matchedFiles > 0 && matchedFiles == len(affectedFiles) {
 			return "true"
 		}
 		return "false"
@@ -28,6 +28,8 @@ func (f Function) WithExpandedArguments(env map[string]string) Function {
 
 type Boolevator struct {
 	functions map[string]Function
+	tracer    Tracer
+	field     string
 }
 
 var ErrInternal = errors.New("internal boolevator error")
@@ -66,6 +68,23 @@ func trimAllQuotes(text string) string {
 }
 
 func (boolevator *Boolevator) Eval(expr string, env map[string]string) (bool, error) {
+	if boolevator.tracer == nil {
+		return boolevator.eval(expr, env, nil)
+	}
+
+	trace := &Trace{
+		Field:      boolevator.field,
+		Expression: expr,
+	}
+
+	trace.Result, trace.Err = boolevator.eval(expr, env, trace)
+
+	boolevator.tracer(trace)
+
+	return trace.Result, trace.Err
+}
+
+func (boolevator *Boolevator) eval(expr string, env map[string]string, trace *Trace) (bool, error) {
 	// Ensure that we keep the env as is
 	localEnv := make(map[string]string)
 	for key, value := range env {
@@ -89,6 +108,7 @@ func (boolevator *Boolevator) Eval(expr string, env map[string]string) (bool, er
 
 		// Lookup variable
 		expandedVariable := localEnv[variableName]
+		trace.recordVariable(variableName, localEnv)
 
 		return parser.Const(expandedVariable), nil
 	}
@@ -130,7 +150,7 @@ func (boolevator *Boolevator) Eval(expr string, env map[string]string) (bool, er
 
 	// Functions
 	for name, function := range boolevator.functions {
-		languageBases = append(languageBases, gval.Function(name, function.WithExpandedArguments(localEnv)))
+		languageBases = append(languageBases, gval.Function(name, trace.wrapFunction(name, function, localEnv)))
 	}
 
 	result, err := gval.NewLanguage(languageBases...).Evaluate(expr, nil)
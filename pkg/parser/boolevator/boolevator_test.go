@@ -3,6 +3,9 @@ package boolevator_test
 import (
 	"github.com/cirruslabs/cirrus-cli/pkg/parser/boolevator"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -159,3 +162,40 @@ func TestComplexTag(t *testing.T) {
 
 	assert.True(t, evalHelper(t, "$CIRRUS_TAG =~ 'v\\d+(\\.\\d+){2}(-.*)?'", env))
 }
+
+func TestTrace(t *testing.T) {
+	var traces []*boolevator.Trace
+
+	b := boolevator.New(
+		boolevator.WithFunctions(map[string]boolevator.Function{
+			"hasPrefix": func(arguments ...interface{}) interface{} {
+				return strconv.FormatBool(strings.HasPrefix(arguments[0].(string), arguments[1].(string)))
+			},
+		}),
+		boolevator.WithTracer(func(trace *boolevator.Trace) {
+			traces = append(traces, trace)
+		}),
+	)
+
+	env := map[string]string{"CIRRUS_BRANCH": "release/1.0", "PREFIX": "release/"}
+
+	evaluation, err := b.ForField("only_if").Eval("$CIRRUS_TAG != '' || hasPrefix($CIRRUS_BRANCH, '${PREFIX}')", env)
+	require.NoError(t, err)
+	assert.True(t, evaluation)
+
+	require.Len(t, traces, 1)
+	assert.Equal(t, "only_if", traces[0].Field)
+	assert.True(t, traces[0].Result)
+	assert.Contains(t, traces[0].Variables, boolevator.Variable{Name: "CIRRUS_TAG"})
+	assert.Contains(t, traces[0].Variables, boolevator.Variable{
+		Name: "CIRRUS_BRANCH", Value: "release/1.0", Defined: true,
+	})
+	assert.Equal(t, []boolevator.Call{
+		{
+			Function:          "hasPrefix",
+			Arguments:         []string{"release/1.0", "${PREFIX}"},
+			ExpandedArguments: []string{"release/1.0", "release/"},
+			Result:            "true",
+		},
+	}, traces[0].Calls)
+}
@@ -7,3 +7,10 @@ func WithFunctions(functions map[string]Function) Option {
 		boolevator.functions = functions
 	}
 }
+
+// WithTracer calls the tracer after each evaluation of an expression.
+func WithTracer(tracer Tracer) Option {
+	return func(boolevator *Boolevator) {
+		boolevator.tracer = tracer
+	}
+}
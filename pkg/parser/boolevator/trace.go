@@ -0,0 +1,87 @@
+package boolevator
+
+import (
+	"fmt"
+
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/expander"
+)
+
+// Trace describes a single evaluation of an expression, which
+// is useful for explaining why it evaluated the way it did.
+type Trace struct {
+	// Name of the field that contained the expression (e.g. "only_if"), if known
+	Field string
+
+	Expression string
+	Variables  []Variable
+	Calls      []Call
+	Result     bool
+	Err        error
+}
+
+type Variable struct {
+	Name    string
+	Value   string
+	Defined bool
+}
+
+type Call struct {
+	Function          string
+	Arguments         []string
+	ExpandedArguments []string
+	Result            string
+}
+
+// Tracer is called after each evaluation of an expression.
+type Tracer func(trace *Trace)
+
+// ForField returns a copy of the boolevator whose evaluations
+// are attributed to the specified field when traced.
+func (boolevator *Boolevator) ForField(field string) *Boolevator {
+	copied := *boolevator
+	copied.field = field
+
+	return &copied
+}
+
+func (trace *Trace) recordVariable(name string, env map[string]string) {
+	if trace == nil {
+		return
+	}
+
+	value, ok := env[name]
+
+	trace.Variables = append(trace.Variables, Variable{
+		Name:    name,
+		Value:   value,
+		Defined: ok,
+	})
+}
+
+func (trace *Trace) wrapFunction(name string, function Function, env map[string]string) Function {
+	if trace == nil {
+		return function.WithExpandedArguments(env)
+	}
+
+	return func(arguments ...interface{}) interface{} {
+		call := Call{Function: name}
+
+		for _, argument := range arguments {
+			call.Arguments = append(call.Arguments, fmt.Sprint(argument))
+
+			if argumentString, ok := argument.(string); ok {
+				call.ExpandedArguments = append(call.ExpandedArguments,
+					expander.ExpandEnvironmentVariables(argumentString, env))
+			} else {
+				call.ExpandedArguments = append(call.ExpandedArguments, fmt.Sprint(argument))
+			}
+		}
+
+		result := function.WithExpandedArguments(env)(arguments...)
+		call.Result = fmt.Sprint(result)
+
+		trace.Calls = append(trace.Calls, call)
+
+		return result
+	}
+}
@@ -162,8 +162,8 @@ func (p *Parser) calculateDockerfileHash(
 	// Try to calculate a deep hash
 	sourcePaths, err := dockerfile.LocalContextSourcePaths(ctx, dockerfileContents, dockerArguments)
 	if err != nil {
-		p.parserKit.IssueRegistry.RegisterIssuef(api.Issue_WARNING, dockerfileNode.Line, dockerfileNode.Column,
-			"%v %q: %v", ErrFailedToAnalyze, dockerfilePath, err)
+		p.parserKit.IssueRegistry.RegisterIssuef(api.Issue_WARNING, dockerfileNode.Path,
+			dockerfileNode.Line, dockerfileNode.Column, "%v %q: %v", ErrFailedToAnalyze, dockerfilePath, err)
 
 		return hex.EncodeToString(oldHash.Sum([]byte{})), nil
 	}
@@ -179,7 +179,8 @@ func (p *Parser) calculateDockerfileHash(
 			newHash.Write(fileContents)
 			hashedAtLeastOneSource = true
 		}); err != nil {
-			p.parserKit.IssueRegistry.RegisterIssuef(api.Issue_WARNING, dockerfileNode.Line, dockerfileNode.Column, "%v", err)
+			p.parserKit.IssueRegistry.RegisterIssuef(api.Issue_WARNING, dockerfileNode.Path,
+				dockerfileNode.Line, dockerfileNode.Column, "%v", err)
 		}
 	}
 
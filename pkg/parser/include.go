@@ -0,0 +1,154 @@
+package parser
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/cirruslabs/cirrus-cli/pkg/larker/fs"
+	"github.com/cirruslabs/cirrus-cli/pkg/larker/resolver"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/nameable"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/node"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/parsererror"
+)
+
+const fieldInclude = "include"
+
+var ErrInclude = errors.New("failed to include a configuration")
+
+// resolveIncludes replaces the top-level "include" directive with the top-level fields
+// of the included YAML files, in the order they're specified. Since the later definitions
+// of the fields like "env" and "container" take precedence, the including file can override
+// the fields defined in the included files, and the tasks from all the files are combined.
+//
+// Similarly to the Starlark's load(), each file is included at most once, and the files
+// are resolved using the same locators (e.g. "ci/go.yml", "github.com/org/repo/go.yml@v1"
+// or "gitlab.com/org/repo.git/go.yml@v1") relative to the including file's file system.
+func (p *Parser) resolveIncludes(
+	ctx context.Context,
+	tree *node.Node,
+	currentFS fs.FileSystem,
+	currentPath string,
+	mergeExemptions []nameable.Nameable,
+) error {
+	var newChildren []*node.Node
+
+	for _, child := range tree.Children {
+		if child.Name != fieldInclude {
+			newChildren = append(newChildren, child)
+
+			continue
+		}
+
+		locators, err := child.GetSliceOfNonEmptyStrings()
+		if err != nil {
+			return err
+		}
+
+		for _, locator := range locators {
+			includedChildren, err := p.include(ctx, child, currentFS, currentPath, locator, mergeExemptions)
+			if err != nil {
+				return err
+			}
+
+			for _, includedChild := range includedChildren {
+				includedChild.Parent = tree
+			}
+
+			newChildren = append(newChildren, includedChildren...)
+		}
+	}
+
+	tree.Children = newChildren
+
+	return nil
+}
+
+func (p *Parser) include(
+	ctx context.Context,
+	includeNode *node.Node,
+	currentFS fs.FileSystem,
+	currentPath string,
+	locator string,
+	mergeExemptions []nameable.Nameable,
+) ([]*node.Node, error) {
+	includedPath := locator
+	if resolver.IsRelative(locator) {
+		if resolver.IsRelative(currentPath) {
+			includedPath = path.Join(path.Dir(currentPath), locator)
+		} else {
+			includedPath = fmt.Sprintf("%s (from %s)", locator, currentPath)
+		}
+	}
+
+	// Each file is included at most once, unless it's still
+	// being included, which means we've hit an include cycle
+	if done, ok := p.includes[includedPath]; ok {
+		if !done {
+			return nil, includeNode.ParserError("include cycle detected: %s", includedPath)
+		}
+
+		return nil, nil
+	}
+
+	if !strings.HasSuffix(locator, ".yml") && !strings.HasSuffix(locator, ".yaml") {
+		return nil, includeNode.ParserError("%v: %q should point to a .yml or .yaml file", ErrInclude, locator)
+	}
+
+	includedFS, filePath, err := resolver.FindModuleFS(ctx, currentFS, p.environment, locator, nil)
+	if err != nil {
+		return nil, includeNode.ParserError("%v: %s: %v", ErrInclude, locator, err)
+	}
+
+	config, err := includedFS.Get(ctx, filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, includeNode.ParserError("%v: %s not found", ErrInclude, includedPath)
+		}
+
+		return nil, includeNode.ParserError("%v: %s: %v", ErrInclude, includedPath, err)
+	}
+
+	p.includes[includedPath] = false
+	p.configs[includedPath] = string(config)
+
+	includedTree, err := node.NewFromTextWithMergeExemptions(string(config), mergeExemptions)
+	if err != nil {
+		return nil, withPath(err, includedPath)
+	}
+
+	setPath(includedTree, includedPath)
+
+	if err := p.resolveIncludes(ctx, includedTree, includedFS, includedPath, mergeExemptions); err != nil {
+		return nil, err
+	}
+
+	p.includes[includedPath] = true
+
+	return includedTree.Children, nil
+}
+
+func setPath(tree *node.Node, path string) {
+	tree.Path = path
+
+	for _, child := range tree.Children {
+		setPath(child, path)
+	}
+}
+
+// withPath attributes the error that occurred when converting the file to a tree to that file.
+func withPath(err error, path string) error {
+	if path == "" {
+		return err
+	}
+
+	var rich *parsererror.Rich
+	if errors.As(err, &rich) {
+		return parsererror.NewRichWithPath(path, rich.Line(), rich.Column(), "%s", rich.Message())
+	}
+
+	return fmt.Errorf("%s: %w", path, err)
+}
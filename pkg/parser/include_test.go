@@ -0,0 +1,154 @@
+package parser_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
+	"github.com/cirruslabs/cirrus-cli/pkg/larker/fs/memory"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/parsererror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInclude(t *testing.T) {
+	fs, err := memory.New(map[string][]byte{
+		"ci/common.yml": []byte(`
+container:
+  image: debian:latest
+
+env:
+  LEVEL: common
+  COMMON: "true"
+`),
+		"ci/go.yml": []byte(`
+include: common.yml
+
+test_task:
+  script: go test ./...
+`),
+		"ci/lint.yml": []byte(`
+include:
+  - common.yml
+
+lint_task:
+  script: golangci-lint run
+`),
+	})
+	require.NoError(t, err)
+
+	config := `
+include:
+  - ci/go.yml
+  - ci/lint.yml
+
+env:
+  LEVEL: main
+
+build_task:
+  script: go build ./...
+`
+
+	result, err := parser.New(parser.WithFileSystem(fs)).Parse(context.Background(), config)
+	require.NoError(t, err)
+
+	var names []string
+	for _, task := range result.Tasks {
+		names = append(names, task.Name)
+
+		// The including file takes precedence
+		assert.Equal(t, "main", task.Environment["LEVEL"])
+		assert.Equal(t, "true", task.Environment["COMMON"])
+
+		var container api.ContainerInstance
+		require.NoError(t, task.Instance.UnmarshalTo(&container))
+		assert.Equal(t, "debian:latest", container.Image)
+	}
+
+	// Tasks are combined in the order of inclusion and each file is included once
+	assert.Equal(t, []string{"test", "lint", "build"}, names)
+}
+
+func TestIncludeErrors(t *testing.T) {
+	fs, err := memory.New(map[string][]byte{
+		"a.yml":       []byte("include: b.yml\n"),
+		"b.yml":       []byte("include: a.yml\n"),
+		"invalid.yml": []byte("container:\n  image: debian:latest\n\ntask:\n  script:\n    A: B\n"),
+	})
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		config  string
+		path    string
+		line    int
+		message string
+	}{
+		"cycle": {
+			config:  "include: a.yml\n",
+			path:    "b.yml",
+			line:    1,
+			message: "include cycle detected: a.yml",
+		},
+		"not found": {
+			config:  "env:\n  A: B\ninclude: missing.yml\n",
+			line:    3,
+			message: "missing.yml not found",
+		},
+		"not YAML": {
+			config:  "include: lib.star\n",
+			line:    1,
+			message: "should point to a .yml or .yaml file",
+		},
+		"error in an included file": {
+			config:  "include: invalid.yml\n",
+			path:    "invalid.yml",
+			line:    5,
+			message: "expected a scalar value",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := parser.New(parser.WithFileSystem(fs)).Parse(context.Background(), testCase.config)
+			require.Error(t, err)
+
+			var rich *parsererror.Rich
+			require.ErrorAs(t, err, &rich)
+			assert.Equal(t, testCase.path, rich.Path())
+			assert.Equal(t, testCase.line, rich.Line())
+			assert.Contains(t, rich.Message(), testCase.message)
+		})
+	}
+}
+
+func TestIncludeIssues(t *testing.T) {
+	fs, err := memory.New(map[string][]byte{
+		"ci/test.yml": []byte(`
+test_task:
+  container:
+    image: debian:latest
+  script: true
+  artifacts: "*.log"
+`),
+	})
+	require.NoError(t, err)
+
+	config := `
+include: ci/test.yml
+
+task_build:
+  container:
+    image: debian:latest
+  script: true
+`
+
+	result, err := parser.New(parser.WithFileSystem(fs)).Parse(context.Background(), config)
+	require.NoError(t, err)
+
+	// Issues point to the file they were found in
+	assert.ElementsMatch(t, []*api.Issue{
+		{Level: api.Issue_WARNING, Message: "you've probably meant build_task", Path: ".cirrus.yml", Line: 4, Column: 1},
+		{Level: api.Issue_WARNING, Message: "expected a map, found scalar", Path: "ci/test.yml", Line: 6, Column: 3},
+	}, result.Issues)
+}
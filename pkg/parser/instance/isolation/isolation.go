@@ -4,6 +4,7 @@ import (
 	"github.com/cirruslabs/cirrus-cli/pkg/api"
 	"github.com/cirruslabs/cirrus-cli/pkg/parser/instance/isolation/container"
 	"github.com/cirruslabs/cirrus-cli/pkg/parser/instance/isolation/parallels"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/instance/isolation/ssh"
 	"github.com/cirruslabs/cirrus-cli/pkg/parser/instance/isolation/tart"
 	"github.com/cirruslabs/cirrus-cli/pkg/parser/instance/isolation/vetu"
 	"github.com/cirruslabs/cirrus-cli/pkg/parser/nameable"
@@ -74,6 +75,19 @@ func NewIsolation(mergedEnv map[string]string, parserKit *parserkit.ParserKit) *
 		return nil
 	})
 
+	sshSchema := ssh.New(mergedEnv).Schema()
+	isolation.OptionalField(nameable.NewSimpleNameable("ssh"), sshSchema, func(node *node.Node) error {
+		ssh := ssh.New(mergedEnv)
+
+		if err := ssh.Parse(node, parserKit); err != nil {
+			return err
+		}
+
+		isolation.proto.Type = ssh.Proto()
+
+		return nil
+	})
+
 	return isolation
 }
 
@@ -0,0 +1,56 @@
+package ssh
+
+import (
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/nameable"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/node"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/parseable"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/parserkit"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/schema"
+	jsschema "github.com/lestrrat-go/jsschema"
+)
+
+type SSH struct {
+	proto *api.Isolation_Ssh_
+
+	parseable.DefaultParser
+}
+
+func New(mergedEnv map[string]string) *SSH {
+	ssh := &SSH{
+		proto: &api.Isolation_Ssh_{
+			Ssh: &api.Isolation_Ssh{},
+		},
+	}
+
+	labelsSchema := schema.Map("Labels that the Persistent Worker's inventory host must have.")
+	ssh.OptionalField(nameable.NewSimpleNameable("labels"), labelsSchema, func(node *node.Node) error {
+		labels, err := node.GetMapOrListOfMapsWithExpansion(mergedEnv)
+		if err != nil {
+			return err
+		}
+
+		ssh.proto.Ssh.Labels = labels
+
+		return nil
+	})
+
+	return ssh
+}
+
+func (ssh *SSH) Parse(node *node.Node, parserKit *parserkit.ParserKit) error {
+	return ssh.DefaultParser.Parse(node, parserKit)
+}
+
+func (ssh *SSH) Proto() *api.Isolation_Ssh_ {
+	return ssh.proto
+}
+
+func (ssh *SSH) Schema() *jsschema.Schema {
+	modifiedSchema := ssh.DefaultParser.Schema()
+
+	modifiedSchema.Type = jsschema.PrimitiveTypes{jsschema.ObjectType}
+	modifiedSchema.Description = "Pre-existing host isolation over SSH."
+
+	return modifiedSchema
+}
@@ -5,7 +5,9 @@ import (
 	"github.com/cirruslabs/cirrus-cli/pkg/api"
 )
 
-const pathYAML = ".cirrus.yml"
+// PathYAML is the path of the main configuration, used for the issues
+// found in the nodes that weren't included from the other files.
+const PathYAML = ".cirrus.yml"
 
 type Registry struct {
 	issues []*api.Issue
@@ -17,6 +19,7 @@ func NewRegistry() *Registry {
 
 func (registry *Registry) RegisterIssuef(
 	level api.Issue_Level,
+	path string,
 	line int,
 	column int,
 	format string,
@@ -25,12 +28,51 @@ func (registry *Registry) RegisterIssuef(
 	registry.issues = append(registry.issues, &api.Issue{
 		Level:   level,
 		Message: fmt.Sprintf(format, args...),
-		Path:    pathYAML,
+		Path:    issuePath(path),
 		Line:    uint64(line),
 		Column:  uint64(column),
 	})
 }
 
+// RegisterRuleIssuef registers an issue produced by a lint rule,
+// skipping the exact duplicates caused by the matrix expansion.
+func (registry *Registry) RegisterRuleIssuef(
+	rule string,
+	level api.Issue_Level,
+	path string,
+	line int,
+	column int,
+	format string,
+	args ...interface{},
+) {
+	message := fmt.Sprintf(format, args...)
+
+	for _, existingIssue := range registry.issues {
+		if existingIssue.Rule == rule && existingIssue.Path == issuePath(path) &&
+			existingIssue.Line == uint64(line) && existingIssue.Column == uint64(column) &&
+			existingIssue.Message == message {
+			return
+		}
+	}
+
+	registry.issues = append(registry.issues, &api.Issue{
+		Level:   level,
+		Message: message,
+		Path:    issuePath(path),
+		Line:    uint64(line),
+		Column:  uint64(column),
+		Rule:    rule,
+	})
+}
+
+func issuePath(path string) string {
+	if path == "" {
+		return PathYAML
+	}
+
+	return path
+}
+
 func (registry *Registry) Issues() []*api.Issue {
 	return registry.issues
 }
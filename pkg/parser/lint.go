@@ -0,0 +1,378 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/cirruslabs/cirrus-cli/internal/executor/environment"
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/nameable"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/node"
+)
+
+// Identifiers of the lint rules, see WithLint().
+const (
+	LintRuleDuplicateCache    = "duplicate-cache"
+	LintRuleSkippedDependency = "skipped-dependency"
+	LintRuleUnusedEnvironment = "unused-env"
+	LintRuleDeprecatedField   = "deprecated-field"
+	LintRuleUndefinedVariable = "undefined-variable"
+)
+
+var (
+	cacheNameable = nameable.NewRegexNameable("^(.*)cache$")
+
+	// Matches both the POSIX-style ($VAR and ${VAR}) and the Windows-style (%VAR%) references
+	anyVariableReferenceRegex = regexp.MustCompile(`\$\{?([A-Za-z_][A-Za-z0-9_]*)|%([A-Za-z_][A-Za-z0-9_]*)%`)
+
+	// Only matches the POSIX-style references, since "%" is commonly used
+	// in the scripts for other purposes (e.g. "date +%Y%m%d")
+	scriptVariableReferenceRegex = regexp.MustCompile(`\$\{?([A-Za-z_][A-Za-z0-9_]*)`)
+
+	// Matches the variables that are defined by the script itself
+	scriptVariableAssignmentRegex = regexp.MustCompile(
+		`(?:^|[\s;&|(])(?:export\s+|local\s+|readonly\s+|declare\s+(?:-\w+\s+)*)?([A-Za-z_][A-Za-z0-9_]*)=`)
+	scriptVariableLoopRegex = regexp.MustCompile(`\b(?:for|read(?:\s+-\w+)*)\s+([A-Za-z_][A-Za-z0-9_]*)`)
+
+	// Lowercase variables are most likely the script's local variables
+	// that are assigned in a way we don't recognize, so only consider
+	// the uppercase ones when looking for the undefined variables
+	uppercaseVariableRegex = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+)
+
+// Variables that are either provided by the CLI and the agent or are
+// commonly set by the shell and the operating system.
+var (
+	wellKnownVariables = map[string]struct{}{
+		"CI": {}, "CONTINUOUS_INTEGRATION": {}, "HOME": {}, "PATH": {}, "PWD": {}, "OLDPWD": {}, "USER": {},
+		"SHELL": {}, "TMPDIR": {}, "TEMP": {}, "TMP": {}, "HOSTNAME": {}, "LANG": {}, "TERM": {}, "UID": {},
+		"IFS": {}, "RANDOM": {}, "SECONDS": {}, "LINENO": {}, "OSTYPE": {}, "HOSTTYPE": {}, "PPID": {},
+	}
+	wellKnownVariablePrefixes = []string{"CIRRUS_", "CI_", "BASH_", "LC_"}
+)
+
+// deprecatedTaskFields maps the deprecated fields to their replacements.
+var deprecatedTaskFields = map[string]string{
+	"osx_instance": "macos_instance",
+}
+
+type lintTask struct {
+	node  *node.Node
+	name  string
+	alias string
+
+	// Name of the field that causes this task to be always skipped, if any
+	skippedBy string
+}
+
+func (p *Parser) lintTree(tree *node.Node, config string) {
+	globalEnv := environmentFromNode(tree)
+
+	var tasks []*lintTask
+
+	for _, treeItem := range tree.Children {
+		for key := range p.parsers {
+			if key.Matches(treeItem.Name) {
+				tasks = append(tasks, p.newLintTask(treeItem, key, globalEnv))
+
+				break
+			}
+		}
+	}
+
+	p.lintSkippedDependencies(tasks)
+	p.lintDuplicateCaches(tasks)
+	p.lintDeprecatedFields(tasks)
+	p.lintUnusedEnvironment(tree, config)
+	p.lintUndefinedVariables(tree, tasks)
+}
+
+func (p *Parser) newLintTask(taskNode *node.Node, key nameable.Nameable, globalEnv map[string]string) *lintTask {
+	env := environment.Merge(globalEnv, environmentFromNode(taskNode), p.environment)
+
+	task := &lintTask{
+		node: taskNode,
+		name: "main",
+	}
+
+	if regexNameable, ok := key.(*nameable.RegexNameable); ok {
+		task.name = regexNameable.FirstGroupOrDefault(taskNode.Name, "main")
+	}
+
+	if nameNode := taskNode.FindChild("name"); nameNode != nil {
+		if name, err := nameNode.GetExpandedStringValue(env); err == nil {
+			task.name = name
+		}
+	}
+
+	if aliasNode := taskNode.FindChild("alias"); aliasNode != nil {
+		if alias, err := aliasNode.GetExpandedStringValue(env); err == nil {
+			task.alias = alias
+		}
+	}
+
+	if value, ok := p.constantBoolValue(taskNode.FindChild("only_if")); ok && !value {
+		task.skippedBy = "only_if"
+	} else if value, ok := p.constantBoolValue(taskNode.FindChild("skip")); ok && value {
+		task.skippedBy = "skip"
+	}
+
+	return task
+}
+
+// constantBoolValue evaluates the expressions that don't depend on the environment.
+func (p *Parser) constantBoolValue(expressionNode *node.Node) (bool, bool) {
+	if expressionNode == nil {
+		return false, false
+	}
+
+	expression, err := expressionNode.GetStringValue()
+	if err != nil || strings.Contains(expression, "$") || strings.Contains(expression, "changesInclude") {
+		return false, false
+	}
+
+	value, err := expressionNode.GetBoolValue(map[string]string{}, p.parserKit.Boolevator)
+	if err != nil {
+		return false, false
+	}
+
+	return value, true
+}
+
+func (p *Parser) lintSkippedDependencies(tasks []*lintTask) {
+	skippedTasks := map[string]*lintTask{}
+	nonSkippedTasks := map[string]struct{}{}
+
+	for _, task := range tasks {
+		for _, name := range []string{task.name, task.alias} {
+			if name == "" {
+				continue
+			}
+
+			if task.skippedBy != "" {
+				skippedTasks[name] = task
+			} else {
+				nonSkippedTasks[name] = struct{}{}
+			}
+		}
+	}
+
+	for _, task := range tasks {
+		dependsOnNode := task.node.FindChild("depends_on")
+		if dependsOnNode == nil {
+			continue
+		}
+
+		dependsOnNames, err := dependsOnNode.GetSliceOfNonEmptyStrings()
+		if err != nil {
+			continue
+		}
+
+		for _, dependsOnName := range dependsOnNames {
+			skippedTask, ok := skippedTasks[dependsOnName]
+			if !ok {
+				continue
+			}
+
+			// Another task with the same name may still run
+			if _, ok := nonSkippedTasks[dependsOnName]; ok {
+				continue
+			}
+
+			p.parserKit.IssueRegistry.RegisterRuleIssuef(LintRuleSkippedDependency, api.Issue_WARNING,
+				dependsOnNode.Path, dependsOnNode.Line, dependsOnNode.Column,
+				"task \"%s\" depends on task \"%s\", which is always skipped due to its %s condition",
+				task.name, dependsOnName, skippedTask.skippedBy)
+		}
+	}
+}
+
+func (p *Parser) lintDuplicateCaches(tasks []*lintTask) {
+	for _, task := range tasks {
+		seen := map[string]struct{}{}
+
+		for _, child := range task.node.Children {
+			if !cacheNameable.Matches(child.Name) {
+				continue
+			}
+
+			name := cacheNameable.FirstGroupOrDefault(child.Name, "main")
+			if nameNode := child.FindChild("name"); nameNode != nil {
+				if value, err := nameNode.GetStringValue(); err == nil {
+					name = value
+				}
+			}
+
+			if _, ok := seen[name]; ok {
+				p.parserKit.IssueRegistry.RegisterRuleIssuef(LintRuleDuplicateCache, api.Issue_WARNING,
+					child.Path, child.Line, child.Column, "task \"%s\" has multiple caches named \"%s\"", task.name, name)
+			}
+
+			seen[name] = struct{}{}
+		}
+	}
+}
+
+func (p *Parser) lintDeprecatedFields(tasks []*lintTask) {
+	for _, task := range tasks {
+		for _, child := range task.node.Children {
+			if replacement, ok := deprecatedTaskFields[child.Name]; ok {
+				p.registerDeprecatedFieldIssue(child, replacement)
+			}
+		}
+	}
+}
+
+func (p *Parser) registerDeprecatedFieldIssue(field *node.Node, replacement string) {
+	p.parserKit.IssueRegistry.RegisterRuleIssuef(LintRuleDeprecatedField, api.Issue_WARNING, field.Path,
+		field.Line, field.Column, "field \"%s\" is deprecated, use \"%s\" instead", field.Name, replacement)
+}
+
+func (p *Parser) lintUnusedEnvironment(tree *node.Node, config string) {
+	referenced := map[string]struct{}{}
+
+	for _, submatch := range anyVariableReferenceRegex.FindAllStringSubmatch(config, -1) {
+		referenced[submatch[1]+submatch[2]] = struct{}{}
+	}
+
+	for _, variableNode := range environmentVariableNodes(tree) {
+		if _, ok := referenced[variableNode.Name]; ok {
+			continue
+		}
+
+		// These are meant to configure the CLI and the agent
+		if strings.HasPrefix(variableNode.Name, "CIRRUS_") {
+			continue
+		}
+
+		p.parserKit.IssueRegistry.RegisterRuleIssuef(LintRuleUnusedEnvironment, api.Issue_INFO,
+			variableNode.Path, variableNode.Line, variableNode.Column,
+			"environment variable %s is never referenced in the configuration", variableNode.Name)
+	}
+}
+
+func (p *Parser) lintUndefinedVariables(tree *node.Node, tasks []*lintTask) {
+	defined := map[string]struct{}{}
+
+	for name := range p.environment {
+		defined[name] = struct{}{}
+	}
+
+	for _, variableNode := range environmentVariableNodes(tree) {
+		defined[variableNode.Name] = struct{}{}
+	}
+
+	for _, task := range tasks {
+		for _, scriptNode := range scriptNodes(task.node) {
+			lines, err := scriptNode.GetScript()
+			if err != nil {
+				continue
+			}
+
+			script := strings.Join(lines, "\n")
+
+			assigned := map[string]struct{}{}
+			for _, regex := range []*regexp.Regexp{scriptVariableAssignmentRegex, scriptVariableLoopRegex} {
+				for _, submatch := range regex.FindAllStringSubmatch(script, -1) {
+					assigned[submatch[1]] = struct{}{}
+				}
+			}
+
+			for _, submatch := range scriptVariableReferenceRegex.FindAllStringSubmatch(script, -1) {
+				name := submatch[1]
+
+				if _, ok := defined[name]; ok {
+					continue
+				}
+				if _, ok := assigned[name]; ok {
+					continue
+				}
+				if !uppercaseVariableRegex.MatchString(name) || isWellKnownVariable(name) {
+					continue
+				}
+
+				p.parserKit.IssueRegistry.RegisterRuleIssuef(LintRuleUndefinedVariable, api.Issue_INFO,
+					scriptNode.Path, scriptNode.Line, scriptNode.Column, "%s references an undefined variable $%s",
+					scriptNode.Name, name)
+			}
+		}
+	}
+}
+
+func isWellKnownVariable(name string) bool {
+	if _, ok := wellKnownVariables[name]; ok {
+		return true
+	}
+
+	for _, prefix := range wellKnownVariablePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func environmentFromNode(parent *node.Node) map[string]string {
+	result := map[string]string{}
+
+	for _, child := range parent.Children {
+		if child.Name != "env" && child.Name != "environment" {
+			continue
+		}
+
+		env, err := child.GetMapOrListOfMaps()
+		if err != nil {
+			continue
+		}
+
+		result = environment.Merge(result, env)
+	}
+
+	return result
+}
+
+// environmentVariableNodes returns the nodes of all the environment
+// variables defined in the env: and environment: fields of the tree.
+func environmentVariableNodes(tree *node.Node) []*node.Node {
+	var result []*node.Node
+
+	walkNodes(tree, func(current *node.Node) {
+		if current.Name != "env" && current.Name != "environment" {
+			return
+		}
+
+		switch current.Value.(type) {
+		case *node.MapValue:
+			result = append(result, current.Children...)
+		case *node.ListValue:
+			for _, child := range current.Children {
+				if child.IsMap() {
+					result = append(result, child.Children...)
+				}
+			}
+		}
+	})
+
+	return result
+}
+
+func scriptNodes(taskNode *node.Node) []*node.Node {
+	var result []*node.Node
+
+	walkNodes(taskNode, func(current *node.Node) {
+		if current.Name == "script" || strings.HasSuffix(current.Name, "_script") {
+			result = append(result, current)
+		}
+	})
+
+	return result
+}
+
+func walkNodes(current *node.Node, callback func(current *node.Node)) {
+	callback(current)
+
+	for _, child := range current.Children {
+		walkNodes(child, callback)
+	}
+}
@@ -17,11 +17,7 @@ func singlePass(inputTree *node.Node) error {
 	}
 
 	// Ensure this matrix node is attached to either a task or a docker_builder
-	taskNode := matrixNode.FindParent(func(nodeName string) bool {
-		return strings.HasSuffix(nodeName, "task") ||
-			strings.HasSuffix(nodeName, "docker_builder") ||
-			strings.HasSuffix(nodeName, "pipe")
-	})
+	taskNode := matrixNode.FindParent(isTaskNode)
 	if taskNode == nil {
 		return matrixNode.ParserError("matrix can be defined only under a task, docker_builder or pipe")
 	}
@@ -50,14 +46,28 @@ func singlePass(inputTree *node.Node) error {
 	return nil
 }
 
+func isTaskNode(nodeName string) bool {
+	return strings.HasSuffix(nodeName, "task") ||
+		strings.HasSuffix(nodeName, "docker_builder") ||
+		strings.HasSuffix(nodeName, "pipe")
+}
+
+// ExpandMatrices replaces the tasks containing matrices with their combinations,
+// adding the ones from "matrix_include" and removing the ones matched by "matrix_exclude".
 func ExpandMatrices(tree *node.Node) error {
+	if err := expandIncludes(tree); err != nil {
+		return err
+	}
+
 	for {
 		if err := singlePass(tree); err != nil {
 			if errors.Is(err, errNoExpansionDone) {
-				return nil
+				break
 			}
 
 			return err
 		}
 	}
+
+	return applyExcludes(tree)
 }
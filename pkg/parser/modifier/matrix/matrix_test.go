@@ -3,7 +3,9 @@ package matrix_test
 import (
 	"github.com/cirruslabs/cirrus-cli/pkg/parser/modifier/matrix"
 	"github.com/cirruslabs/cirrus-cli/pkg/parser/node"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/parsererror"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
 	"os"
 	"path/filepath"
@@ -62,6 +64,9 @@ var goodCases = []string{
 	"parallel.yaml",
 	"one-sized-matrix.yaml",
 	"expansion-order.yaml",
+	// Include and exclude rules
+	"matrix-exclude.yaml",
+	"matrix-include.yaml",
 }
 
 var badCases = []string{
@@ -70,6 +75,11 @@ var badCases = []string{
 	"bad-only-task-and-docker-builder-expand.yaml",
 }
 
+var badRuleCases = []string{
+	"bad-matrix-exclude-misplaced.yaml",
+	"bad-matrix-include-with-list-of-scalars.yaml",
+}
+
 func runPreprocessor(input string, expand bool) (string, error) {
 	tree, err := node.NewFromText(input)
 	if err != nil {
@@ -144,3 +154,27 @@ func TestBadCases(t *testing.T) {
 		})
 	}
 }
+
+// Ensures that the errors in the include and exclude rules point to the originating node.
+func TestBadRuleCases(t *testing.T) {
+	t.Parallel()
+	for _, badCase := range badRuleCases {
+		badCase := badCase
+
+		t.Run(badCase, func(t *testing.T) {
+			t.Parallel()
+			newPath := filepath.Join("testdata", badCase)
+			testCaseBytes, err := os.ReadFile(newPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = runPreprocessor(string(testCaseBytes), true)
+			require.Error(t, err)
+
+			var richErr *parsererror.Rich
+			require.ErrorAs(t, err, &richErr)
+			assert.NotZero(t, richErr.Line())
+		})
+	}
+}
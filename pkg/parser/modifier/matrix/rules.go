@@ -0,0 +1,267 @@
+package matrix
+
+import (
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/node"
+)
+
+const (
+	fieldExclude = "matrix_exclude"
+	fieldInclude = "matrix_include"
+)
+
+// Fields that are interchangeable when matching the rules.
+var aliases = map[string]string{
+	"env":         "environment",
+	"environment": "env",
+}
+
+// expandIncludes adds a task for each of the "matrix_include" rules, which is a copy of the
+// task with its matrices collapsed to their first combination and the rule's fields merged on top.
+func expandIncludes(tree *node.Node) error {
+	var newChildren []*node.Node
+
+	for _, taskNode := range tree.Children {
+		newChildren = append(newChildren, taskNode)
+
+		includeNode := taskNode.FindChild(fieldInclude)
+		if includeNode == nil || !isTaskNode(taskNode.Name) {
+			continue
+		}
+
+		rules, err := ruleNodes(includeNode)
+		if err != nil {
+			return err
+		}
+
+		removeChildren(taskNode, fieldInclude)
+
+		for _, rule := range rules {
+			includedTask := taskNode.CopyWithParent(tree)
+			removeChildren(includedTask, fieldExclude)
+			collapseMatrices(includedTask)
+
+			for _, child := range rule.Children {
+				mergeOnTop(includedTask, child.CopyWithParent(includedTask))
+			}
+
+			newChildren = append(newChildren, includedTask)
+		}
+	}
+
+	tree.Children = newChildren
+
+	return ensureNoMisplacedRules(tree, fieldInclude)
+}
+
+// applyExcludes removes the expanded tasks that match any of their "matrix_exclude" rules.
+func applyExcludes(tree *node.Node) error {
+	var newChildren []*node.Node
+
+	for _, taskNode := range tree.Children {
+		excludeNode := taskNode.FindChild(fieldExclude)
+		if excludeNode == nil || !isTaskNode(taskNode.Name) {
+			newChildren = append(newChildren, taskNode)
+
+			continue
+		}
+
+		rules, err := ruleNodes(excludeNode)
+		if err != nil {
+			return err
+		}
+
+		removeChildren(taskNode, fieldExclude)
+
+		var excluded bool
+
+		target := withGlobalEnvironment(tree, taskNode)
+
+		for _, rule := range rules {
+			matched, err := matches([]*node.Node{target}, rule)
+			if err != nil {
+				return err
+			}
+
+			if matched {
+				excluded = true
+
+				break
+			}
+		}
+
+		if !excluded {
+			newChildren = append(newChildren, taskNode)
+		}
+	}
+
+	tree.Children = newChildren
+
+	return ensureNoMisplacedRules(tree, fieldExclude)
+}
+
+// collapseMatrices replaces each of the task's matrices with its first combination,
+// so that the fields that are only defined in the matrices are not lost.
+func collapseMatrices(task *node.Node) {
+	for {
+		matrixNode := task.DeepFindChild("matrix")
+		if matrixNode == nil {
+			return
+		}
+
+		var firstCombination []*node.Node
+
+		if len(matrixNode.Children) != 0 {
+			switch matrixNode.Value.(type) {
+			case *node.MapValue:
+				firstCombination = []*node.Node{matrixNode.Children[0]}
+			case *node.ListValue:
+				firstCombination = matrixNode.Children[0].Children
+			}
+		}
+
+		matrixNode.ReplaceWith(firstCombination)
+	}
+}
+
+// withGlobalEnvironment returns the task to match the rules against, which has the top-level
+// environment merged underneath its own, the same way the task's environment is evaluated.
+func withGlobalEnvironment(tree *node.Node, taskNode *node.Node) *node.Node {
+	result := taskNode.CopyWithParent(tree)
+	env := &node.Node{Name: "env", Value: &node.MapValue{}, Parent: result}
+
+	var found bool
+
+	for _, source := range []*node.Node{tree, taskNode} {
+		for _, child := range source.Children {
+			if _, ok := aliases[child.Name]; !ok {
+				continue
+			}
+
+			if _, ok := child.Value.(*node.MapValue); !ok {
+				continue
+			}
+
+			found = true
+
+			for _, variable := range child.Children {
+				mergeOnTop(env, variable.CopyWithParent(env))
+			}
+		}
+	}
+
+	if !found {
+		return taskNode
+	}
+
+	for name := range aliases {
+		removeChildren(result, name)
+	}
+
+	result.Children = append(result.Children, env)
+
+	return result
+}
+
+func ensureNoMisplacedRules(tree *node.Node, name string) error {
+	if misplaced := tree.DeepFindChild(name); misplaced != nil {
+		return misplaced.ParserError("%s can be defined only directly under a task, docker_builder or pipe", name)
+	}
+
+	return nil
+}
+
+// ruleNodes returns the rules defined either as a single map or as a list of maps.
+func ruleNodes(rulesNode *node.Node) ([]*node.Node, error) {
+	switch rulesNode.Value.(type) {
+	case *node.MapValue:
+		return []*node.Node{rulesNode}, nil
+	case *node.ListValue:
+		for _, child := range rulesNode.Children {
+			if _, ok := child.Value.(*node.MapValue); !ok {
+				return nil, child.ParserError("%s with a list can only contain maps as it's items", rulesNode.Name)
+			}
+		}
+
+		return rulesNode.Children, nil
+	default:
+		return nil, rulesNode.ParserError("%s should contain a map or a list of maps", rulesNode.Name)
+	}
+}
+
+// matches checks whether the fields of the rule are found in the targets,
+// with the nested maps matched partially and the scalars matched exactly.
+func matches(targets []*node.Node, rule *node.Node) (bool, error) {
+	for _, ruleChild := range rule.Children {
+		var candidates []*node.Node
+
+		for _, target := range targets {
+			for _, targetChild := range target.Children {
+				if targetChild.Name == ruleChild.Name || targetChild.Name == aliases[ruleChild.Name] {
+					candidates = append(candidates, targetChild)
+				}
+			}
+		}
+
+		if len(candidates) == 0 {
+			return false, nil
+		}
+
+		switch ruleValue := ruleChild.Value.(type) {
+		case *node.MapValue:
+			matched, err := matches(candidates, ruleChild)
+			if err != nil || !matched {
+				return false, err
+			}
+		case *node.ScalarValue:
+			var matched bool
+
+			for _, candidate := range candidates {
+				if candidateValue, ok := candidate.Value.(*node.ScalarValue); ok && candidateValue.Value == ruleValue.Value {
+					matched = true
+
+					break
+				}
+			}
+
+			if !matched {
+				return false, nil
+			}
+		default:
+			return false, ruleChild.ParserError("matrix rules can only match maps and scalars")
+		}
+	}
+
+	return true, nil
+}
+
+// mergeOnTop merges the map fields recursively and replaces the rest of the fields.
+func mergeOnTop(target *node.Node, with *node.Node) {
+	if existing := target.FindChild(with.Name); existing != nil {
+		_, existingIsMap := existing.Value.(*node.MapValue)
+		_, withIsMap := with.Value.(*node.MapValue)
+
+		if existingIsMap && withIsMap {
+			for _, child := range with.Children {
+				mergeOnTop(existing, child)
+			}
+
+			return
+		}
+	}
+
+	removeChildren(target, with.Name)
+	with.Parent = target
+	target.Children = append(target.Children, with)
+}
+
+func removeChildren(target *node.Node, name string) {
+	var newChildren []*node.Node
+
+	for _, child := range target.Children {
+		if child.Name != name {
+			newChildren = append(newChildren, child)
+		}
+	}
+
+	target.Children = newChildren
+}
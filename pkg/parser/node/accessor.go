@@ -27,7 +27,7 @@ func (node *Node) GetBoolValue(env map[string]string, boolevator *boolevator.Boo
 		return false, err
 	}
 
-	evaluation, err := boolevator.Eval(expression, env)
+	evaluation, err := boolevator.ForField(node.Name).Eval(expression, env)
 	if err != nil {
 		return false, err
 	}
@@ -17,6 +17,9 @@ type Node struct {
 	Line   int
 	Column int
 
+	// File the node was included from, empty for the main configuration
+	Path string
+
 	YAMLNode *yaml.Node
 }
 
@@ -84,6 +87,7 @@ func (node *Node) CopyWithParent(parent *Node) *Node {
 		Parent: parent,
 		Line:   node.Line,
 		Column: node.Column,
+		Path:   node.Path,
 	}
 
 	for _, child := range node.Children {
@@ -43,3 +43,11 @@ func WithMissingInstancesAllowed() Option {
 		parser.missingInstancesAllowed = true
 	}
 }
+
+// WithLint enables additional checks that don't prevent the configuration
+// from being parsed, but are reported as issues in the Result.
+func WithLint() Option {
+	return func(parser *Parser) {
+		parser.lint = true
+	}
+}
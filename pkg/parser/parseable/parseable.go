@@ -48,7 +48,7 @@ func (parser *DefaultParser) Parse(node *nodepkg.Node, parserKit *parserkit.Pars
 	// (e.g. "container: ruby:latest"), yet allow "container:" since
 	// there's no clear intention to configure this field from the user
 	if _, ok := node.Value.(*nodepkg.MapValue); !ok && !node.ValueIsEmpty() {
-		parserKit.IssueRegistry.RegisterIssuef(api.Issue_WARNING, node.Line, node.Column,
+		parserKit.IssueRegistry.RegisterIssuef(api.Issue_WARNING, node.Path, node.Line, node.Column,
 			"expected a map, found %s", node.ValueTypeAsString())
 	}
 
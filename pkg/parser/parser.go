@@ -12,7 +12,6 @@ import (
 	"github.com/cirruslabs/cirrus-cli/pkg/parser/abstractcontainer"
 	"github.com/cirruslabs/cirrus-cli/pkg/parser/boolevator"
 	"github.com/cirruslabs/cirrus-cli/pkg/parser/issue"
-	"github.com/cirruslabs/cirrus-cli/pkg/parser/modifier/matrix"
 	"github.com/cirruslabs/cirrus-cli/pkg/parser/nameable"
 	"github.com/cirruslabs/cirrus-cli/pkg/parser/node"
 	"github.com/cirruslabs/cirrus-cli/pkg/parser/parseable"
@@ -57,6 +56,20 @@ type Parser struct {
 	additionalInstances      map[string]protoreflect.MessageDescriptor
 	additionalTaskProperties []*descriptor.FieldDescriptorProto
 	missingInstancesAllowed  bool
+	lint                     bool
+
+	trace            *Trace
+	currentTaskTrace *TaskTrace
+
+	// Included files and whether they were fully processed
+	includes map[string]bool
+
+	// Contents of the parsed files, with the main configuration stored under the empty path
+	configs map[string]string
+
+	// Tasks of the monorepo projects and the project that's being parsed right now
+	taskProjects      map[*node.Node]string
+	currentProjectDir string
 
 	tasksCountBeforeFiltering   int64
 	disabledTaskNamesAndAliases map[string]struct{}
@@ -91,19 +104,28 @@ func New(opts ...Option) *Parser {
 	parser.fs = wrappedFS
 
 	// Initialize boolevator
-	parser.parserKit = &parserkit.ParserKit{
-		Boolevator: boolevator.New(boolevator.WithFunctions(map[string]boolevator.Function{
+	boolevatorOpts := []boolevator.Option{
+		boolevator.WithFunctions(map[string]boolevator.Function{
 			"changesInclude":     parser.bfuncChangesInclude(),
 			"changesIncludeOnly": parser.bfuncChangesIncludeOnly(),
-		})),
+		}),
+	}
+
+	if parser.trace != nil {
+		parser.trace.AffectedFiles = parser.affectedFiles
+		boolevatorOpts = append(boolevatorOpts, boolevator.WithTracer(parser.traceEvaluation))
+	}
+
+	parser.parserKit = &parserkit.ParserKit{
+		Boolevator:    boolevator.New(boolevatorOpts...),
 		IssueRegistry: issue.NewRegistry(),
 	}
 
 	// Register parsers
 	taskParser := task.NewTask(nil, nil, parser.additionalInstances, parser.additionalTaskProperties,
-		parser.missingInstancesAllowed, 0, 0)
-	pipeParser := task.NewDockerPipe(nil, nil, parser.additionalTaskProperties, 0, 0)
-	builderParser := task.NewDockerBuilder(nil, nil, parser.additionalTaskProperties, 0, 0)
+		parser.missingInstancesAllowed, "", 0, 0)
+	pipeParser := task.NewDockerPipe(nil, nil, parser.additionalTaskProperties, "", 0, 0)
+	builderParser := task.NewDockerBuilder(nil, nil, parser.additionalTaskProperties, "", 0, 0)
 	parser.parsers = map[nameable.Nameable]parseable.Parseable{
 		nameable.NewRegexNameable("^(.*)task$"):           taskParser,
 		nameable.NewRegexNameable("^(.*)pipe$"):           pipeParser,
@@ -113,14 +135,20 @@ func New(opts ...Option) *Parser {
 	return parser
 }
 
+// registerPotentiallyMissedTask warns about the top-level keys that look like tasks,
+// but won't be parsed as ones (e.g. "task_build" instead of "build_task").
+func (p *Parser) registerPotentiallyMissedTask(treeItem *node.Node) {
+	if strings.HasPrefix(treeItem.Name, "task_") {
+		p.parserKit.IssueRegistry.RegisterIssuef(api.Issue_WARNING, treeItem.Path, treeItem.Line, treeItem.Column,
+			"you've probably meant %s_task", strings.TrimPrefix(treeItem.Name, "task_"))
+	}
+}
+
 func (p *Parser) parseTasks(tree *node.Node) ([]task.ParseableTaskLike, error) {
 	var tasks []task.ParseableTaskLike
 
 	for _, treeItem := range tree.Children {
-		if strings.HasPrefix(treeItem.Name, "task_") {
-			p.parserKit.IssueRegistry.RegisterIssuef(api.Issue_WARNING, treeItem.Line, treeItem.Column,
-				"you've probably meant %s_task", strings.TrimPrefix(treeItem.Name, "task_"))
-		}
+		p.registerPotentiallyMissedTask(treeItem)
 
 		for key, value := range p.parsers {
 			var taskLike task.ParseableTaskLike
@@ -132,6 +160,7 @@ func (p *Parser) parseTasks(tree *node.Node) ([]task.ParseableTaskLike, error) {
 					p.additionalInstances,
 					p.additionalTaskProperties,
 					p.missingInstancesAllowed,
+					treeItem.Path,
 					treeItem.Line,
 					treeItem.Column,
 				)
@@ -140,6 +169,7 @@ func (p *Parser) parseTasks(tree *node.Node) ([]task.ParseableTaskLike, error) {
 					environment.Copy(p.environment),
 					p.parserKit,
 					p.additionalTaskProperties,
+					treeItem.Path,
 					treeItem.Line,
 					treeItem.Column,
 				)
@@ -148,6 +178,7 @@ func (p *Parser) parseTasks(tree *node.Node) ([]task.ParseableTaskLike, error) {
 					environment.Copy(p.environment),
 					p.parserKit,
 					p.additionalTaskProperties,
+					treeItem.Path,
 					treeItem.Line,
 					treeItem.Column,
 				)
@@ -159,6 +190,11 @@ func (p *Parser) parseTasks(tree *node.Node) ([]task.ParseableTaskLike, error) {
 				continue
 			}
 
+			p.beginTaskTrace(treeItem.Line, treeItem.Column, triggerType(treeItem))
+
+			// Scope the changesInclude() and changesIncludeOnly() to the task's project
+			p.currentProjectDir = p.taskProjects[treeItem]
+
 			err := taskLike.Parse(treeItem, p.parserKit)
 			if err != nil {
 				return nil, err
@@ -187,6 +223,9 @@ func (p *Parser) parseTasks(tree *node.Node) ([]task.ParseableTaskLike, error) {
 				return nil, err
 			}
 
+			p.endTaskTrace(taskLike, enabled)
+			p.currentProjectDir = ""
+
 			if !enabled {
 				p.disabledTaskNamesAndAliases[taskLike.Name()] = struct{}{}
 				p.disabledTaskNamesAndAliases[taskLike.Alias()] = struct{}{}
@@ -202,11 +241,17 @@ func (p *Parser) parseTasks(tree *node.Node) ([]task.ParseableTaskLike, error) {
 	return tasks, nil
 }
 
+func (p *Parser) Parse(ctx context.Context, config string) (*Result, error) {
+	return p.ParseProjects(ctx, []Project{{Config: config}})
+}
+
+// ParseProjects parses the configurations of multiple projects as a single build.
+//
 //nolint:gocognit // it's a parser, and it's complicated
-func (p *Parser) Parse(ctx context.Context, config string) (result *Result, err error) {
+func (p *Parser) ParseProjects(ctx context.Context, projects []Project) (result *Result, err error) {
 	defer func() {
 		if re, ok := err.(*parsererror.Rich); ok {
-			re.Enrich(config)
+			re.Enrich(p.configs[re.Path()])
 		}
 	}()
 
@@ -247,16 +292,17 @@ func (p *Parser) Parse(ctx context.Context, config string) (result *Result, err
 		}
 	}
 
-	// Convert the parsed and nested YAML structure into a tree
-	// to get the ability to walk parents
-	tree, err := node.NewFromTextWithMergeExemptions(config, mergeExemptions)
+	p.includes = map[string]bool{}
+	p.configs = map[string]string{}
+	p.taskProjects = map[*node.Node]string{}
+
+	tree, err := p.projectsTree(ctx, projects, mergeExemptions)
 	if err != nil {
 		return nil, err
 	}
 
-	// Run modifiers on it
-	if err := matrix.ExpandMatrices(tree); err != nil {
-		return nil, err
+	if p.lint {
+		p.lintTree(tree, p.allConfigs())
 	}
 
 	// Run parsers on the top-level nodes
@@ -360,7 +406,7 @@ func (p *Parser) searchForUnbalancedOnlyIfs(tasks []task.ParseableTaskLike) {
 }
 
 func (p *Parser) registerUnbalancedOnlyIfIssue(dependent task.ParseableTaskLike, dependeeName string) {
-	p.parserKit.IssueRegistry.RegisterIssuef(api.Issue_WARNING, dependent.Line(), dependent.Column(),
+	p.parserKit.IssueRegistry.RegisterIssuef(api.Issue_WARNING, dependent.Path(), dependent.Line(), dependent.Column(),
 		"task \"%s\" depends on task \"%s\", but their only_if conditions are different",
 		dependent.Name(), dependeeName)
 }
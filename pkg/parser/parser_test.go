@@ -61,6 +61,9 @@ var validCases = []string{
 	"tart-ssh-options",
 	"vetu-ssh-options",
 	"tart-default-config",
+	"ssh-isolation",
+	"matrix-exclude-global-env",
+	"matrix-include-matrix-only-fields",
 }
 
 func absolutize(file string) string {
@@ -716,3 +719,64 @@ func TestAdditionalInstanceCredentials(t *testing.T) {
 
 	require.EqualValues(t, instance.(*dynamicpb.Message).String(), instanceAsMap.(*dynamicpb.Message).String())
 }
+
+func TestLint(t *testing.T) {
+	p := parser.New(parser.WithLint())
+	result, err := p.ParseFromFile(context.Background(), "testdata/lint.yml")
+	require.NoError(t, err)
+
+	assert.EqualValues(t, []*api.Issue{
+		{
+			Level:   api.Issue_WARNING,
+			Message: "task \"test\" depends on task \"build\", which is always skipped due to its only_if condition",
+			Path:    ".cirrus.yml",
+			Line:    13,
+			Column:  3,
+			Rule:    parser.LintRuleSkippedDependency,
+		},
+		{
+			Level:   api.Issue_WARNING,
+			Message: "task \"test\" has multiple caches named \"modules\"",
+			Path:    ".cirrus.yml",
+			Line:    16,
+			Column:  3,
+			Rule:    parser.LintRuleDuplicateCache,
+		},
+		{
+			Level:   api.Issue_WARNING,
+			Message: "field \"osx_instance\" is deprecated, use \"macos_instance\" instead",
+			Path:    ".cirrus.yml",
+			Line:    21,
+			Column:  3,
+			Rule:    parser.LintRuleDeprecatedField,
+		},
+		{
+			Level:   api.Issue_INFO,
+			Message: "environment variable UNUSED is never referenced in the configuration",
+			Path:    ".cirrus.yml",
+			Line:    6,
+			Column:  3,
+			Rule:    parser.LintRuleUnusedEnvironment,
+		},
+		{
+			Level:   api.Issue_INFO,
+			Message: "script references an undefined variable $UNDEFINED",
+			Path:    ".cirrus.yml",
+			Line:    18,
+			Column:  3,
+			Rule:    parser.LintRuleUndefinedVariable,
+		},
+		{
+			Level:   api.Issue_WARNING,
+			Message: "task \"test\" depends on task \"build\", but their only_if conditions are different",
+			Path:    ".cirrus.yml",
+			Line:    12,
+			Column:  1,
+		},
+	}, result.Issues)
+
+	// Lint rules are only evaluated when requested
+	result, err = parser.New().ParseFromFile(context.Background(), "testdata/lint.yml")
+	require.NoError(t, err)
+	assert.Len(t, result.Issues, 1)
+}
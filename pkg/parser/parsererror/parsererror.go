@@ -14,13 +14,21 @@ var (
 type Rich struct {
 	config  string
 	message string
+	path    string
 	line    int
 	column  int
 }
 
 func NewRich(line, column int, format string, args ...interface{}) *Rich {
+	return NewRichWithPath("", line, column, format, args...)
+}
+
+// NewRichWithPath creates an error that originates from the specified
+// file (e.g. an included one) rather than from the main configuration.
+func NewRichWithPath(path string, line, column int, format string, args ...interface{}) *Rich {
 	return &Rich{
 		message: fmt.Sprintf(format, args...),
+		path:    path,
 		line:    line,
 		column:  column,
 	}
@@ -31,6 +39,10 @@ func (rich *Rich) Enrich(config string) {
 }
 
 func (rich *Rich) Error() string {
+	if rich.path != "" {
+		return fmt.Sprintf("parsing error: %s:%d:%d: %s", rich.path, rich.line, rich.column, rich.message)
+	}
+
 	return fmt.Sprintf("parsing error: %d:%d: %s", rich.line, rich.column, rich.message)
 }
 
@@ -42,6 +54,11 @@ func (rich *Rich) Message() string {
 	return rich.message
 }
 
+// Path returns the file the error originates from, which is empty for the main configuration.
+func (rich *Rich) Path() string {
+	return rich.path
+}
+
 func (rich *Rich) Line() int {
 	return rich.line
 }
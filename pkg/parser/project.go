@@ -0,0 +1,272 @@
+package parser
+
+import (
+	"context"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/cirruslabs/cirrus-cli/internal/executor/environment"
+	"github.com/cirruslabs/cirrus-cli/pkg/larker/fs/scopedlayer"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/modifier/matrix"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/nameable"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/node"
+)
+
+// Project is a configuration located in one of the directories of a monorepo.
+type Project struct {
+	// Directory relative to the repository root, empty or "." for the root itself
+	Dir string
+
+	// Name of the configuration file that was read (e.g. ".cirrus.star"), ".cirrus.yml" if empty
+	ConfigFile string
+
+	Config string
+}
+
+func (project Project) dir() string {
+	dir := path.Clean(project.Dir)
+	if dir == "." {
+		return ""
+	}
+
+	return dir
+}
+
+// configPath is used to refer to the project's configuration in the errors.
+func (project Project) configPath() string {
+	if project.dir() == "" {
+		return ""
+	}
+
+	configFile := project.ConfigFile
+	if configFile == "" {
+		configFile = ".cirrus.yml"
+	}
+
+	return path.Join(project.dir(), configFile)
+}
+
+// projectsTree combines the projects into a single tree, with the tasks of the projects in
+// the subdirectories namespaced by the project's directory (e.g. "services/api/build").
+//
+// The top-level fields of the root project (e.g. "env" or "container") apply to all tasks,
+// while the top-level fields of the rest of the projects only apply to the project's tasks.
+func (p *Parser) projectsTree(
+	ctx context.Context,
+	projects []Project,
+	mergeExemptions []nameable.Nameable,
+) (*node.Node, error) {
+	if len(projects) == 1 && projects[0].dir() == "" {
+		return p.projectTree(ctx, projects[0], mergeExemptions)
+	}
+
+	tree := &node.Node{Name: "root", Value: &node.MapValue{}}
+
+	for _, project := range projects {
+		projectTree, err := p.projectTree(ctx, project, mergeExemptions)
+		if err != nil {
+			return nil, err
+		}
+
+		if project.dir() != "" {
+			p.scopeToProject(projectTree, project.dir())
+		}
+
+		for _, child := range projectTree.Children {
+			child.Parent = tree
+			tree.Children = append(tree.Children, child)
+		}
+	}
+
+	return tree, nil
+}
+
+func (p *Parser) projectTree(
+	ctx context.Context,
+	project Project,
+	mergeExemptions []nameable.Nameable,
+) (*node.Node, error) {
+	configPath := project.configPath()
+	p.configs[configPath] = project.Config
+
+	// Convert the parsed and nested YAML structure into a tree
+	// to get the ability to walk parents
+	tree, err := node.NewFromTextWithMergeExemptions(project.Config, mergeExemptions)
+	if err != nil {
+		return nil, withPath(err, configPath)
+	}
+
+	setPath(tree, configPath)
+
+	// Pull the fields from the included files, relative to the project
+	projectFS := p.fs
+	if project.dir() != "" {
+		projectFS = scopedlayer.New(p.fs, project.dir())
+	}
+
+	p.includes = map[string]bool{}
+
+	if err := p.resolveIncludes(ctx, tree, projectFS, configPath, mergeExemptions); err != nil {
+		return nil, err
+	}
+
+	// Run modifiers on it
+	if err := matrix.ExpandMatrices(tree); err != nil {
+		return nil, err
+	}
+
+	return tree, nil
+}
+
+func (p *Parser) scopeToProject(tree *node.Node, dir string) {
+	var tasks, fields []*node.Node
+
+	for _, child := range tree.Children {
+		if _, ok := p.fallbackName(child.Name); ok {
+			tasks = append(tasks, child)
+		} else {
+			// The project-level fields are only kept in the tasks,
+			// so check them here as they won't reach parseTasks()
+			p.registerPotentiallyMissedTask(child)
+
+			fields = append(fields, child)
+		}
+	}
+
+	for _, task := range tasks {
+		// Prepend the project-level fields so that the task-level fields take precedence
+		inherited := []*node.Node{
+			newMapNode(task, "env", newScalarNode(task, environment.MonorepoProjectDir, dir)),
+		}
+
+		for _, field := range fields {
+			inherited = append(inherited, field.CopyWithParent(task))
+		}
+
+		task.Children = append(inherited, task.Children...)
+
+		p.namespaceTask(task, dir)
+		p.taskProjects[task] = dir
+	}
+
+	tree.Children = tasks
+}
+
+// namespaceTask prefixes the task's name, alias and dependencies with the project's directory,
+// unless the dependency already refers to a task in another project (e.g. "services/api/build")
+// or in the root project (e.g. "/lint").
+func (p *Parser) namespaceTask(task *node.Node, dir string) {
+	var hasName bool
+
+	for _, child := range task.Children {
+		switch child.Name {
+		case "name", "alias":
+			if scalar, ok := child.Value.(*node.ScalarValue); ok {
+				scalar.Value = path.Join(dir, scalar.Value)
+				hasName = hasName || child.Name == "name"
+			}
+		case "depends_on":
+			dependencies := []*node.Node{child}
+			if _, ok := child.Value.(*node.ListValue); ok {
+				dependencies = child.Children
+			}
+
+			for _, dependency := range dependencies {
+				if scalar, ok := dependency.Value.(*node.ScalarValue); ok {
+					scalar.Value = qualifyTaskName(dir, scalar.Value)
+				}
+			}
+		}
+	}
+
+	if !hasName {
+		fallbackName, _ := p.fallbackName(task.Name)
+		task.Children = append(task.Children, newScalarNode(task, "name", path.Join(dir, fallbackName)))
+	}
+}
+
+func qualifyTaskName(dir string, name string) string {
+	if rootName, ok := strings.CutPrefix(name, "/"); ok {
+		return rootName
+	}
+
+	if strings.Contains(name, "/") {
+		return name
+	}
+
+	return path.Join(dir, name)
+}
+
+// fallbackName returns the name of the task derived from its key (e.g. "build" for "build_task").
+func (p *Parser) fallbackName(key string) (string, bool) {
+	for parserName := range p.parsers {
+		if !parserName.Matches(key) {
+			continue
+		}
+
+		if rn, ok := parserName.(*nameable.RegexNameable); ok {
+			return rn.FirstGroupOrDefault(key, "main"), true
+		}
+	}
+
+	return "", false
+}
+
+// scopedAffectedFiles returns the affected files relative to the project that's being parsed
+// right now, so that changesInclude() and changesIncludeOnly() only consider the project's subtree.
+func (p *Parser) scopedAffectedFiles() []string {
+	if p.currentProjectDir == "" {
+		return p.affectedFiles
+	}
+
+	var result []string
+
+	for _, affectedFile := range p.affectedFiles {
+		if relativeFile, ok := strings.CutPrefix(affectedFile, p.currentProjectDir+"/"); ok {
+			result = append(result, relativeFile)
+		}
+	}
+
+	return result
+}
+
+func (p *Parser) allConfigs() string {
+	var result []string
+
+	for _, configPath := range slices.Sorted(maps.Keys(p.configs)) {
+		result = append(result, p.configs[configPath])
+	}
+
+	return strings.Join(result, "\n")
+}
+
+func newScalarNode(parent *node.Node, name string, value string) *node.Node {
+	return &node.Node{
+		Name:   name,
+		Value:  &node.ScalarValue{Value: value},
+		Parent: parent,
+		Line:   parent.Line,
+		Column: parent.Column,
+		Path:   parent.Path,
+	}
+}
+
+func newMapNode(parent *node.Node, name string, children ...*node.Node) *node.Node {
+	result := &node.Node{
+		Name:   name,
+		Value:  &node.MapValue{},
+		Parent: parent,
+		Line:   parent.Line,
+		Column: parent.Column,
+		Path:   parent.Path,
+	}
+
+	for _, child := range children {
+		child.Parent = result
+		result.Children = append(result.Children, child)
+	}
+
+	return result
+}
@@ -0,0 +1,149 @@
+package parser_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/parsererror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProjects(t *testing.T) {
+	projects := []parser.Project{
+		{
+			Dir: ".",
+			Config: `
+container:
+  image: debian:latest
+
+lint_task:
+  script: make lint
+`,
+		},
+		{
+			Dir: "services/api",
+			Config: `
+env:
+  SERVICE: api
+
+build_task:
+  only_if: "changesInclude('src/**')"
+  script: make
+
+test_task:
+  depends_on:
+    - build
+    - /lint
+  script: make test
+`,
+		},
+		{
+			Dir: "services/web",
+			Config: `
+build_task:
+  alias: web-build
+  only_if: "changesInclude('src/**')"
+  script: make
+
+deploy_task:
+  depends_on:
+    - web-build
+    - services/api/test
+  script: make deploy
+`,
+		},
+	}
+
+	p := parser.New(parser.WithAffectedFiles([]string{"services/api/src/main.go", "src/unrelated.go"}))
+	result, err := p.ParseProjects(context.Background(), projects)
+	require.NoError(t, err)
+
+	tasks := map[string]*api.Task{}
+	for _, task := range result.Tasks {
+		tasks[task.Name] = task
+	}
+
+	// changesInclude() is scoped to the project's subtree
+	require.Contains(t, tasks, "services/api/build")
+	assert.NotContains(t, tasks, "services/web/build")
+
+	test := tasks["services/api/test"]
+	require.NotNil(t, test)
+	assert.Equal(t, "api", test.Environment["SERVICE"])
+	assert.Equal(t, "services/api", test.Environment["CIRRUS_MONOREPO_PROJECT_DIR"])
+	assert.ElementsMatch(t, []int64{tasks["services/api/build"].LocalGroupId, tasks["lint"].LocalGroupId},
+		test.RequiredGroups)
+
+	deploy := tasks["services/web/deploy"]
+	require.NotNil(t, deploy)
+	assert.NotContains(t, deploy.Environment, "SERVICE")
+	assert.Equal(t, []int64{test.LocalGroupId}, deploy.RequiredGroups)
+
+	// Root project's fields apply to all tasks
+	var container api.ContainerInstance
+	require.NoError(t, deploy.Instance.UnmarshalTo(&container))
+	assert.Equal(t, "debian:latest", container.Image)
+}
+
+func TestParseProjectsErrorPath(t *testing.T) {
+	projects := []parser.Project{
+		{Dir: ".", Config: "container:\n  image: debian:latest\n"},
+		{Dir: "services/api", Config: "task:\n  script:\n    A: B\n"},
+	}
+
+	_, err := parser.New().ParseProjects(context.Background(), projects)
+	require.Error(t, err)
+
+	var rich *parsererror.Rich
+	require.ErrorAs(t, err, &rich)
+	assert.Equal(t, "services/api/.cirrus.yml", rich.Path())
+	assert.Equal(t, 2, rich.Line())
+
+	// The path points to the configuration file that was actually read
+	projects[1].ConfigFile = ".cirrus.star"
+
+	_, err = parser.New().ParseProjects(context.Background(), projects)
+	require.ErrorAs(t, err, &rich)
+	assert.Equal(t, "services/api/.cirrus.star", rich.Path())
+}
+
+func TestParseProjectsIssuePath(t *testing.T) {
+	projects := []parser.Project{
+		{
+			Dir: ".",
+			Config: `
+container:
+  image: debian:latest
+
+task_lint:
+  script: make lint
+`,
+		},
+		{
+			Dir: "services/api",
+			Config: `
+task_build:
+  script: make
+
+test_task:
+  script: make test
+  artifacts: "*.log"
+`,
+		},
+	}
+
+	result, err := parser.New().ParseProjects(context.Background(), projects)
+	require.NoError(t, err)
+
+	// Issues point to the configuration of the project they were found in
+	assert.ElementsMatch(t, []*api.Issue{
+		{Level: api.Issue_WARNING, Message: "you've probably meant lint_task", Path: ".cirrus.yml", Line: 5, Column: 1},
+		{Level: api.Issue_WARNING, Message: "you've probably meant build_task",
+			Path: "services/api/.cirrus.yml", Line: 2, Column: 1},
+		{Level: api.Issue_WARNING, Message: "expected a map, found scalar",
+			Path: "services/api/.cirrus.yml", Line: 7, Column: 3},
+	}, result.Issues)
+}
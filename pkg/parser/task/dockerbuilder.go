@@ -28,6 +28,7 @@ type DockerBuilder struct {
 	dependsOn    []string
 
 	onlyIfExpression string
+	path             string
 	line             int
 	column           int
 
@@ -38,10 +39,12 @@ func NewDockerBuilder(
 	env map[string]string,
 	parserKit *parserkit.ParserKit,
 	additionalTaskProperties []*descriptor.FieldDescriptorProto,
+	path string,
 	line int,
 	column int,
 ) *DockerBuilder {
 	dbuilder := &DockerBuilder{
+		path:   path,
 		line:   line,
 		column: column,
 	}
@@ -194,7 +197,8 @@ func (dbuilder *DockerBuilder) Enabled(env map[string]string, boolevator *boolev
 		return true, nil
 	}
 
-	evaluation, err := boolevator.Eval(dbuilder.onlyIfExpression, environment.Merge(dbuilder.proto.Environment, env))
+	evaluation, err := boolevator.ForField("only_if").Eval(dbuilder.onlyIfExpression,
+		environment.Merge(dbuilder.proto.Environment, env))
 	if err != nil {
 		return false, err
 	}
@@ -202,6 +206,10 @@ func (dbuilder *DockerBuilder) Enabled(env map[string]string, boolevator *boolev
 	return evaluation, nil
 }
 
+func (dbuilder *DockerBuilder) Path() string {
+	return dbuilder.path
+}
+
 func (dbuilder *DockerBuilder) Line() int {
 	return dbuilder.line
 }
@@ -31,6 +31,7 @@ type DockerPipe struct {
 	dependsOn    []string
 
 	onlyIfExpression string
+	path             string
 	line             int
 	column           int
 
@@ -41,10 +42,12 @@ func NewDockerPipe(
 	env map[string]string,
 	parserKit *parserkit.ParserKit,
 	additionalTaskProperties []*descriptor.FieldDescriptorProto,
+	path string,
 	line int,
 	column int,
 ) *DockerPipe {
 	pipe := &DockerPipe{
+		path:   path,
 		line:   line,
 		column: column,
 	}
@@ -246,7 +249,8 @@ func (pipe *DockerPipe) Enabled(env map[string]string, boolevator *boolevator.Bo
 		return true, nil
 	}
 
-	evaluation, err := boolevator.Eval(pipe.onlyIfExpression, environment.Merge(pipe.proto.Environment, env))
+	evaluation, err := boolevator.ForField("only_if").Eval(pipe.onlyIfExpression,
+		environment.Merge(pipe.proto.Environment, env))
 	if err != nil {
 		return false, err
 	}
@@ -254,6 +258,10 @@ func (pipe *DockerPipe) Enabled(env map[string]string, boolevator *boolevator.Bo
 	return evaluation, nil
 }
 
+func (pipe *DockerPipe) Path() string {
+	return pipe.path
+}
+
 func (pipe *DockerPipe) Line() int {
 	return pipe.line
 }
@@ -32,6 +32,7 @@ type Task struct {
 	onlyIfExpression string
 
 	missingInstancesAllowed bool
+	path                    string
 	line                    int
 	column                  int
 
@@ -45,11 +46,13 @@ func NewTask(
 	additionalInstances map[string]protoreflect.MessageDescriptor,
 	additionalTaskProperties []*descriptor.FieldDescriptorProto,
 	missingInstancesAllowed bool,
+	path string,
 	line int,
 	column int,
 ) *Task {
 	task := &Task{
 		missingInstancesAllowed: missingInstancesAllowed,
+		path:                    path,
 		line:                    line,
 		column:                  column,
 	}
@@ -358,7 +361,8 @@ func (task *Task) Enabled(env map[string]string, boolevator *boolevator.Boolevat
 		return true, nil
 	}
 
-	evaluation, err := boolevator.Eval(task.onlyIfExpression, environment.Merge(task.proto.Environment, env))
+	evaluation, err := boolevator.ForField("only_if").Eval(task.onlyIfExpression,
+		environment.Merge(task.proto.Environment, env))
 	if err != nil {
 		return false, err
 	}
@@ -366,6 +370,10 @@ func (task *Task) Enabled(env map[string]string, boolevator *boolevator.Boolevat
 	return evaluation, nil
 }
 
+func (task *Task) Path() string {
+	return task.path
+}
+
 func (task *Task) Line() int {
 	return task.line
 }
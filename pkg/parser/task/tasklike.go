@@ -23,6 +23,7 @@ type ParseableTaskLike interface {
 	OnlyIfExpression() string
 	Enabled(env map[string]string, boolevator *boolevator.Boolevator) (bool, error)
 
+	Path() string
 	Line() int
 	Column() int
 
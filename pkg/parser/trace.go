@@ -0,0 +1,152 @@
+package parser
+
+import (
+	"github.com/cirruslabs/cirrus-cli/pkg/api"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/boolevator"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/node"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/task"
+	"github.com/cirruslabs/go-java-glob"
+)
+
+// Trace collects the evaluations of the boolean expressions (such as "only_if" and "skip")
+// made while parsing the configuration to explain why each task was included or not.
+type Trace struct {
+	AffectedFiles []string
+	Tasks         []*TaskTrace
+}
+
+type TaskTrace struct {
+	Name   string
+	Alias  string
+	Line   int
+	Column int
+
+	DependsOn []string
+
+	// Raw value of the "trigger_type" field, if any
+	TriggerType string
+
+	Evaluations []*Evaluation
+
+	// Enabled is false when the task was filtered out by its "only_if" expression
+	Enabled bool
+	Skipped bool
+}
+
+type Evaluation struct {
+	*boolevator.Trace
+
+	// Affected files that matched each of the changesInclude() and changesIncludeOnly() patterns
+	MatchedFiles []PatternMatch
+}
+
+type PatternMatch struct {
+	Pattern string
+	Files   []string
+}
+
+// WithTrace records the evaluations made while parsing into the provided trace.
+func WithTrace(trace *Trace) Option {
+	return func(parser *Parser) {
+		parser.trace = trace
+	}
+}
+
+func (p *Parser) traceEvaluation(trace *boolevator.Trace) {
+	if p.currentTaskTrace == nil {
+		return
+	}
+
+	evaluation := &Evaluation{Trace: trace}
+
+	for _, call := range trace.Calls {
+		if call.Function != "changesInclude" && call.Function != "changesIncludeOnly" {
+			continue
+		}
+
+		for _, pattern := range call.ExpandedArguments {
+			files, err := MatchingAffectedFiles(p.scopedAffectedFiles(), pattern)
+			if err != nil {
+				continue
+			}
+
+			evaluation.MatchedFiles = append(evaluation.MatchedFiles, PatternMatch{
+				Pattern: pattern,
+				Files:   files,
+			})
+		}
+	}
+
+	// Collectible fields are evaluated more than once, in which case the last evaluation takes effect
+	for i, existing := range p.currentTaskTrace.Evaluations {
+		if existing.Field == trace.Field && existing.Expression == trace.Expression {
+			p.currentTaskTrace.Evaluations[i] = evaluation
+
+			return
+		}
+	}
+
+	p.currentTaskTrace.Evaluations = append(p.currentTaskTrace.Evaluations, evaluation)
+}
+
+func (p *Parser) beginTaskTrace(line int, column int, triggerType string) {
+	if p.trace == nil {
+		return
+	}
+
+	p.currentTaskTrace = &TaskTrace{
+		Line:        line,
+		Column:      column,
+		TriggerType: triggerType,
+	}
+	p.trace.Tasks = append(p.trace.Tasks, p.currentTaskTrace)
+}
+
+func (p *Parser) endTaskTrace(taskLike task.ParseableTaskLike, enabled bool) {
+	if p.currentTaskTrace == nil {
+		return
+	}
+
+	p.currentTaskTrace.Name = taskLike.Name()
+	p.currentTaskTrace.Alias = taskLike.Alias()
+	p.currentTaskTrace.DependsOn = taskLike.DependsOnNames()
+	p.currentTaskTrace.Enabled = enabled
+
+	if protoTask, ok := taskLike.Proto().(*api.Task); ok {
+		p.currentTaskTrace.Skipped = protoTask.Status == api.Status_SKIPPED
+	}
+
+	p.currentTaskTrace = nil
+}
+
+// MatchingAffectedFiles returns the affected files that match the pattern.
+func MatchingAffectedFiles(affectedFiles []string, pattern string) ([]string, error) {
+	re, err := glob.ToRegexPattern(pattern, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+
+	for _, affectedFile := range affectedFiles {
+		if re.MatchString(affectedFile) {
+			result = append(result, affectedFile)
+		}
+	}
+
+	return result, nil
+}
+
+func triggerType(tree *node.Node) string {
+	child := tree.FindChild("trigger_type")
+	if child == nil {
+		return ""
+	}
+
+	value, err := child.GetStringValue()
+	if err != nil {
+		return ""
+	}
+
+	return value
+}
@@ -0,0 +1,88 @@
+package parser_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cirruslabs/cirrus-cli/pkg/parser"
+	"github.com/cirruslabs/cirrus-cli/pkg/parser/boolevator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrace(t *testing.T) {
+	config := `
+container:
+  image: debian:latest
+
+env:
+  DOCS_DIR: docs
+
+docs_task:
+  only_if: "changesInclude('${DOCS_DIR}/**', '*.md')"
+  script: true
+
+lint_task:
+  skip: "$CIRRUS_BRANCH == 'main'"
+  script: true
+
+release_task:
+  only_if: "$CIRRUS_TAG != ''"
+  script: true
+
+deploy_task:
+  trigger_type: manual
+  depends_on: docs
+  script: true
+`
+
+	var trace parser.Trace
+
+	p := parser.New(
+		parser.WithEnvironment(map[string]string{"CIRRUS_BRANCH": "main"}),
+		parser.WithAffectedFiles([]string{"src/main.go", "README.md"}),
+		parser.WithTrace(&trace),
+	)
+
+	result, err := p.Parse(context.Background(), config)
+	require.NoError(t, err)
+	assert.Len(t, result.Tasks, 3)
+
+	assert.Equal(t, []string{"src/main.go", "README.md"}, trace.AffectedFiles)
+	require.Len(t, trace.Tasks, 4)
+
+	tasks := map[string]*parser.TaskTrace{}
+	for _, taskTrace := range trace.Tasks {
+		tasks[taskTrace.Name] = taskTrace
+	}
+
+	docs := tasks["docs"]
+	require.NotNil(t, docs)
+	assert.True(t, docs.Enabled)
+	require.Len(t, docs.Evaluations, 1)
+	assert.Equal(t, "only_if", docs.Evaluations[0].Field)
+	assert.True(t, docs.Evaluations[0].Result)
+	assert.Equal(t, []parser.PatternMatch{
+		{Pattern: "docs/**"},
+		{Pattern: "*.md", Files: []string{"README.md"}},
+	}, docs.Evaluations[0].MatchedFiles)
+
+	lint := tasks["lint"]
+	require.NotNil(t, lint)
+	assert.True(t, lint.Enabled)
+	assert.True(t, lint.Skipped)
+	require.Len(t, lint.Evaluations, 1)
+	assert.Equal(t, "skip", lint.Evaluations[0].Field)
+
+	release := tasks["release"]
+	require.NotNil(t, release)
+	assert.False(t, release.Enabled)
+	require.Len(t, release.Evaluations, 1)
+	assert.Equal(t, []boolevator.Variable{{Name: "CIRRUS_TAG"}}, release.Evaluations[0].Variables)
+
+	deploy := tasks["deploy"]
+	require.NotNil(t, deploy)
+	assert.Equal(t, "manual", deploy.TriggerType)
+	assert.Equal(t, []string{"docs"}, deploy.DependsOn)
+	assert.Empty(t, deploy.Evaluations)
+}